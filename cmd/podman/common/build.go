@@ -25,6 +25,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/env"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -49,7 +50,7 @@ type BuildFlagsWrapper struct {
 // FarmBuildHiddenFlags are the flags hidden from the farm build command because they are either not
 // supported or don't make sense in the farm build use case
 var FarmBuildHiddenFlags = []string{"arch", "all-platforms", "compress", "cw", "disable-content-trust",
-	"logsplit", "manifest", "os", "output", "platform", "sign-by", "signature-policy", "stdin",
+	"logsplit", "manifest", "os", "output", "platform", "progress", "sign-by", "signature-policy", "stdin",
 	"variant"}
 
 func DefineBuildFlags(cmd *cobra.Command, buildOpts *BuildFlagsWrapper, isFarmBuild bool) {
@@ -59,9 +60,10 @@ func DefineBuildFlags(cmd *cobra.Command, buildOpts *BuildFlagsWrapper, isFarmBu
 	flags.Bool("load", false, "buildx --load")
 	_ = flags.MarkHidden("load")
 
-	// buildx build --progress ignored, but added for compliance
-	flags.String("progress", "auto", "buildx --progress")
-	_ = flags.MarkHidden("progress")
+	// buildx build --progress values other than json are ignored, but
+	// added for compliance
+	flags.String("progress", "auto", "Report the progress of image pulls as JSON events if set to 'json'")
+	_ = cmd.RegisterFlagCompletionFunc("progress", AutocompleteBuildProgress)
 
 	// Podman flags
 	flags.BoolVarP(&buildOpts.SquashAll, "squash-all", "", false, "Squash all layers into a single layer")
@@ -395,6 +397,15 @@ func buildFlagsWrapperToOptions(c *cobra.Command, contextDir string, flags *Buil
 		stderr = logfile
 		reporter = logfile
 	}
+	var reportWriter io.Writer = reporter
+	if format, _ := c.Flags().GetString("progress"); format == progress.FormatJSON {
+		if registry.IsRemote() {
+			return nil, errors.New("--progress=json is not supported by the remote client")
+		}
+		// buildah has no progress channel, so the events of the
+		// pulls are derived from the report.
+		reportWriter = progress.NewPullReportWriter(progress.NewJSONEmitter(reporter))
+	}
 
 	nsValues, networkPolicy, err := parse.NamespaceOptions(c)
 	if err != nil {
@@ -566,7 +577,7 @@ func buildFlagsWrapperToOptions(c *cobra.Command, contextDir string, flags *Buil
 		PullPushRetryDelay:      retryDelay,
 		Quiet:                   flags.Quiet,
 		RemoveIntermediateCtrs:  flags.Rm,
-		ReportWriter:            reporter,
+		ReportWriter:            reportWriter,
 		Runtime:                 podmanConfig.RuntimePath,
 		RuntimeArgs:             runtimeFlags,
 		RusageLogFile:           flags.RusageLogFile,
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	"github.com/spf13/cobra"
+)
+
+// BulkFormatJSON is the --format of bulk operations printing the result
+// of each item as JSON.
+const BulkFormatJSON = "json"
+
+// BulkFlags are the flags of commands operating on many containers, pods,
+// images or volumes at once.
+type BulkFlags struct {
+	Parallel uint
+	FailFast bool
+	Format   string
+}
+
+// DefineBulkFlags adds the --parallel, --fail-fast and --format flags to
+// cmd.
+func DefineBulkFlags(cmd *cobra.Command, bulkFlags *BulkFlags) {
+	flags := cmd.Flags()
+
+	parallelFlagName := "parallel"
+	flags.UintVar(&bulkFlags.Parallel, parallelFlagName, 0, "Process at most `N` items at the same time, 0 for the default of the command")
+	_ = cmd.RegisterFlagCompletionFunc(parallelFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&bulkFlags.FailFast, "fail-fast", false, "Do not process further items after the first failure")
+
+	formatFlagName := "format"
+	flags.StringVar(&bulkFlags.Format, formatFlagName, "", "Print the result of each item in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, AutocompleteBulkFormat)
+}
+
+// Validate returns an error if the flags are invalid.
+func (f *BulkFlags) Validate() error {
+	if f.Format != "" && f.Format != BulkFormatJSON {
+		return fmt.Errorf("unsupported --format %q, only %q is supported", f.Format, BulkFormatJSON)
+	}
+	return nil
+}
+
+// Options returns the engine options of the bulk operation.
+func (f *BulkFlags) Options() entities.BulkOptions {
+	return entities.BulkOptions{
+		Parallel: f.Parallel,
+		FailFast: f.FailFast,
+	}
+}
+
+// JSON returns true if the results are printed as JSON.
+func (f *BulkFlags) JSON() bool {
+	return f.Format == BulkFormatJSON
+}
+
+// PrintBulkResults prints the results of a bulk operation as JSON. Unlike
+// the errors of the default output, failures only set the exit code, the
+// errors are part of the results.
+func PrintBulkResults(results []reports.BulkResult) error {
+	if results == nil {
+		results = []reports.BulkResult{}
+	}
+	b, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	for _, r := range results {
+		// Keep a more specific exit code set by the command.
+		if r.Status != reports.BulkStatusOK && registry.GetExitCode() == 0 {
+			registry.SetExitCode(define.ExecErrorCodeGeneric)
+			break
+		}
+	}
+	return nil
+}
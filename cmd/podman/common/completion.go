@@ -21,6 +21,8 @@ import (
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/inspect"
+	"github.com/containers/podman/v5/pkg/progress"
+	"github.com/containers/podman/v5/pkg/recommend"
 	"github.com/containers/podman/v5/pkg/signal"
 	systemdDefine "github.com/containers/podman/v5/pkg/systemd/define"
 	"github.com/containers/podman/v5/pkg/util"
@@ -275,6 +277,29 @@ func getSecrets(cmd *cobra.Command, toComplete string, cType completeType) ([]st
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getJobs(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	jobs, err := engine.JobList(registry.GetContext())
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, j := range jobs {
+		// jobs have no names, so always complete the short ID
+		if strings.HasPrefix(j.ID, toComplete) {
+			suggestions = append(suggestions, j.ID[0:12])
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func getRegistries() ([]string, cobra.ShellCompDirective) {
 	regs, err := sysregistriesv2.UnqualifiedSearchRegistries(nil)
 	if err != nil {
@@ -619,6 +644,14 @@ func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) (
 	return getSecrets(cmd, toComplete, completeDefault)
 }
 
+// AutocompleteJobs - Autocomplete job IDs.
+func AutocompleteJobs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getJobs(cmd, toComplete)
+}
+
 func AutocompleteSecretCreate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if len(args) == 1 {
 		return nil, cobra.ShellCompDirectiveDefault
@@ -1035,7 +1068,7 @@ func AutocompleteLogDriver(cmd *cobra.Command, args []string, toComplete string)
 // AutocompleteLogOpt - Autocomplete log-opt options.
 // -> "path=", "tag="
 func AutocompleteLogOpt(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	logOptions := []string{"path=", "tag=", "max-size="}
+	logOptions := []string{"path=", "tag=", "max-size=", "rate=", "burst="}
 	if strings.HasPrefix(toComplete, "path=") {
 		return nil, cobra.ShellCompDirectiveDefault
 	}
@@ -1063,6 +1096,7 @@ func AutocompleteSecurityOption(cmd *cobra.Command, args []string, toComplete st
 		"apparmor=":         nil,
 		"no-new-privileges": nil,
 		"seccomp=":          func(s string) ([]string, cobra.ShellCompDirective) { return nil, cobra.ShellCompDirectiveDefault },
+		"seccomp-notify=":   func(s string) ([]string, cobra.ShellCompDirective) { return nil, cobra.ShellCompDirectiveDefault },
 		"label=": func(s string) ([]string, cobra.ShellCompDirective) {
 			if strings.HasPrefix(s, "d") {
 				return []string{"disable"}, cobra.ShellCompDirectiveNoFileComp
@@ -1569,6 +1603,30 @@ func AutocompleteImageSaveFormat(cmd *cobra.Command, args []string, toComplete s
 	return ValidSaveFormats, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteProgressFormat - Autocomplete progress format options.
+// -> "text", "json"
+func AutocompleteProgressFormat(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{progress.FormatText, progress.FormatJSON}, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteBuildProgress - Autocomplete build progress options.
+// -> "auto", "plain", "tty", "json"
+func AutocompleteBuildProgress(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{"auto", "plain", "tty", progress.FormatJSON}, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteBulkFormat - Autocomplete the result format options of bulk operations.
+// -> "json"
+func AutocompleteBulkFormat(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{BulkFormatJSON}, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteRecommendFormat - Autocomplete container recommend format options.
+// -> "update", "quadlet", "kube", "json"
+func AutocompleteRecommendFormat(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{recommend.FormatUpdate, recommend.FormatQuadlet, recommend.FormatKube, recommend.FormatJSON}, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteWaitCondition - Autocomplete wait condition options.
 // -> "unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing"
 func AutocompleteWaitCondition(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1723,6 +1781,16 @@ func AutocompletePruneFilters(cmd *cobra.Command, args []string, toComplete stri
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompleteImagePruneFilters - Autocomplete image prune --filter options.
+func AutocompleteImagePruneFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kv := keyValueCompletion{
+		"label=":      nil,
+		"until=":      nil,
+		"unused-for=": nil,
+	}
+	return completeKeyValues(toComplete, kv)
+}
+
 // AutocompleteNetworkFilters - Autocomplete network ls --filter options.
 func AutocompleteNetworkFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	kv := keyValueCompletion{
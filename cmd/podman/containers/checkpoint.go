@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/containers/buildah/pkg/cli"
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -40,7 +41,10 @@ var (
 	}
 )
 
-var checkpointOptions entities.CheckpointOptions
+var (
+	checkpointOptions        entities.CheckpointOptions
+	checkpointEncryptionKeys []string
+)
 
 type checkpointStatistics struct {
 	PodmanDuration      int64                        `json:"podman_checkpoint_duration"`
@@ -82,6 +86,18 @@ func init() {
 		"Display checkpoint statistics",
 	)
 
+	encryptionKeyFlagName := "encryption-key"
+	flags.StringArrayVar(&checkpointEncryptionKeys, encryptionKeyFlagName, nil, "Key with the encryption protocol to use to encrypt the checkpoint (e.g. jwe:/path/to/key.pem)")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(encryptionKeyFlagName, completion.AutocompleteDefault)
+
+	encryptionSecretFlagName := "encryption-secret"
+	flags.StringVar(&checkpointOptions.EncryptionSecret, encryptionSecretFlagName, "", "Encrypt the checkpoint with the passphrase stored in the specified secret")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(encryptionSecretFlagName, common.AutocompleteSecrets)
+
+	if registry.IsRemote() {
+		_ = flags.MarkHidden(encryptionKeyFlagName)
+	}
+
 	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
 }
 
@@ -122,6 +138,21 @@ func checkpoint(cmd *cobra.Command, args []string) error {
 	if (checkpointOptions.WithPrevious || checkpointOptions.PreCheckPoint) && !criu.MemTrack() {
 		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking")
 	}
+	if len(checkpointEncryptionKeys) > 0 || checkpointOptions.EncryptionSecret != "" {
+		if checkpointOptions.Export == "" && checkpointOptions.CreateImage == "" {
+			return errors.New("--encryption-key and --encryption-secret can only be used with --export or --create-image")
+		}
+		if checkpointOptions.PreCheckPoint {
+			return errors.New("--encryption-key and --encryption-secret can not be used with --pre-checkpoint")
+		}
+	}
+	if len(checkpointEncryptionKeys) > 0 {
+		encConfig, _, err := cli.EncryptConfig(checkpointEncryptionKeys, nil)
+		if err != nil {
+			return fmt.Errorf("unable to obtain encryption config: %w", err)
+		}
+		checkpointOptions.OciEncryptConfig = encConfig
+	}
 	responses, err := registry.ContainerEngine().ContainerCheckpoint(context.Background(), args, checkpointOptions)
 	if err != nil {
 		return err
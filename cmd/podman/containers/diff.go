@@ -40,6 +40,8 @@ func init() {
 	flags.StringVar(&diffOpts.Format, formatFlagName, "", "Change the output format (json)")
 	_ = diffCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(nil))
 
+	diff.AddContentFlags(diffCmd, diffOpts)
+
 	validate.AddLatestFlag(diffCmd, &diffOpts.Latest)
 }
 
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -95,6 +96,10 @@ func execFlags(cmd *cobra.Command) {
 	flags.Int32(waitFlagName, 0, "Total seconds to wait for container to start")
 	_ = flags.MarkHidden(waitFlagName)
 
+	recordFlagName := "record"
+	flags.StringVar(&execOpts.RecordFile, recordFlagName, "", "Record the terminal session to `file` in asciicast v2 format, requires --tty")
+	_ = cmd.RegisterFlagCompletionFunc(recordFlagName, completion.AutocompleteDefault)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("preserve-fds")
 	}
@@ -155,6 +160,19 @@ func exec(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if execOpts.RecordFile != "" {
+		if !execOpts.Tty {
+			return errors.New("--record requires --tty")
+		}
+		if execDetach {
+			return errors.New("--record cannot be used with --detach")
+		}
+		execOpts.RecordFile, err = filepath.Abs(execOpts.RecordFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	if cmd.Flags().Changed("wait") {
 		seconds, err := cmd.Flags().GetInt32("wait")
 		if err != nil {
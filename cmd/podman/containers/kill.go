@@ -13,6 +13,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/signal"
 	"github.com/spf13/cobra"
 )
@@ -51,6 +52,7 @@ var (
 var (
 	killOptions  = entities.KillOptions{}
 	killCidFiles = []string{}
+	killBulk     common.BulkFlags
 )
 
 func killFlags(cmd *cobra.Command) {
@@ -64,6 +66,8 @@ func killFlags(cmd *cobra.Command) {
 	cidfileFlagName := "cidfile"
 	flags.StringArrayVar(&killCidFiles, cidfileFlagName, nil, "Read the container ID from the file")
 	_ = cmd.RegisterFlagCompletionFunc(cidfileFlagName, completion.AutocompleteDefault)
+
+	common.DefineBulkFlags(cmd, &killBulk)
 }
 
 func init() {
@@ -87,6 +91,10 @@ func kill(_ *cobra.Command, args []string) error {
 		errs utils.OutputErrors
 	)
 	args = utils.RemoveSlash(args)
+	if err := killBulk.Validate(); err != nil {
+		return err
+	}
+	killOptions.BulkOptions = killBulk.Options()
 	// Check if the signalString provided by the user is valid
 	// Invalid signals will return err
 	sig, err := signal.ParseSignalNameOrNumber(killOptions.Signal)
@@ -109,6 +117,13 @@ func kill(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if killBulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	for _, r := range responses {
 		switch {
 		case r.Err != nil:
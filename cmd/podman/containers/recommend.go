@@ -0,0 +1,195 @@
+package containers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/recommend"
+	"github.com/spf13/cobra"
+)
+
+var (
+	recommendDescription = `Samples the resource usage of a container over a window and recommends resource limits.
+
+  The CPU, memory, PIDs and block I/O usage of the container is sampled, optionally combined with samples recorded before, and limits with headroom are suggested as podman update flags, Quadlet keys or a Kubernetes resources patch. Block I/O limits are suggested for the device given with --io-device.`
+
+	recommendCommand = &cobra.Command{
+		Use:               "recommend [options] CONTAINER",
+		Short:             "Recommend resource limits for a container",
+		Long:              recommendDescription,
+		RunE:              recommendRun,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainersRunning,
+		Example: `podman container recommend --window 1h ctrID
+  podman container recommend --format quadlet --headroom 30 ctrID
+  podman container recommend --io-device /dev/sda ctrID
+  podman container recommend --window 0 --samples samples.json --format kube ctrID`,
+	}
+)
+
+type recommendOptionsCLI struct {
+	Window   time.Duration
+	Interval int
+	Headroom uint
+	Format   string
+	Samples  string
+	IODevice string
+}
+
+var recommendOptions recommendOptionsCLI
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: recommendCommand,
+		Parent:  containerCmd,
+	})
+	flags := recommendCommand.Flags()
+
+	windowFlagName := "window"
+	flags.DurationVar(&recommendOptions.Window, windowFlagName, 5*time.Minute, "Duration to sample the resource usage of the container")
+	_ = recommendCommand.RegisterFlagCompletionFunc(windowFlagName, completion.AutocompleteNone)
+
+	intervalFlagName := "interval"
+	flags.IntVarP(&recommendOptions.Interval, intervalFlagName, "i", 1, "Time in seconds between samples")
+	_ = recommendCommand.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
+
+	headroomFlagName := "headroom"
+	flags.UintVar(&recommendOptions.Headroom, headroomFlagName, 20, "Headroom in percent added on top of the observed usage")
+	_ = recommendCommand.RegisterFlagCompletionFunc(headroomFlagName, completion.AutocompleteNone)
+
+	formatFlagName := "format"
+	flags.StringVar(&recommendOptions.Format, formatFlagName, recommend.FormatUpdate, "Output format (update, quadlet, kube, json)")
+	_ = recommendCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteRecommendFormat)
+
+	samplesFlagName := "samples"
+	flags.StringVar(&recommendOptions.Samples, samplesFlagName, "", "Read previously recorded samples from and record new samples to `file`")
+	_ = recommendCommand.RegisterFlagCompletionFunc(samplesFlagName, completion.AutocompleteDefault)
+
+	ioDeviceFlagName := "io-device"
+	flags.StringVar(&recommendOptions.IODevice, ioDeviceFlagName, "", "Recommend block I/O read and write rate limits of `device`")
+	_ = recommendCommand.RegisterFlagCompletionFunc(ioDeviceFlagName, completion.AutocompleteDefault)
+}
+
+func recommendRun(cmd *cobra.Command, args []string) error {
+	switch recommendOptions.Format {
+	case recommend.FormatUpdate, recommend.FormatQuadlet, recommend.FormatKube, recommend.FormatJSON:
+	default:
+		return fmt.Errorf("unsupported format %q, must be one of %q, %q, %q or %q", recommendOptions.Format,
+			recommend.FormatUpdate, recommend.FormatQuadlet, recommend.FormatKube, recommend.FormatJSON)
+	}
+	if recommendOptions.Window < 0 {
+		return errors.New("--window must not be negative")
+	}
+	if recommendOptions.Window == 0 && recommendOptions.Samples == "" {
+		return errors.New("--samples is required if --window is 0")
+	}
+	if recommendOptions.IODevice != "" && !strings.HasPrefix(recommendOptions.IODevice, "/dev/") {
+		return fmt.Errorf("--io-device %q is not a path in /dev", recommendOptions.IODevice)
+	}
+
+	var samples []recommend.Sample
+	var samplesFile *os.File
+	if recommendOptions.Samples != "" {
+		f, err := os.OpenFile(recommendOptions.Samples, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		samples, err = recommend.ReadSamples(f)
+		if err != nil {
+			return fmt.Errorf("reading samples from %q: %w", recommendOptions.Samples, err)
+		}
+		samplesFile = f
+	}
+
+	name := args[0]
+	if recommendOptions.Window > 0 {
+		fmt.Fprintf(os.Stderr, "Sampling the resource usage of %s for %s\n", name, recommendOptions.Window)
+		newSamples, ctrName, err := sampleContainer(name, samplesFile)
+		if err != nil {
+			return err
+		}
+		samples = append(samples, newSamples...)
+		name = ctrName
+	}
+
+	usage, err := recommend.Analyze(samples)
+	if err != nil {
+		return err
+	}
+	recommendation := recommend.Recommend(usage, float64(recommendOptions.Headroom)/100, recommendOptions.IODevice)
+
+	switch recommendOptions.Format {
+	case recommend.FormatQuadlet:
+		fmt.Println("[Container]")
+		fmt.Println(strings.Join(recommendation.QuadletKeys(), "\n"))
+	case recommend.FormatKube:
+		patch, err := recommendation.KubePatch(name)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(patch))
+	case recommend.FormatJSON:
+		b, err := json.MarshalIndent(struct {
+			Usage          *recommend.Usage          `json:"usage"`
+			Recommendation *recommend.Recommendation `json:"recommendation"`
+		}{usage, recommendation}, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	default:
+		fmt.Printf("podman update %s %s\n", strings.Join(recommendation.UpdateFlags(), " "), args[0])
+	}
+	return nil
+}
+
+// sampleContainer samples the resource usage of the container nameOrID over
+// the window.  If samplesFile is set, the samples are also written to it.
+// The name of the container is returned along with the samples.
+func sampleContainer(nameOrID string, samplesFile *os.File) ([]recommend.Sample, string, error) {
+	ctx, cancel := context.WithTimeout(registry.Context(), recommendOptions.Window)
+	defer cancel()
+
+	statsChan, err := registry.ContainerEngine().ContainerStats(ctx, []string{nameOrID}, entities.ContainerStatsOptions{
+		Stream:   true,
+		Interval: recommendOptions.Interval,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var samples []recommend.Sample
+	name := nameOrID
+	for {
+		select {
+		case <-ctx.Done():
+			return samples, name, nil
+		case report, ok := <-statsChan:
+			if !ok {
+				return samples, name, nil
+			}
+			if report.Error != nil {
+				return nil, "", report.Error
+			}
+			for i := range report.Stats {
+				sample := recommend.SampleFromStats(&report.Stats[i])
+				samples = append(samples, sample)
+				name = report.Stats[i].Name
+				if samplesFile != nil {
+					if err := recommend.WriteSample(samplesFile, sample); err != nil {
+						return nil, "", fmt.Errorf("recording sample: %w", err)
+					}
+				}
+			}
+		}
+	}
+}
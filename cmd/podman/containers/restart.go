@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/spf13/cobra"
 )
 
@@ -51,6 +52,7 @@ var (
 	}
 	restartCidFiles = []string{}
 	restartTimeout  int
+	restartBulk     common.BulkFlags
 )
 
 func restartFlags(cmd *cobra.Command) {
@@ -71,6 +73,8 @@ func restartFlags(cmd *cobra.Command) {
 	flags.IntVarP(&restartTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for stop before killing the container")
 	_ = cmd.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 
+	common.DefineBulkFlags(cmd, &restartBulk)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("cidfile")
 	}
@@ -99,6 +103,10 @@ func restart(cmd *cobra.Command, args []string) error {
 	)
 	args = utils.RemoveSlash(args)
 
+	if err := restartBulk.Validate(); err != nil {
+		return err
+	}
+	restartOpts.BulkOptions = restartBulk.Options()
 	if cmd.Flag("time").Changed {
 		timeout := uint(restartTimeout)
 		restartOpts.Timeout = &timeout
@@ -125,6 +133,13 @@ func restart(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if restartBulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	for _, r := range responses {
 		switch {
 		case r.Err != nil:
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/containers/buildah/pkg/cli"
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -36,7 +37,10 @@ var (
 	}
 )
 
-var restoreOptions entities.RestoreOptions
+var (
+	restoreOptions        entities.RestoreOptions
+	restoreDecryptionKeys []string
+)
 
 type restoreStatistics struct {
 	PodmanDuration      int64                     `json:"podman_restore_duration"`
@@ -87,6 +91,18 @@ func init() {
 		"Display restore statistics",
 	)
 
+	decryptionKeyFlagName := "decryption-key"
+	flags.StringArrayVar(&restoreDecryptionKeys, decryptionKeyFlagName, nil, "Key needed to decrypt an encrypted checkpoint (e.g. /path/to/key.pem)")
+	_ = restoreCommand.RegisterFlagCompletionFunc(decryptionKeyFlagName, completion.AutocompleteDefault)
+
+	decryptionSecretFlagName := "decryption-secret"
+	flags.StringVar(&restoreOptions.DecryptionSecret, decryptionSecretFlagName, "", "Decrypt an encrypted checkpoint with the passphrase stored in the specified secret")
+	_ = restoreCommand.RegisterFlagCompletionFunc(decryptionSecretFlagName, common.AutocompleteSecrets)
+
+	if registry.IsRemote() {
+		_ = flags.MarkHidden(decryptionKeyFlagName)
+	}
+
 	validate.AddLatestFlag(restoreCommand, &restoreOptions.Latest)
 }
 
@@ -142,6 +158,16 @@ func restore(cmd *cobra.Command, args []string) error {
 	if restoreOptions.Name != "" && restoreOptions.TCPEstablished {
 		return fmt.Errorf("--tcp-established cannot be used with --name")
 	}
+	if notImport && (len(restoreDecryptionKeys) > 0 || restoreOptions.DecryptionSecret != "") {
+		return fmt.Errorf("--decryption-key and --decryption-secret can only be used with image or --import")
+	}
+	if len(restoreDecryptionKeys) > 0 {
+		decConfig, err := cli.DecryptConfig(restoreDecryptionKeys)
+		if err != nil {
+			return fmt.Errorf("unable to obtain decryption config: %w", err)
+		}
+		restoreOptions.OciDecryptConfig = decConfig
+	}
 
 	inputPorts, err := cmd.Flags().GetStringSlice("publish")
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -56,6 +57,7 @@ var (
 		Filters: make(map[string][]string),
 	}
 	rmCidFiles = []string{}
+	rmBulk     common.BulkFlags
 )
 
 func rmFlags(cmd *cobra.Command) {
@@ -78,6 +80,8 @@ func rmFlags(cmd *cobra.Command) {
 	flags.StringArrayVar(&filters, filterFlagName, []string{}, "Filter output based on conditions given")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
 
+	common.DefineBulkFlags(cmd, &rmBulk)
+
 	if !registry.IsRemote() {
 		// This option is deprecated, but needs to still exists for backwards compatibility
 		flags.Bool("storage", false, "Remove container from storage library")
@@ -101,6 +105,10 @@ func init() {
 }
 
 func rm(cmd *cobra.Command, args []string) error {
+	if err := rmBulk.Validate(); err != nil {
+		return err
+	}
+	rmOptions.BulkOptions = rmBulk.Options()
 	if cmd.Flag("time").Changed {
 		if !rmOptions.Force {
 			return errors.New("--force option must be specified to use the --time option")
@@ -136,6 +144,21 @@ func rm(cmd *cobra.Command, args []string) error {
 		rmOptions.Ignore = true
 	}
 
+	if rmBulk.JSON() {
+		responses, err := registry.ContainerEngine().ContainerRm(context.Background(), utils.RemoveSlash(args), rmOptions)
+		if err != nil {
+			setExitCode(err)
+			return err
+		}
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			if r.Err != nil {
+				setExitCode(r.Err)
+			}
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	return removeContainers(utils.RemoveSlash(args), rmOptions, true, false)
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/containers/common/pkg/auth"
 	"github.com/containers/common/pkg/completion"
@@ -49,8 +50,9 @@ var (
 )
 
 var (
-	runOpts entities.ContainerRunOptions
-	runRmi  bool
+	runOpts   entities.ContainerRunOptions
+	runRmi    bool
+	runTiming bool
 )
 
 func runFlags(cmd *cobra.Command) {
@@ -82,6 +84,14 @@ func runFlags(cmd *cobra.Command) {
 	passwdFlagName := "passwd"
 	flags.BoolVar(&runOpts.Passwd, passwdFlagName, true, "add entries to /etc/passwd and /etc/group")
 
+	flags.BoolVar(&runTiming, "timing", false, "Print the durations of the steps of starting the container")
+
+	timingOTLPFileFlagName := "timing-otlp-file"
+	// The timing spans are recorded in the trace of the command, so the
+	// flag sets the file of --trace-otlp-file.
+	flags.StringVar(&registry.PodmanConfig().TraceOTLPFile, timingOTLPFileFlagName, "", "Append the OpenTelemetry trace of the command, including the durations of the steps of starting the container, to `file`, implies --timing")
+	_ = cmd.RegisterFlagCompletionFunc(timingOTLPFileFlagName, completion.AutocompleteDefault)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden(preserveFdsFlagName)
 		_ = flags.MarkHidden(preserveFdFlagName)
@@ -109,6 +119,9 @@ func run(cmd *cobra.Command, args []string) error {
 	if err := commonFlags(cmd); err != nil {
 		return err
 	}
+	if cmd.Flags().Changed("timing-otlp-file") {
+		runTiming = true
+	}
 
 	if runRmi {
 		if cmd.Flags().Changed("rm") && !cliVals.Rm {
@@ -147,13 +160,20 @@ func run(cmd *cobra.Command, args []string) error {
 
 	imageName := args[0]
 	rawImageName := ""
+	var timing []define.TimingSpan
 	if !cliVals.RootFS {
 		rawImageName = args[0]
+		pullStart := time.Now()
 		name, err := pullImage(cmd, args[0], &cliVals)
 		if err != nil {
 			return err
 		}
 		imageName = name
+		timing = append(timing, define.TimingSpan{
+			Name:     timingImageResolution,
+			Start:    pullStart,
+			Duration: time.Since(pullStart),
+		})
 	}
 
 	if cliVals.Replace {
@@ -214,6 +234,9 @@ func run(cmd *cobra.Command, args []string) error {
 	if runRmi {
 		s.RemoveImage = &runRmi
 	}
+	if runTiming {
+		s.Timing = &runTiming
+	}
 
 	runOpts.Spec = s
 
@@ -237,6 +260,12 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if runTiming {
+		timing = append(timing, report.Timing...)
+		printTiming(timing)
+		recordTiming(registry.GetContext(), timing)
+	}
+
 	if runOpts.Detach {
 		if !passthrough {
 			fmt.Println(report.Id)
@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/spf13/cobra"
 )
 
@@ -44,6 +45,7 @@ var (
 	startOptions = entities.ContainerStartOptions{
 		Filters: make(map[string][]string),
 	}
+	startBulk common.BulkFlags
 )
 
 func startFlags(cmd *cobra.Command) {
@@ -64,6 +66,8 @@ func startFlags(cmd *cobra.Command) {
 
 	flags.BoolVar(&startOptions.All, "all", false, "Start all containers regardless of their state or configuration")
 
+	common.DefineBulkFlags(cmd, &startBulk)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("sig-proxy")
 	}
@@ -102,7 +106,10 @@ func validateStart(cmd *cobra.Command, args []string) error {
 	if startOptions.Attach && startOptions.All {
 		return errors.New("you cannot start and attach all containers at once")
 	}
-	return nil
+	if startOptions.Attach && (cmd.Flag("parallel").Changed || startBulk.FailFast || startBulk.Format != "") {
+		return errors.New("--parallel, --fail-fast and --format cannot be used with --attach")
+	}
+	return startBulk.Validate()
 }
 
 func start(cmd *cobra.Command, args []string) error {
@@ -112,6 +119,7 @@ func start(cmd *cobra.Command, args []string) error {
 		sigProxy = startOptions.SigProxy
 	}
 	startOptions.SigProxy = sigProxy
+	startOptions.BulkOptions = startBulk.Options()
 
 	if sigProxy && !startOptions.Attach {
 		return fmt.Errorf("you cannot use sig-proxy without --attach: %w", define.ErrInvalidArg)
@@ -135,6 +143,13 @@ func start(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if startBulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	for _, r := range responses {
 		switch {
 		case r.Err != nil:
@@ -13,6 +13,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/spf13/cobra"
 )
 
@@ -53,6 +54,7 @@ var (
 	}
 	stopCidFiles = []string{}
 	stopTimeout  int
+	stopBulk     common.BulkFlags
 )
 
 func stopFlags(cmd *cobra.Command) {
@@ -73,6 +75,8 @@ func stopFlags(cmd *cobra.Command) {
 	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
 
+	common.DefineBulkFlags(cmd, &stopBulk)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("cidfile")
 		_ = flags.MarkHidden("ignore")
@@ -102,6 +106,10 @@ func stop(cmd *cobra.Command, args []string) error {
 	)
 	args = utils.RemoveSlash(args)
 
+	if err := stopBulk.Validate(); err != nil {
+		return err
+	}
+	stopOptions.BulkOptions = stopBulk.Options()
 	if cmd.Flag("time").Changed {
 		timeout := uint(stopTimeout)
 		stopOptions.Timeout = &timeout
@@ -130,6 +138,13 @@ func stop(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if stopBulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	for _, r := range responses {
 		switch {
 		case r.Err != nil:
@@ -0,0 +1,41 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/tracing"
+)
+
+// timingImageResolution is the name of the timing span of resolving and, if
+// necessary, pulling the image, which is measured by the client.
+const timingImageResolution = "image-resolution"
+
+// printTiming prints the timing spans of starting a container to stderr.
+func printTiming(spans []define.TimingSpan) {
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "STEP\tDETAIL\tDURATION")
+	var total time.Duration
+	for _, span := range spans {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", span.Name, span.Detail, span.Duration.Round(time.Microsecond))
+		total += span.Duration
+	}
+	fmt.Fprintf(w, "total\t\t%s\n", total.Round(time.Microsecond))
+	_ = w.Flush()
+}
+
+// recordTiming records the timing spans of starting a container as spans of
+// the trace of the command, if tracing is enabled.
+func recordTiming(ctx context.Context, spans []define.TimingSpan) {
+	for _, span := range spans {
+		var attributes map[string]string
+		if span.Detail != "" {
+			attributes = map[string]string{"detail": span.Detail}
+		}
+		tracing.Record(ctx, span.Name, span.Start, span.Start.Add(span.Duration), attributes)
+	}
+}
@@ -6,19 +6,58 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/containers/common/pkg/completion"
 	"github.com/containers/common/pkg/report"
 	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/spf13/cobra"
 )
 
+// AddContentFlags adds the flags to compare the content of the files of a
+// container with its image.  They are not supported by the remote client.
+func AddContentFlags(cmd *cobra.Command, options *entities.DiffOptions) {
+	if registry.IsRemote() {
+		return
+	}
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.Content, "content", false, "Show the content changes of the files of the container compared to its image")
+
+	exportFlagName := "export"
+	flags.StringVar(&options.Export, exportFlagName, "", "Write the changed files of the container to a tar archive")
+	_ = cmd.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
+}
+
 func Diff(_ *cobra.Command, args []string, options entities.DiffOptions) error {
+	if options.Content || options.Export != "" {
+		// All arguments after the container are paths.
+		if options.Latest {
+			options.Paths = args
+			args = nil
+		} else {
+			options.Paths = args[1:]
+			args = args[:1]
+		}
+	}
+
 	results, err := registry.ContainerEngine().Diff(registry.GetContext(), args, options)
 	if err != nil {
 		return err
 	}
 
+	if options.Content {
+		switch {
+		case report.IsJSON(options.Format):
+			return filesToJSON(results)
+		case options.Format == "":
+			return filesToText(results)
+		default:
+			return errors.New("only supported value for '--format' is 'json'")
+		}
+	}
+
 	switch {
 	case report.IsJSON(options.Format):
 		return changesToJSON(results)
@@ -63,9 +102,60 @@ func changesToTable(diffs *entities.DiffReport) error {
 	return nil
 }
 
+type fileDiffJSON struct {
+	Kind string `json:"kind"`
+	define.FileDiff
+}
+
+func filesToJSON(diffs *entities.DiffReport) error {
+	body := make([]fileDiffJSON, 0, len(diffs.Files))
+	for _, file := range diffs.Files {
+		var kind string
+		switch file.Kind {
+		case archive.ChangeAdd:
+			kind = "added"
+		case archive.ChangeDelete:
+			kind = "deleted"
+		case archive.ChangeModify:
+			kind = "changed"
+		default:
+			return fmt.Errorf("output kind %q not recognized", file.Kind)
+		}
+		body = append(body, fileDiffJSON{Kind: kind, FileDiff: file})
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "     ")
+	return enc.Encode(body)
+}
+
+func filesToText(diffs *entities.DiffReport) error {
+	for _, file := range diffs.Files {
+		if !file.Binary {
+			fmt.Fprint(os.Stdout, file.Diff)
+			continue
+		}
+		switch file.Kind {
+		case archive.ChangeAdd:
+			fmt.Fprintf(os.Stdout, "Binary file %s added: %d bytes, %s\n", file.Path, file.NewSize, file.NewDigest)
+		case archive.ChangeDelete:
+			fmt.Fprintf(os.Stdout, "Binary file %s deleted: %d bytes, %s\n", file.Path, file.OldSize, file.OldDigest)
+		default:
+			fmt.Fprintf(os.Stdout, "Binary file %s changed: %d -> %d bytes, %s -> %s\n", file.Path, file.OldSize, file.NewSize, file.OldDigest, file.NewDigest)
+		}
+	}
+	return nil
+}
+
 // ValidateContainerDiffArgs used to validate a nameOrId was provided or the "--latest" flag
 func ValidateContainerDiffArgs(cmd *cobra.Command, args []string) error {
 	given, _ := cmd.Flags().GetBool("latest")
+	content, _ := cmd.Flags().GetBool("content")
+	export, _ := cmd.Flags().GetString("export")
+	if (content || export != "") && (len(args) > 0 || given) {
+		// The arguments are paths after the container, or with --latest.
+		return nil
+	}
 	if len(args) > 0 && !given {
 		return cobra.RangeArgs(1, 2)(cmd, args)
 	}
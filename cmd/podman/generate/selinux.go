@@ -0,0 +1,88 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/selinux"
+	"github.com/spf13/cobra"
+)
+
+var (
+	selinuxDescription = `Generate an SELinux policy module in CIL format for a container.
+
+  The policy allows the bind mounts, published ports, devices and capabilities of the container and inherits from the udica container templates.`
+
+	selinuxCmd = &cobra.Command{
+		Use:               "selinux [options] CONTAINER",
+		Short:             "Generate an SELinux policy for a container",
+		Long:              selinuxDescription,
+		RunE:              generateSELinux,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainers,
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Example: `podman generate selinux ctrID
+  podman generate selinux --name mypolicy --filename mypolicy.cil ctrID`,
+	}
+)
+
+var selinuxOptions = struct {
+	Name     string
+	FileName string
+}{}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: selinuxCmd,
+		Parent:  GenerateCmd,
+	})
+	flags := selinuxCmd.Flags()
+
+	nameFlagName := "name"
+	flags.StringVarP(&selinuxOptions.Name, nameFlagName, "n", "", "Name of the policy (default: name of the container)")
+	_ = selinuxCmd.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+
+	filenameFlagName := "filename"
+	flags.StringVarP(&selinuxOptions.FileName, filenameFlagName, "f", "", "Write the policy to the specified path")
+	_ = selinuxCmd.RegisterFlagCompletionFunc(filenameFlagName, completion.AutocompleteDefault)
+}
+
+func generateSELinux(cmd *cobra.Command, args []string) error {
+	data, errs, err := registry.ContainerEngine().ContainerInspect(registry.Context(), args, entities.InspectOptions{})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	ctr := data[0].InspectContainerData
+
+	name := selinuxOptions.Name
+	if name == "" {
+		name = ctr.Name
+	}
+	policy, err := selinux.GeneratePolicy(selinux.PolicyName(name), ctr)
+	if err != nil {
+		return err
+	}
+
+	policyFile := policy.Name + ".cil"
+	if selinuxOptions.FileName != "" {
+		policyFile = selinuxOptions.FileName
+		if err := os.WriteFile(policyFile, []byte(policy.CIL()), 0o644); err != nil {
+			return err
+		}
+		fmt.Println(policyFile)
+	} else {
+		fmt.Print(policy.CIL())
+	}
+
+	fmt.Fprintf(os.Stderr, "\nInstall the policy with:\n  semodule -i %s %s\n", policyFile, strings.Join(policy.TemplateFiles(), " "))
+	fmt.Fprintf(os.Stderr, "Run the container with:\n  --security-opt label=type:%s\n", policy.ProcessType())
+	return nil
+}
@@ -2,6 +2,7 @@ package images
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 
@@ -53,6 +54,8 @@ var (
 	}
 
 	buildOpts = common.BuildFlagsWrapper{}
+	// buildAsync runs the build as a job of the service, remote only
+	buildAsync bool
 )
 
 func init() {
@@ -75,14 +78,23 @@ func init() {
 
 func buildFlags(cmd *cobra.Command) {
 	common.DefineBuildFlags(cmd, &buildOpts, false)
+
+	if registry.IsRemote() {
+		asyncFlagName := "async"
+		cmd.Flags().BoolVar(&buildAsync, asyncFlagName, false, "Build as a job of the service and print the job ID instead of waiting")
+	}
 }
 
 // build executes the build command.
 func build(cmd *cobra.Command, args []string) error {
+	if buildAsync && cmd.Flag("iidfile").Changed {
+		return errors.New("--iidfile cannot be used with --async")
+	}
 	apiBuildOpts, err := common.ParseBuildOpts(cmd, args, &buildOpts)
 	if err != nil {
 		return err
 	}
+	apiBuildOpts.Async = buildAsync
 	// Close the logFile if one was created based on the flag
 	if apiBuildOpts.LogFileToClose != nil {
 		defer apiBuildOpts.LogFileToClose.Close()
@@ -118,6 +130,11 @@ func build(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if report.JobID != "" {
+		fmt.Println(report.JobID)
+		return nil
+	}
+
 	if cmd.Flag("iidfile").Changed {
 		f, err := os.Create(buildOpts.Iidfile)
 		if err != nil {
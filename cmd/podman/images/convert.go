@@ -0,0 +1,94 @@
+package images
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	convertDescription = `Rewrites the layers and the manifest of a local image with another compression or manifest type.
+
+  The converted image is stored locally, without the need to push it to a registry.  Without --tag, the converted image takes over the name of the image.  Converting an image whose converted image keeps its ID requires --in-place.`
+	convertCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "convert [options] IMAGE",
+		Short:             "Convert the compression or manifest type of a local image",
+		Long:              convertDescription,
+		RunE:              convert,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteImages,
+		Example: `podman image convert --in-place --compression-format zstd:chunked --format oci myimage
+  podman image convert --in-place --compression-format gzip fedora:latest`,
+	}
+
+	convertOptions entities.ImageConvertOptions
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: convertCommand,
+		Parent:  imageCmd,
+	})
+	flags := convertCommand.Flags()
+
+	tagFlagName := "tag"
+	flags.StringArrayVarP(&convertOptions.Tags, tagFlagName, "t", nil, "Name of the converted image")
+	_ = convertCommand.RegisterFlagCompletionFunc(tagFlagName, completion.AutocompleteNone)
+
+	formatFlagName := "format"
+	flags.StringVarP(&convertOptions.Format, formatFlagName, "f", "", "Manifest type (oci or v2s2) of the converted image (default is manifest type of the image)")
+	_ = convertCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteManifestFormat)
+
+	compFormat := "compression-format"
+	flags.StringVar(&convertOptions.CompressionFormat, compFormat, compressionFormat(), "compression format to use")
+	_ = convertCommand.RegisterFlagCompletionFunc(compFormat, common.AutocompleteCompressionFormat)
+
+	compLevel := "compression-level"
+	flags.Int(compLevel, compressionLevel(), "compression level to use")
+	_ = convertCommand.RegisterFlagCompletionFunc(compLevel, completion.AutocompleteNone)
+
+	flags.BoolVar(&convertOptions.InPlace, "in-place", false, "Convert the image in place if the converted image keeps its ID")
+	flags.BoolVarP(&convertOptions.Quiet, "quiet", "q", false, "Suppress output information when converting images")
+
+	signaturePolicyFlagName := "signature-policy"
+	flags.StringVar(&convertOptions.SignaturePolicy, signaturePolicyFlagName, "", "Path to a signature-policy file")
+	_ = flags.MarkHidden(signaturePolicyFlagName)
+}
+
+func convert(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("compression-level") {
+		val, err := cmd.Flags().GetInt("compression-level")
+		if err != nil {
+			return err
+		}
+		convertOptions.CompressionLevel = &val
+	}
+
+	report, err := registry.ImageEngine().Convert(registry.Context(), args[0], convertOptions)
+	if err != nil {
+		return err
+	}
+	if convertOptions.Quiet {
+		fmt.Println(report.ID)
+		return nil
+	}
+
+	fmt.Printf("ID:     %s -> %s\n", report.SourceID[:12], report.ID[:12])
+	fmt.Printf("Format: %s -> %s\n", report.SourceFormat, report.Format)
+	fmt.Printf("Size:   %s -> %s (%s)\n", units.HumanSize(float64(report.SourceSize)), units.HumanSize(float64(report.Size)), sizeChange(report.SourceSize, report.Size))
+	return nil
+}
+
+// sizeChange returns the relative change from one size to another.
+func sizeChange(from, to int64) string {
+	if from == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.1f%%", float64(to-from)/float64(from)*100)
+}
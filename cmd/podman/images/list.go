@@ -379,6 +379,24 @@ func (i imageReporter) CreatedTime() string {
 	return i.CreatedAt()
 }
 
+func (i imageReporter) LastUsed() string {
+	if i.ImageSummary.LastUsed == 0 {
+		return "never"
+	}
+	return units.HumanDuration(time.Since(i.lastUsed())) + " ago"
+}
+
+func (i imageReporter) LastUsedAt() string {
+	if i.ImageSummary.LastUsed == 0 {
+		return ""
+	}
+	return i.lastUsed().String()
+}
+
+func (i imageReporter) lastUsed() time.Time {
+	return time.Unix(i.ImageSummary.LastUsed, 0).UTC()
+}
+
 func (i imageReporter) size() int64 {
 	return i.ImageSummary.Size
 }
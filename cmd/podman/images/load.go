@@ -10,9 +10,11 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/common/pkg/download"
+	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage/pkg/fileutils"
 	"github.com/spf13/cobra"
@@ -64,6 +66,11 @@ func loadFlags(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc(inputFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVarP(&loadOpts.Quiet, "quiet", "q", false, "Suppress the output")
+
+	progressFlagName := "progress"
+	flags.StringVar(&loadOpts.ProgressFormat, progressFlagName, progress.FormatText, "Format of the progress output: text or json")
+	_ = cmd.RegisterFlagCompletionFunc(progressFlagName, common.AutocompleteProgressFormat)
+
 	if !registry.IsRemote() {
 		flags.StringVar(&loadOpts.SignaturePolicy, "signature-policy", "", "Pathname of signature policy file")
 		_ = flags.MarkHidden("signature-policy")
@@ -71,6 +78,10 @@ func loadFlags(cmd *cobra.Command) {
 }
 
 func load(cmd *cobra.Command, args []string) error {
+	if err := progress.ValidateFormat(loadOpts.ProgressFormat); err != nil {
+		return err
+	}
+
 	if len(loadOpts.Input) > 0 {
 		// Download the input file if needed.
 		if strings.HasPrefix(loadOpts.Input, "https://") || strings.HasPrefix(loadOpts.Input, "http://") {
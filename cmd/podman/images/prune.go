@@ -52,7 +52,7 @@ func init() {
 
 	filterFlagName := "filter"
 	flags.StringArrayVar(&filter, filterFlagName, []string{}, "Provide filter values (e.g. 'label=<key>=<value>')")
-	_ = pruneCmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePruneFilters)
+	_ = pruneCmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteImagePruneFilters)
 }
 
 func prune(cmd *cobra.Command, args []string) error {
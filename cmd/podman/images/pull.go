@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/containers/buildah/pkg/cli"
 	"github.com/containers/common/pkg/auth"
@@ -14,6 +15,9 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	"github.com/containers/podman/v5/pkg/parallel"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/spf13/cobra"
 )
@@ -25,6 +29,7 @@ type pullOptionsWrapper struct {
 	TLSVerifyCLI   bool // CLI only
 	CredentialsCLI string
 	DecryptionKeys []string
+	Bulk           common.BulkFlags
 }
 
 var (
@@ -103,6 +108,11 @@ func pullFlags(cmd *cobra.Command) {
 
 	flags.Bool("disable-content-trust", false, "This is a Docker specific option and is a NOOP")
 	flags.BoolVarP(&pullOptions.Quiet, "quiet", "q", false, "Suppress output information when pulling images")
+
+	progressFlagName := "progress"
+	flags.StringVar(&pullOptions.ProgressFormat, progressFlagName, progress.FormatText, "Format of the progress output: text or json")
+	_ = cmd.RegisterFlagCompletionFunc(progressFlagName, common.AutocompleteProgressFormat)
+
 	flags.BoolVar(&pullOptions.TLSVerifyCLI, "tls-verify", true, "Require HTTPS and verify certificates when contacting registries")
 
 	authfileFlagName := "authfile"
@@ -120,8 +130,13 @@ func pullFlags(cmd *cobra.Command) {
 	flags.String(retryDelayFlagName, registry.RetryDelayDefault(), "delay between retries in case of pull failures")
 	_ = cmd.RegisterFlagCompletionFunc(retryDelayFlagName, completion.AutocompleteNone)
 
+	common.DefineBulkFlags(cmd, &pullOptions.Bulk)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden(decryptionKeysFlagName)
+
+		asyncFlagName := "async"
+		flags.BoolVar(&pullOptions.Async, asyncFlagName, false, "Pull as a job of the service and print the job ID instead of waiting")
 	} else {
 		certDirFlagName := "cert-dir"
 		flags.StringVar(&pullOptions.CertDir, certDirFlagName, "", "`Pathname` of a directory containing TLS certificates and keys")
@@ -135,6 +150,13 @@ func pullFlags(cmd *cobra.Command) {
 
 // imagePull is implement the command for pulling images.
 func imagePull(cmd *cobra.Command, args []string) error {
+	if err := progress.ValidateFormat(pullOptions.ProgressFormat); err != nil {
+		return err
+	}
+	if err := pullOptions.Bulk.Validate(); err != nil {
+		return err
+	}
+
 	// TLS verification in c/image is controlled via a `types.OptionalBool`
 	// which allows for distinguishing among set-true, set-false, unspecified
 	// which is important to implement a sane way of dealing with defaults of
@@ -200,21 +222,63 @@ func imagePull(cmd *cobra.Command, args []string) error {
 	}
 	pullOptions.OciDecryptConfig = decConfig
 
-	if !pullOptions.Quiet {
+	// The progress of images pulled in parallel would be interleaved.
+	bulkOptions := pullOptions.Bulk.Options().SerialByDefault()
+	if !pullOptions.Quiet && bulkOptions.Parallel == 1 {
 		pullOptions.Writer = os.Stderr
 	}
 
 	// Let's do all the remaining Yoga in the API to prevent us from
 	// scattering logic across (too) many parts of the code.
+	var printLock sync.Mutex
+	pullReports := make([]*entities.ImagePullReport, len(args))
+	// The remote pulls are requests to the service, which queues them.
+	run := parallel.Run
+	if registry.IsRemote() {
+		run = parallel.RunUnqueued
+	}
+	pullErrs := run(registry.GetContext(), len(args), bulkOptions, func(i int) error {
+		pullReport, err := registry.ImageEngine().Pull(registry.GetContext(), args[i], pullOptions.ImagePullOptions)
+		if err != nil {
+			return err
+		}
+		pullReports[i] = pullReport
+		if !pullOptions.Bulk.JSON() {
+			printLock.Lock()
+			defer printLock.Unlock()
+			if pullReport.JobID != "" {
+				fmt.Println(pullReport.JobID)
+			}
+			for _, img := range pullReport.Images {
+				fmt.Println(img)
+			}
+		}
+		return nil
+	})
+
+	if pullOptions.Bulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(args))
+		for i, arg := range args {
+			if pullErrs[i] != nil {
+				results = append(results, reports.NewBulkResult("", arg, pullErrs[i]))
+				continue
+			}
+			if pullReports[i].JobID != "" {
+				results = append(results, reports.NewBulkResult(pullReports[i].JobID, arg, nil))
+				continue
+			}
+			// One result per image pulled, e.g. with --all-tags
+			for _, img := range pullReports[i].Images {
+				results = append(results, reports.NewBulkResult(img, arg, nil))
+			}
+		}
+		return common.PrintBulkResults(results)
+	}
+
 	var errs utils.OutputErrors
-	for _, arg := range args {
-		pullReport, err := registry.ImageEngine().Pull(registry.GetContext(), arg, pullOptions.ImagePullOptions)
+	for _, err := range pullErrs {
 		if err != nil {
 			errs = append(errs, err)
-			continue
-		}
-		for _, img := range pullReport.Images {
-			fmt.Println(img)
 		}
 	}
 	return errs.PrintErrors()
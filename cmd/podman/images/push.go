@@ -1,6 +1,7 @@
 package images
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/spf13/cobra"
 )
@@ -109,6 +111,11 @@ func pushFlags(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteManifestFormat)
 
 	flags.BoolVarP(&pushOptions.Quiet, "quiet", "q", false, "Suppress output information when pushing images")
+
+	progressFlagName := "progress"
+	flags.StringVar(&pushOptions.ProgressFormat, progressFlagName, progress.FormatText, "Format of the progress output: text or json")
+	_ = cmd.RegisterFlagCompletionFunc(progressFlagName, common.AutocompleteProgressFormat)
+
 	flags.BoolVar(&pushOptions.RemoveSignatures, "remove-signatures", false, "Discard any pre-existing signatures in the image")
 
 	retryFlagName := "retry"
@@ -162,6 +169,9 @@ func pushFlags(cmd *cobra.Command) {
 		_ = flags.MarkHidden(signPassphraseFileFlagName)
 		_ = flags.MarkHidden(encryptionKeysFlagName)
 		_ = flags.MarkHidden(encryptLayersFlagName)
+
+		asyncFlagName := "async"
+		flags.BoolVar(&pushOptions.Async, asyncFlagName, false, "Push as a job of the service and print the job ID instead of waiting")
 	} else {
 		signaturePolicyFlagName := "signature-policy"
 		flags.StringVar(&pushOptions.SignaturePolicy, signaturePolicyFlagName, "", "Path to a signature-policy file")
@@ -174,6 +184,10 @@ func imagePush(cmd *cobra.Command, args []string) error {
 	source := args[0]
 	destination := args[len(args)-1]
 
+	if err := progress.ValidateFormat(pushOptions.ProgressFormat); err != nil {
+		return err
+	}
+
 	// TLS verification in c/image is controlled via a `types.OptionalBool`
 	// which allows for distinguishing among set-true, set-false, unspecified
 	// which is important to implement a sane way of dealing with defaults of
@@ -249,6 +263,10 @@ func imagePush(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if pushOptions.Async && pushOptions.DigestFile != "" {
+		return errors.New("--digestfile cannot be used with --async")
+	}
+
 	// Let's do all the remaining Yoga in the API to prevent us from scattering
 	// logic across (too) many parts of the code.
 	report, err := registry.ImageEngine().Push(registry.GetContext(), source, destination, pushOptions.ImagePushOptions)
@@ -256,6 +274,11 @@ func imagePush(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if report.JobID != "" {
+		fmt.Println(report.JobID)
+		return nil
+	}
+
 	if pushOptions.DigestFile != "" {
 		if err := os.WriteFile(pushOptions.DigestFile, []byte(report.ManifestDigest), 0o644); err != nil {
 			return err
@@ -46,7 +46,8 @@ var (
 		ValidArgsFunction: common.AutocompleteImages,
 		Example: `podman save --quiet -o myimage.tar imageID
   podman save --format docker-dir -o ubuntu-dir ubuntu
-  podman save > alpine-all.tar alpine:latest`,
+  podman save > alpine-all.tar alpine:latest
+  podman save --all-platforms --format oci-archive -o release.tar quay.io/myorg/mylist:1.0`,
 	}
 
 	imageSaveCommand = &cobra.Command{
@@ -82,6 +83,8 @@ func init() {
 func saveFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 
+	flags.BoolVar(&saveOpts.AllPlatforms, "all-platforms", false, "Save all instances of a manifest list as an OCI image index (only for oci-archive and oci-dir)")
+
 	flags.BoolVar(&saveOpts.Compress, "compress", false, "Compress tarball image layers when saving to a directory using the 'dir' transport. (default is same compression type as source)")
 
 	flags.BoolVar(&saveOpts.OciAcceptUncompressedLayers, "uncompressed", false, "Accept uncompressed layers when copying OCI images")
@@ -97,7 +100,10 @@ func saveFlags(cmd *cobra.Command) {
 	flags.BoolVarP(&saveOpts.Quiet, "quiet", "q", false, "Suppress the output")
 	flags.BoolVarP(&saveOpts.MultiImageArchive, "multi-image-archive", "m", containerConfig.ContainersConfDefaultsRO.Engine.MultiImageArchive, "Interpret additional arguments as images not tags and create a multi-image-archive (only for docker-archive)")
 
-	if !registry.IsRemote() {
+	if registry.IsRemote() {
+		asyncFlagName := "async"
+		flags.BoolVar(&saveOpts.Async, asyncFlagName, false, "Save as a job of the service and print the job ID instead of waiting")
+	} else {
 		flags.StringVar(&saveOpts.SignaturePolicy, "signature-policy", "", "Path to a signature-policy file")
 		_ = flags.MarkHidden("signature-policy")
 	}
@@ -111,7 +117,23 @@ func save(cmd *cobra.Command, args []string) (finalErr error) {
 	if cmd.Flag("compress").Changed && saveOpts.Format != define.V2s2ManifestDir {
 		return errors.New("--compress can only be set when --format is 'docker-dir'")
 	}
-	if len(saveOpts.Output) == 0 {
+	if saveOpts.AllPlatforms {
+		if saveOpts.Format != define.OCIArchive && saveOpts.Format != define.OCIManifestDir {
+			return errors.New("--all-platforms can only be set when --format is 'oci-archive' or 'oci-dir'")
+		}
+		if len(args) > 1 {
+			return errors.New("--all-platforms does not support saving multiple images")
+		}
+	}
+	if saveOpts.Async {
+		// The archive is the output of the job, see podman job logs
+		if len(saveOpts.Output) > 0 {
+			return errors.New("--output cannot be used with --async")
+		}
+		if saveOpts.Format == define.OCIManifestDir || saveOpts.Format == define.V2s2ManifestDir {
+			return errors.New("--async can only be used with --format 'docker-archive' or 'oci-archive'")
+		}
+	} else if len(saveOpts.Output) == 0 {
 		saveOpts.Quiet = true
 		fi := os.Stdout
 		if term.IsTerminal(int(fi.Fd())) {
@@ -141,9 +163,13 @@ func save(cmd *cobra.Command, args []string) (finalErr error) {
 		tags = args[1:]
 	}
 
-	err := registry.ImageEngine().Save(context.Background(), args[0], tags, saveOpts)
-	if err == nil {
-		succeeded = true
+	report, err := registry.ImageEngine().Save(context.Background(), args[0], tags, saveOpts)
+	if err != nil {
+		return err
+	}
+	succeeded = true
+	if report.JobID != "" {
+		fmt.Println(report.JobID)
 	}
-	return err
+	return nil
 }
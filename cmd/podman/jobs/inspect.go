@@ -0,0 +1,81 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:               "inspect [options] JOB [JOB...]",
+		Short:             "Inspect a job",
+		Long:              "Display the status of one or more jobs",
+		RunE:              inspect,
+		Example:           "podman --remote job inspect 3f5c2b7a",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompleteJobs,
+	}
+)
+
+var format string
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: inspectCmd,
+		Parent:  jobCmd,
+	})
+	flags := inspectCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVarP(&format, formatFlagName, "f", "", "Format inspect output using Go template")
+	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.JobReport{}))
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	var errs []error
+	inspected := make([]*entities.JobReport, 0, len(args))
+	for _, id := range args {
+		job, err := registry.ContainerEngine().JobInspect(context.Background(), id)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		inspected = append(inspected, job)
+	}
+
+	if cmd.Flags().Changed("format") {
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		rpt, err := rpt.Parse(report.OriginUser, format)
+		if err != nil {
+			return err
+		}
+		if err := rpt.Execute(inspected); err != nil {
+			return err
+		}
+	} else {
+		buf, err := json.MarshalIndent(inspected, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	}
+
+	if len(errs) > 0 {
+		if len(errs) > 1 {
+			for _, err := range errs[1:] {
+				fmt.Fprintf(os.Stderr, "error inspecting job: %v\n", err)
+			}
+		}
+		return fmt.Errorf("inspecting job: %w", errs[0])
+	}
+	return nil
+}
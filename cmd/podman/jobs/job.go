@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman _job_
+	jobCmd = &cobra.Command{
+		Use:   "job",
+		Short: "Manage asynchronous jobs",
+		Long:  "Manage the asynchronous jobs of a Podman service started with --async",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: jobCmd,
+	})
+}
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lsCmd = &cobra.Command{
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Short:             "List jobs",
+		RunE:              ls,
+		Example:           "podman --remote job ls",
+		Args:              validate.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+	listFlag = listFlagType{}
+)
+
+type listFlagType struct {
+	format    string
+	noHeading bool
+	quiet     bool
+}
+
+type jobReporter struct {
+	*entities.JobReport
+}
+
+func (j jobReporter) Created() string {
+	return units.HumanDuration(time.Since(j.JobReport.Created)) + " ago"
+}
+
+func (j jobReporter) Finished() string {
+	if j.JobReport.Finished.IsZero() {
+		return ""
+	}
+	return units.HumanDuration(time.Since(j.JobReport.Finished)) + " ago"
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: lsCmd,
+		Parent:  jobCmd,
+	})
+
+	flags := lsCmd.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&listFlag.format, formatFlagName, "{{range .}}{{.ID}}\t{{.Operation}}\t{{.Status}}\t{{.Created}}\t{{.Finished}}\n{{end -}}", "Format job output using Go template")
+	_ = lsCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&jobReporter{}))
+
+	noHeadingFlagName := "noheading"
+	flags.BoolVarP(&listFlag.noHeading, noHeadingFlagName, "n", false, "Do not print headers")
+
+	quietFlagName := "quiet"
+	flags.BoolVarP(&listFlag.quiet, quietFlagName, "q", false, "Print job IDs only")
+}
+
+func ls(cmd *cobra.Command, args []string) error {
+	responses, err := registry.ContainerEngine().JobList(context.Background())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case report.IsJSON(listFlag.format):
+		buf, err := json.MarshalIndent(responses, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+		return nil
+	case listFlag.quiet && !cmd.Flags().Changed("format"):
+		for _, response := range responses {
+			fmt.Println(response.ID)
+		}
+		return nil
+	}
+
+	listed := make([]jobReporter, 0, len(responses))
+	for _, response := range responses {
+		listed = append(listed, jobReporter{response})
+	}
+
+	headers := report.Headers(entities.JobReport{}, nil)
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	switch {
+	case cmd.Flag("format").Changed:
+		rpt, err = rpt.Parse(report.OriginUser, listFlag.format)
+	default:
+		rpt, err = rpt.Parse(report.OriginPodman, listFlag.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !listFlag.noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(listed)
+}
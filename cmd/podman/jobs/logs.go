@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"os"
+
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logsCmd = &cobra.Command{
+		Use:               "logs [options] JOB",
+		Short:             "Display the output of a job",
+		Long:              "Display the output of a job, which is the response the operation would have returned if it was not run asynchronously",
+		RunE:              logs,
+		Example:           "podman --remote job logs --follow 3f5c2b7a",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteJobs,
+	}
+)
+
+var logsOpts = entities.JobLogsOptions{}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: logsCmd,
+		Parent:  jobCmd,
+	})
+	flags := logsCmd.Flags()
+	flags.BoolVarP(&logsOpts.Follow, "follow", "f", false, "Follow the output until the job finishes")
+}
+
+func logs(cmd *cobra.Command, args []string) error {
+	logsOpts.Writer = os.Stdout
+	return registry.ContainerEngine().JobLogs(context.Background(), args[0], logsOpts)
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rmCmd = &cobra.Command{
+		Use:               "rm JOB [JOB...]",
+		Short:             "Remove one or more finished jobs",
+		RunE:              rm,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompleteJobs,
+		Example:           "podman --remote job rm 3f5c2b7a",
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: rmCmd,
+		Parent:  jobCmd,
+	})
+}
+
+func rm(cmd *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	responses, err := registry.ContainerEngine().JobRm(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	for _, r := range responses {
+		if r.Err == nil {
+			fmt.Println(r.ID)
+		} else {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errs.PrintErrors()
+}
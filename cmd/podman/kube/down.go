@@ -9,7 +9,8 @@ import (
 )
 
 type downKubeOptions struct {
-	Force bool
+	Force     bool
+	Kustomize bool
 }
 
 var (
@@ -18,7 +19,7 @@ var (
   Removes pods that have been based on the Kubernetes kind described in the YAML.`
 
 	downCmd = &cobra.Command{
-		Use:               "down [options] KUBEFILE|-",
+		Use:               "down [options] KUBEFILE|-|KUSTOMIZATION",
 		Short:             "Remove pods based on Kubernetes YAML",
 		Long:              downDescription,
 		RunE:              down,
@@ -26,7 +27,8 @@ var (
 		ValidArgsFunction: common.AutocompleteDefaultOneArg,
 		Example: `podman kube down nginx.yml
    cat nginx.yml | podman kube down -
-   podman kube down https://example.com/nginx.yml`,
+   podman kube down https://example.com/nginx.yml
+   podman kube down --kustomize ./overlays/production`,
 	}
 
 	downOptions = downKubeOptions{}
@@ -45,10 +47,11 @@ func downFlags(cmd *cobra.Command) {
 	flags.SetNormalizeFunc(utils.AliasFlags)
 
 	flags.BoolVar(&downOptions.Force, "force", false, "remove volumes")
+	flags.BoolVarP(&downOptions.Kustomize, "kustomize", "k", false, "Render the kustomization in the given directory and remove its pods")
 }
 
 func down(cmd *cobra.Command, args []string) error {
-	reader, err := readerFromArg(args[0])
+	reader, err := readerFromArg(args[0], downOptions.Kustomize)
 	if err != nil {
 		return err
 	}
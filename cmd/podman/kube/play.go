@@ -25,6 +25,7 @@ import (
 	"github.com/containers/podman/v5/pkg/annotations"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/kustomize"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/spf13/cobra"
 )
@@ -40,6 +41,7 @@ type playKubeOptionsWrapper struct {
 	BuildCLI       bool
 	annotations    []string
 	macs           []string
+	kustomize      bool
 }
 
 var (
@@ -51,7 +53,7 @@ var (
   Creates pods or volumes based on the Kubernetes kind described in the YAML. Supported kinds are Pods, Deployments, DaemonSets, Jobs, and PersistentVolumeClaims.`
 
 	playCmd = &cobra.Command{
-		Use:               "play [options] KUBEFILE|-",
+		Use:               "play [options] KUBEFILE|-|KUSTOMIZATION",
 		Short:             "Play a pod or volume based on Kubernetes YAML",
 		Long:              playDescription,
 		RunE:              play,
@@ -60,13 +62,14 @@ var (
 		Example: `podman kube play nginx.yml
   cat nginx.yml | podman kube play -
   podman kube play --creds user:password --seccomp-profile-root /custom/path apache.yml
-  podman kube play https://example.com/nginx.yml`,
+  podman kube play https://example.com/nginx.yml
+  podman kube play --kustomize ./overlays/production`,
 	}
 )
 
 var (
 	playKubeCmd = &cobra.Command{
-		Use:               "kube [options] KUBEFILE|-",
+		Use:               "kube [options] KUBEFILE|-|KUSTOMIZATION",
 		Short:             "Play a pod or volume based on Kubernetes YAML",
 		Long:              playDescription,
 		Hidden:            true,
@@ -110,6 +113,8 @@ func playFlags(cmd *cobra.Command) {
 	flags.StringVar(&playOptions.CredentialsCLI, credsFlagName, "", "`Credentials` (USERNAME:PASSWORD) to use for authenticating to a registry")
 	_ = cmd.RegisterFlagCompletionFunc(credsFlagName, completion.AutocompleteNone)
 
+	flags.BoolVarP(&playOptions.kustomize, "kustomize", "k", false, "Render the kustomization in the given directory and play the result")
+
 	staticMACFlagName := "mac-address"
 	flags.StringSliceVar(&playOptions.macs, staticMACFlagName, nil, "Static MAC addresses to assign to the pods")
 	_ = cmd.RegisterFlagCompletionFunc(staticMACFlagName, completion.AutocompleteNone)
@@ -203,6 +208,9 @@ func playFlags(cmd *cobra.Command) {
 		exitFlagName := "service-exit-code-propagation"
 		flags.StringVar(&playOptions.ExitCodePropagation, exitFlagName, "", "Exit-code propagation of the service container")
 		_ = flags.MarkHidden(exitFlagName)
+	} else {
+		asyncFlagName := "async"
+		flags.BoolVar(&playOptions.Async, asyncFlagName, false, "Play as a job of the service and print the job ID instead of waiting")
 	}
 }
 
@@ -210,6 +218,9 @@ func play(cmd *cobra.Command, args []string) error {
 	if playOptions.ServiceContainer && !playOptions.StartCLI { // Sanity check to be future proof
 		return fmt.Errorf("--service-container does not work with --start=stop")
 	}
+	if playOptions.Async && (playOptions.Wait || playOptions.Down) {
+		return errors.New("--async cannot be used with --wait or --down")
+	}
 	// TLS verification in c/image is controlled via a `types.OptionalBool`
 	// which allows for distinguishing among set-true, set-false, unspecified
 	// which is important to implement a sane way of dealing with defaults of
@@ -274,7 +285,7 @@ func play(cmd *cobra.Command, args []string) error {
 		return errors.New("--force may be specified only with --down")
 	}
 
-	reader, err := readerFromArg(args[0])
+	reader, err := readerFromArg(args[0], playOptions.kustomize)
 	if err != nil {
 		return err
 	}
@@ -304,7 +315,7 @@ func play(cmd *cobra.Command, args []string) error {
 		playOptions.ServiceContainer = true
 
 		// Read the kube yaml file again so that a reader can be passed down to the teardown function
-		teardownReader, err = readerFromArg(args[0])
+		teardownReader, err = readerFromArg(args[0], playOptions.kustomize)
 		if err != nil {
 			return err
 		}
@@ -362,7 +373,21 @@ func playKube(cmd *cobra.Command, args []string) error {
 	return play(cmd, args)
 }
 
-func readerFromArg(fileName string) (*bytes.Reader, error) {
+// readerFromArg returns the content of the YAML file, URL or stdin in
+// fileName or, with kustomization, the rendered kustomization in the
+// directory fileName.
+func readerFromArg(fileName string, kustomization bool) (*bytes.Reader, error) {
+	if kustomization {
+		if fileName == "-" || parse.ValidURL(fileName) == nil {
+			return nil, fmt.Errorf("--kustomize requires a local kustomization directory, not %q", fileName)
+		}
+		data, err := kustomize.Build(fileName)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(data), nil
+	}
+
 	var reader io.Reader
 	switch {
 	case fileName == "-": // Read from stdin
@@ -467,6 +492,10 @@ func kubeplay(body io.Reader) error {
 	if err != nil {
 		return err
 	}
+	if report.JobID != "" {
+		fmt.Println(report.JobID)
+		return nil
+	}
 	if report.ExitCode != nil {
 		registry.SetExitCode(int(*report.ExitCode))
 	}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/containers/podman/v5/cmd/podman/generate"
 	_ "github.com/containers/podman/v5/cmd/podman/healthcheck"
 	_ "github.com/containers/podman/v5/cmd/podman/images"
+	_ "github.com/containers/podman/v5/cmd/podman/jobs"
 	_ "github.com/containers/podman/v5/cmd/podman/kube"
 	_ "github.com/containers/podman/v5/cmd/podman/machine"
 	_ "github.com/containers/podman/v5/cmd/podman/machine/os"
@@ -20,6 +21,7 @@ import (
 	_ "github.com/containers/podman/v5/cmd/podman/pods"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	_ "github.com/containers/podman/v5/cmd/podman/secrets"
+	_ "github.com/containers/podman/v5/cmd/podman/session"
 	_ "github.com/containers/podman/v5/cmd/podman/system"
 	_ "github.com/containers/podman/v5/cmd/podman/system/connection"
 	"github.com/containers/podman/v5/cmd/podman/validate"
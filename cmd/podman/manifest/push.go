@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/spf13/cobra"
 )
@@ -101,6 +102,11 @@ func init() {
 	flags.BoolVar(&manifestPushOpts.Insecure, "insecure", false, "neither require HTTPS nor verify certificates when accessing the registry")
 	_ = flags.MarkHidden("insecure")
 	flags.BoolVarP(&manifestPushOpts.Quiet, "quiet", "q", false, "don't output progress information when pushing lists")
+
+	progressFlagName := "progress"
+	flags.StringVar(&manifestPushOpts.ProgressFormat, progressFlagName, progress.FormatText, "format of the progress output: text or json")
+	_ = pushCmd.RegisterFlagCompletionFunc(progressFlagName, common.AutocompleteProgressFormat)
+
 	flags.SetNormalizeFunc(utils.AliasFlags)
 
 	compressionFormat := "compression-format"
@@ -121,6 +127,9 @@ func init() {
 }
 
 func push(cmd *cobra.Command, args []string) error {
+	if err := progress.ValidateFormat(manifestPushOpts.ProgressFormat); err != nil {
+		return err
+	}
 	if cmd.Flags().Changed("authfile") {
 		if err := auth.CheckAuthFile(manifestPushOpts.Authfile); err != nil {
 			return err
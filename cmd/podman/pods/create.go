@@ -87,6 +87,12 @@ func init() {
 	flags.StringVar(&infraImage, infraImageFlagName, defInfraImage, "Image to use to override builtin infra container")
 	_ = createCommand.RegisterFlagCompletionFunc(infraImageFlagName, common.AutocompleteImages)
 
+	flags.BoolVar(&createOptions.InfraNoImage, "infra-no-image", false, "Run the infra container without an image, using the init binary of the host")
+
+	infraHealthIntervalFlagName := "infra-health-interval"
+	flags.StringVar(&createOptions.InfraHealthInterval, infraHealthIntervalFlagName, "", "Run a healthcheck of an infra container without image at the given `interval`")
+	_ = createCommand.RegisterFlagCompletionFunc(infraHealthIntervalFlagName, completion.AutocompleteNone)
+
 	podIDFileFlagName := "pod-id-file"
 	flags.StringVar(&podIDFile, podIDFileFlagName, "", "Write the pod ID to the file")
 	_ = createCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
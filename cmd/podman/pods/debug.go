@@ -0,0 +1,136 @@
+package pods
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/spf13/cobra"
+)
+
+var (
+	debugDescription = `Run a temporary debug container in the namespaces of a running pod.
+
+  The debug container is not restarted and is removed when it exits.  With --target, it shares the PID namespace of the target container, so the processes of the target container can be inspected.`
+
+	debugCommand = &cobra.Command{
+		Use:               "debug [options] POD [COMMAND [ARG...]]",
+		Short:             "Run a temporary debug container in a pod",
+		Long:              debugDescription,
+		RunE:              debug,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod debug --image quay.io/libpod/busybox mypod
+  podman pod debug -it --image fedora --target mypod-web mypod /bin/bash`,
+	}
+)
+
+var debugOptions = struct {
+	Image       string
+	Target      string
+	Name        string
+	Interactive bool
+	TTY         bool
+	DetachKeys  string
+}{}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: debugCommand,
+		Parent:  podCmd,
+	})
+	flags := debugCommand.Flags()
+	flags.SetInterspersed(false)
+
+	imageFlagName := "image"
+	flags.StringVar(&debugOptions.Image, imageFlagName, "", "Image of the debug container")
+	_ = debugCommand.RegisterFlagCompletionFunc(imageFlagName, common.AutocompleteImages)
+	_ = debugCommand.MarkFlagRequired(imageFlagName)
+
+	targetFlagName := "target"
+	flags.StringVar(&debugOptions.Target, targetFlagName, "", "Share the PID namespace of this container of the pod")
+	_ = debugCommand.RegisterFlagCompletionFunc(targetFlagName, common.AutocompleteContainers)
+
+	nameFlagName := "name"
+	flags.StringVar(&debugOptions.Name, nameFlagName, "", "Assign a name to the debug container")
+	_ = debugCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+
+	flags.BoolVarP(&debugOptions.Interactive, "interactive", "i", false, "Keep STDIN open even if not attached")
+	flags.BoolVarP(&debugOptions.TTY, "tty", "t", false, "Allocate a pseudo-TTY for the debug container")
+
+	detachKeysFlagName := "detach-keys"
+	flags.StringVar(&debugOptions.DetachKeys, detachKeysFlagName, containerConfig.DetachKeys(), "Select the key sequence for detaching a container. Format is a single character `[a-Z]` or a comma separated sequence of `ctrl-<value>`, where `<value>` is one of: `a-cf`, `@`, `^`, `[`, `\\`, `]`, `^` or `_`")
+	_ = debugCommand.RegisterFlagCompletionFunc(detachKeysFlagName, common.AutocompleteDetachKeys)
+}
+
+func debug(_ *cobra.Command, args []string) error {
+	pods, errs, err := registry.ContainerEngine().PodInspect(registry.Context(), args[:1], entities.InspectOptions{})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	pod := pods[0]
+	if pod.State != define.PodStateRunning && pod.State != define.PodStateDegraded {
+		return fmt.Errorf("pod %s must be running to add a debug container: %w", pod.Name, define.ErrCtrStateInvalid)
+	}
+
+	s := specgen.NewSpecGenerator(debugOptions.Image, false)
+	s.Pod = pod.ID
+	s.Name = debugOptions.Name
+	s.Command = args[1:]
+	s.Terminal = &debugOptions.TTY
+	s.Stdin = &debugOptions.Interactive
+	remove := true
+	s.Remove = &remove
+	s.RestartPolicy = define.RestartPolicyNo
+	s.Annotations = map[string]string{define.EphemeralContainerAnnotation: debugOptions.Target}
+
+	if debugOptions.Target != "" {
+		ctrs, errs, err := registry.ContainerEngine().ContainerInspect(registry.Context(), []string{debugOptions.Target}, entities.InspectOptions{})
+		if err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		target := ctrs[0]
+		if target.Pod != pod.ID {
+			return fmt.Errorf("container %s is not part of pod %s: %w", debugOptions.Target, pod.Name, define.ErrInvalidArg)
+		}
+		// The PID namespace of the pod is already joined if it is
+		// shared.
+		if !slices.Contains(pod.SharedNamespaces, "pid") {
+			s.PidNS = specgen.Namespace{NSMode: specgen.FromContainer, Value: target.ID}
+		}
+	}
+
+	if _, err := registry.ImageEngine().Pull(registry.Context(), debugOptions.Image, entities.ImagePullOptions{PullPolicy: config.PullPolicyMissing}); err != nil {
+		return err
+	}
+
+	runOptions := entities.ContainerRunOptions{
+		DetachKeys:   debugOptions.DetachKeys,
+		OutputStream: os.Stdout,
+		ErrorStream:  os.Stderr,
+		Rm:           true,
+		SigProxy:     true,
+		Spec:         s,
+	}
+	if debugOptions.Interactive {
+		runOptions.InputStream = os.Stdin
+	}
+	report, err := registry.ContainerEngine().ContainerRun(registry.Context(), runOptions)
+	if report != nil {
+		registry.SetExitCode(report.ExitCode)
+	}
+	return err
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +24,7 @@ type podRmOptionsWrapper struct {
 	entities.PodRmOptions
 
 	PodIDFiles []string
+	bulk       common.BulkFlags
 }
 
 var (
@@ -65,6 +67,8 @@ func init() {
 	flags.IntVarP(&stopTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for pod stop before killing the container")
 	_ = rmCommand.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 
+	common.DefineBulkFlags(rmCommand, &rmOptions.bulk)
+
 	validate.AddLatestFlag(rmCommand, &rmOptions.Latest)
 
 	if registry.IsRemote() {
@@ -87,6 +91,14 @@ func rm(cmd *cobra.Command, args []string) error {
 		rmOptions.Ignore = true
 	}
 
+	if err := rmOptions.bulk.Validate(); err != nil {
+		return err
+	}
+	rmOptions.BulkOptions = rmOptions.bulk.Options()
+	if rmOptions.bulk.JSON() {
+		return removePodsJSON(args)
+	}
+
 	errs = append(errs, removePods(args, rmOptions.PodRmOptions, true)...)
 
 	for _, idFile := range rmOptions.PodIDFiles {
@@ -107,6 +119,39 @@ func rm(cmd *cobra.Command, args []string) error {
 	return errs.PrintErrors()
 }
 
+// removePodsJSON removes the specified pods and the pods in the pod ID files
+// in a single operation and prints the result of each pod as JSON. The pod
+// ID files of removed pods are removed.
+func removePodsJSON(namesOrIDs []string) error {
+	idFiles := make(map[string]string, len(rmOptions.PodIDFiles))
+	for _, idFile := range rmOptions.PodIDFiles {
+		id, err := specgenutil.ReadPodIDFile(idFile)
+		if err != nil {
+			return err
+		}
+		idFiles[id] = idFile
+		namesOrIDs = append(namesOrIDs, id)
+	}
+
+	responses, err := registry.ContainerEngine().PodRm(context.Background(), namesOrIDs, rmOptions.PodRmOptions)
+	if err != nil {
+		setExitCode(err)
+		return err
+	}
+	results := make([]reports.BulkResult, 0, len(responses))
+	for _, r := range responses {
+		if r.Err != nil {
+			setExitCode(r.Err)
+		} else if idFile, ok := idFiles[r.Id]; ok {
+			if err := os.Remove(idFile); err != nil {
+				r.Err = err
+			}
+		}
+		results = append(results, reports.NewBulkResult(r.Id, "", r.Err))
+	}
+	return common.PrintBulkResults(results)
+}
+
 // removePods removes the specified pods (names or IDs).  Allows for sharing
 // pod-removal logic across commands.
 func removePods(namesOrIDs []string, rmOptions entities.PodRmOptions, printIDs bool) utils.OutputErrors {
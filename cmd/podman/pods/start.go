@@ -10,6 +10,8 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	"github.com/containers/podman/v5/pkg/errorhandling"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +21,7 @@ type podStartOptionsWrapper struct {
 	entities.PodStartOptions
 
 	PodIDFiles []string
+	bulk       common.BulkFlags
 }
 
 var (
@@ -54,12 +57,18 @@ func init() {
 	flags.StringArrayVarP(&startOptions.PodIDFiles, podIDFileFlagName, "", nil, "Read the pod ID from the file")
 	_ = startCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
 
+	common.DefineBulkFlags(startCommand, &startOptions.bulk)
+
 	validate.AddLatestFlag(startCommand, &startOptions.Latest)
 }
 
 func start(cmd *cobra.Command, args []string) error {
 	var errs utils.OutputErrors
 
+	if err := startOptions.bulk.Validate(); err != nil {
+		return err
+	}
+	startOptions.BulkOptions = startOptions.bulk.Options()
 	ids, err := specgenutil.ReadPodIDFiles(startOptions.PodIDFiles)
 	if err != nil {
 		return err
@@ -70,6 +79,13 @@ func start(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if startOptions.bulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, errorhandling.JoinErrors(r.Errs)))
+		}
+		return common.PrintBulkResults(results)
+	}
 	// in the cli, first we print out all the successful attempts
 	for _, r := range responses {
 		if len(r.Errs) == 0 {
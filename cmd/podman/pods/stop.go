@@ -10,6 +10,8 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	"github.com/containers/podman/v5/pkg/errorhandling"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/spf13/cobra"
 )
@@ -20,6 +22,7 @@ type podStopOptionsWrapper struct {
 
 	podIDFiles []string
 	timeoutCLI int
+	bulk       common.BulkFlags
 }
 
 var (
@@ -61,6 +64,8 @@ func init() {
 	flags.StringArrayVarP(&stopOptions.podIDFiles, podIDFileFlagName, "", nil, "Write the pod ID to the file")
 	_ = stopCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
 
+	common.DefineBulkFlags(stopCommand, &stopOptions.bulk)
+
 	validate.AddLatestFlag(stopCommand, &stopOptions.Latest)
 
 	if registry.IsRemote() {
@@ -72,6 +77,10 @@ func init() {
 
 func stop(cmd *cobra.Command, args []string) error {
 	var errs utils.OutputErrors
+	if err := stopOptions.bulk.Validate(); err != nil {
+		return err
+	}
+	stopOptions.BulkOptions = stopOptions.bulk.Options()
 	if cmd.Flag("time").Changed {
 		stopOptions.Timeout = stopOptions.timeoutCLI
 	}
@@ -86,6 +95,13 @@ func stop(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if stopOptions.bulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			results = append(results, reports.NewBulkResult(r.Id, r.RawInput, errorhandling.JoinErrors(r.Errs)))
+		}
+		return common.PrintBulkResults(results)
+	}
 	// in the cli, first we print out all the successful attempts
 	for _, r := range responses {
 		if len(r.Errs) == 0 {
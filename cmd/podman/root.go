@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -21,6 +22,7 @@ import (
 	"github.com/containers/podman/v5/pkg/checkpoint/crutils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/parallel"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/version"
 	"github.com/containers/storage"
 	"github.com/sirupsen/logrus"
@@ -86,6 +88,10 @@ var (
 	// transform the noStdout BoolVar to a string that we can assign to useStdout.
 	noStdout  = false
 	useStdout = ""
+
+	// commandSpan is the tracing span of the command, nil if tracing is
+	// disabled.
+	commandSpan *tracing.Span
 )
 
 func init() {
@@ -123,6 +129,7 @@ func Execute() {
 			}
 		}
 		fmt.Fprintln(os.Stderr, formatError(err))
+		commandSpan.SetError(err)
 	}
 
 	_ = shutdown.Stop()
@@ -142,6 +149,11 @@ func Execute() {
 		}
 	}
 
+	commandSpan.End()
+	if err := tracing.Shutdown(context.Background()); err != nil {
+		logrus.Warn(err)
+	}
+
 	os.Exit(registry.GetExitCode())
 }
 
@@ -313,6 +325,21 @@ func persistentPreRunE(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if err := tracing.Init(tracing.Options{Endpoint: podmanConfig.TraceOTLPEndpoint, File: podmanConfig.TraceOTLPFile}); err != nil {
+		return err
+	}
+	// The span of the command is the parent of all spans of the command,
+	// including the spans of the requests of the remote client. The API
+	// service traces every request separately instead, as child of the
+	// span of the client.
+	if cmd.Name() != "service" || cmd.Parent().Name() != "system" {
+		ctx, span := tracing.Start(registry.Context(), cmd.CommandPath())
+		span.SetAttribute("podman.remote", strconv.FormatBool(registry.IsRemote()))
+		tracing.SetProcessSpan(span)
+		registry.ContextWithOptions(ctx)
+		commandSpan = span
+	}
+
 	// Prep the engines
 	if _, err := registry.NewImageEngine(cmd, args); err != nil {
 		// Note: this is gross, but it is the hand we are dealt
@@ -505,6 +532,14 @@ func rootFlags(cmd *cobra.Command, podmanConfig *entities.PodmanConfig) {
 	lFlags.StringVar(&podmanConfig.Identity, identityFlagName, podmanConfig.Identity, "path to SSH identity file, (CONTAINER_SSHKEY)")
 	_ = cmd.RegisterFlagCompletionFunc(identityFlagName, completion.AutocompleteDefault)
 
+	traceOTLPEndpointFlagName := "trace-otlp-endpoint"
+	lFlags.StringVar(&podmanConfig.TraceOTLPEndpoint, traceOTLPEndpointFlagName, "", "Export OpenTelemetry traces to the OTLP/HTTP endpoint `URL` (OTEL_EXPORTER_OTLP_ENDPOINT)")
+	_ = cmd.RegisterFlagCompletionFunc(traceOTLPEndpointFlagName, completion.AutocompleteNone)
+
+	traceOTLPFileFlagName := "trace-otlp-file"
+	lFlags.StringVar(&podmanConfig.TraceOTLPFile, traceOTLPFileFlagName, "", "Append OpenTelemetry traces in JSON format to `FILE`")
+	_ = cmd.RegisterFlagCompletionFunc(traceOTLPFileFlagName, completion.AutocompleteDefault)
+
 	// Flags that control or influence any kind of output.
 	outFlagName := "out"
 	lFlags.StringVar(&useStdout, outFlagName, "", "Send output (stdout) from podman to a file")
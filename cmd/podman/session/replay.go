@@ -0,0 +1,137 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/asciicast"
+	"github.com/spf13/cobra"
+)
+
+var (
+	replayDescription = `Replay a terminal session recorded with podman exec --record or by the session recording configuration.
+
+  The output of the session is written to the terminal with its original timing.`
+	replayCmd = &cobra.Command{
+		Use:               "replay [options] FILE",
+		Short:             "Replay a recorded terminal session",
+		Long:              replayDescription,
+		RunE:              replay,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example: `podman session replay session.cast
+  podman session replay --speed 2 --idle-time-limit 1 session.cast
+  podman session replay --info session.cast`,
+	}
+)
+
+var replayOpts = struct {
+	info          bool
+	speed         float64
+	idleTimeLimit float64
+}{}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: replayCmd,
+		Parent:  sessionCmd,
+	})
+	flags := replayCmd.Flags()
+	flags.BoolVar(&replayOpts.info, "info", false, "Print the metadata of the session instead of replaying it")
+
+	speedFlagName := "speed"
+	flags.Float64Var(&replayOpts.speed, speedFlagName, 1, "Replay the session at `factor` times the original speed")
+	_ = replayCmd.RegisterFlagCompletionFunc(speedFlagName, completion.AutocompleteNone)
+
+	idleTimeLimitFlagName := "idle-time-limit"
+	flags.Float64Var(&replayOpts.idleTimeLimit, idleTimeLimitFlagName, 0, "Limit pauses of the session to `seconds`, 0 for no limit")
+	_ = replayCmd.RegisterFlagCompletionFunc(idleTimeLimitFlagName, completion.AutocompleteNone)
+}
+
+func replay(cmd *cobra.Command, args []string) error {
+	if replayOpts.speed <= 0 {
+		return errors.New("--speed must be greater than 0")
+	}
+	if replayOpts.idleTimeLimit < 0 {
+		return errors.New("--idle-time-limit must not be negative")
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader, header, err := asciicast.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+	if replayOpts.info {
+		return printInfo(reader, header)
+	}
+
+	idleTimeLimit := time.Duration(replayOpts.idleTimeLimit * float64(time.Second))
+	var last time.Duration
+	for {
+		event, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("reading %s: %w", args[0], err)
+		}
+		pause := event.Time - last
+		last = event.Time
+		if idleTimeLimit > 0 && pause > idleTimeLimit {
+			pause = idleTimeLimit
+		}
+		time.Sleep(time.Duration(float64(pause) / replayOpts.speed))
+		if event.Type == asciicast.EventOutput {
+			if _, err := io.WriteString(os.Stdout, event.Data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// printInfo prints the metadata of the session. The end of the session is
+// the time of its last event.
+func printInfo(reader *asciicast.Reader, header *asciicast.Header) error {
+	var duration time.Duration
+	for {
+		event, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		duration = event.Time
+	}
+
+	start := time.Unix(header.Timestamp, 0)
+	if meta := header.Podman; meta != nil {
+		fmt.Printf("Type:           %s\n", meta.Type)
+		fmt.Printf("Container:      %s (%s)\n", meta.ContainerName, meta.ContainerID)
+		if meta.SessionID != "" {
+			fmt.Printf("Session:        %s\n", meta.SessionID)
+		}
+		fmt.Printf("User:           %s\n", meta.User)
+		if meta.ContainerUser != "" {
+			fmt.Printf("Container user: %s\n", meta.ContainerUser)
+		}
+	}
+	if header.Command != "" {
+		fmt.Printf("Command:        %s\n", header.Command)
+	}
+	fmt.Printf("Terminal:       %dx%d\n", header.Width, header.Height)
+	fmt.Printf("Start:          %s\n", start.Format(time.RFC3339))
+	fmt.Printf("End:            %s\n", start.Add(duration).Format(time.RFC3339))
+	fmt.Printf("Duration:       %s\n", duration.Round(time.Millisecond))
+	return nil
+}
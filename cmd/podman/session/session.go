@@ -0,0 +1,23 @@
+package session
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman _session_
+	sessionCmd = &cobra.Command{
+		Use:   "session",
+		Short: "Manage recorded terminal sessions",
+		Long:  "Manage terminal sessions of exec and attach recorded in asciicast v2 format",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: sessionCmd,
+	})
+}
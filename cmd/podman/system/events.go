@@ -30,7 +30,8 @@ var (
 		Example: `podman events
   podman events --filter event=create
   podman events --format {{.Image}}
-  podman events --since 1h30s`,
+  podman events --since 1h30s
+  podman events --after-id 1042`,
 	}
 
 	systemEventsCommand = &cobra.Command{
@@ -74,6 +75,8 @@ type Event struct {
 	HealthStatus string `json:"health_status,omitempty"`
 	// Error code for certain events involving errors.
 	Error string `json:",omitempty"`
+	// SequenceID is the position of the event in the events log
+	SequenceID uint64 `json:",omitempty"`
 
 	events.Details
 }
@@ -92,6 +95,7 @@ func newEventFromLibpodEvent(e *events.Event) Event {
 		Details:           e.Details,
 		TimeNano:          e.Time.UnixNano(),
 		Error:             e.Error,
+		SequenceID:        e.SequenceID,
 	}
 }
 
@@ -115,6 +119,10 @@ func init() {
 func eventsFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 
+	afterIDFlagName := "after-id"
+	flags.Uint64Var(&eventOptions.AfterID, afterIDFlagName, 0, "show all events after the event with this sequence ID")
+	_ = cmd.RegisterFlagCompletionFunc(afterIDFlagName, completion.AutocompleteNone)
+
 	filterFlagName := "filter"
 	flags.StringArrayVarP(&eventOptions.Filter, filterFlagName, "f", []string{}, "filter output")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteEventFilter)
@@ -137,7 +145,7 @@ func eventsFlags(cmd *cobra.Command) {
 }
 
 func eventsCmd(cmd *cobra.Command, _ []string) error {
-	if len(eventOptions.Since) > 0 || len(eventOptions.Until) > 0 {
+	if len(eventOptions.Since) > 0 || len(eventOptions.Until) > 0 || eventOptions.AfterID > 0 {
 		eventOptions.FromStart = true
 	}
 	eventChannel := make(chan events.ReadResult, 1)
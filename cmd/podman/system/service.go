@@ -40,9 +40,10 @@ Enable a listening service for API access to Podman commands.
 	}
 
 	srvArgs = struct {
-		CorsHeaders string
-		PProfAddr   string
-		Timeout     uint
+		CorsHeaders  string
+		JobRetention time.Duration
+		PProfAddr    string
+		Timeout      uint
 	}{}
 )
 
@@ -64,6 +65,11 @@ func init() {
 	flags.StringVarP(&srvArgs.CorsHeaders, "cors", "", "", "Set CORS Headers")
 	_ = srvCmd.RegisterFlagCompletionFunc("cors", completion.AutocompleteNone)
 
+	jobRetentionFlagName := "job-retention"
+	flags.DurationVar(&srvArgs.JobRetention, jobRetentionFlagName, time.Hour,
+		"Time finished asynchronous jobs are kept.  Use 0 to keep them until they are removed")
+	_ = srvCmd.RegisterFlagCompletionFunc(jobRetentionFlagName, completion.AutocompleteNone)
+
 	flags.StringVarP(&srvArgs.PProfAddr, "pprof-address", "", "",
 		"Binding network address for pprof profile endpoints, default: do not expose endpoints")
 	_ = flags.MarkHidden("pprof-address")
@@ -100,10 +106,11 @@ func service(cmd *cobra.Command, args []string) error {
 	}
 
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
-		CorsHeaders: srvArgs.CorsHeaders,
-		PProfAddr:   srvArgs.PProfAddr,
-		Timeout:     time.Duration(srvArgs.Timeout) * time.Second,
-		URI:         apiURI,
+		CorsHeaders:  srvArgs.CorsHeaders,
+		JobRetention: srvArgs.JobRetention,
+		PProfAddr:    srvArgs.PProfAddr,
+		Timeout:      time.Duration(srvArgs.Timeout) * time.Second,
+		URI:          apiURI,
 	})
 }
 
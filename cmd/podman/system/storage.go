@@ -0,0 +1,27 @@
+//go:build !remote
+
+package system
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman system _storage_
+	storageCmd = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "storage",
+		Short:       "Manage the storage of images and containers",
+		Long:        "Manage the storage of images and containers",
+		RunE:        validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: storageCmd,
+		Parent:  systemCmd,
+	})
+}
@@ -0,0 +1,92 @@
+//go:build !remote
+
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	storageMigrateDescription = `Migrate the storage to another graph driver
+
+  All images and containers are copied to the new graph driver, containers keep their IDs, names and volumes.
+  Running containers are stopped.  The storage of the old graph driver is removed once everything was copied.
+  An interrupted migration is resumed by running the command again.
+`
+	storageMigrateCommand = &cobra.Command{
+		Annotations: map[string]string{
+			registry.EngineMode:    registry.ABIMode,
+			registry.NoMoveProcess: registry.NoMoveProcess,
+		},
+		Use:               "migrate [options]",
+		Args:              validate.NoArgs,
+		Short:             "Migrate the storage to another graph driver",
+		Long:              storageMigrateDescription,
+		RunE:              storageMigrate,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system storage migrate --driver overlay --dry-run
+  podman system storage migrate --driver overlay`,
+	}
+
+	storageMigrateOptions entities.SystemStorageMigrateOptions
+	storageMigrateForce   bool
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: storageMigrateCommand,
+		Parent:  storageCmd,
+	})
+	flags := storageMigrateCommand.Flags()
+
+	driverFlagName := "driver"
+	flags.StringVar(&storageMigrateOptions.Driver, driverFlagName, "", "Graph driver to migrate the storage to")
+	_ = storageMigrateCommand.RegisterFlagCompletionFunc(driverFlagName, completion.AutocompleteNone)
+	_ = storageMigrateCommand.MarkFlagRequired(driverFlagName)
+
+	flags.BoolVar(&storageMigrateOptions.DryRun, "dry-run", false, "Only show what would be migrated")
+	flags.BoolVarP(&storageMigrateForce, "force", "f", false, "Do not prompt for confirmation")
+}
+
+func storageMigrate(cmd *cobra.Command, args []string) error {
+	if !storageMigrateOptions.DryRun && !storageMigrateForce {
+		fmt.Printf(`WARNING! All containers will be stopped, and the storage of the current graph driver
+will be removed once all images and containers were copied to the %s graph driver.
+`, storageMigrateOptions.Driver)
+		fmt.Print(`Are you sure you want to continue? [y/N] `)
+		answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(strings.ToLower(answer), "y") {
+			return nil
+		}
+	}
+
+	report, err := registry.ContainerEngine().SystemStorageMigrate(registry.Context(), storageMigrateOptions)
+	if err != nil {
+		return err
+	}
+
+	if report.Resumed {
+		fmt.Printf("Resuming the interrupted migration to %s\n", report.To)
+	}
+	if storageMigrateOptions.DryRun {
+		fmt.Printf("Migrating from %s to %s copies %d images with %d layers and %d containers, about %s\n",
+			report.From, report.To, report.Images, report.Layers, report.Containers, units.HumanSize(float64(report.Size)))
+		return nil
+	}
+	fmt.Printf("Migrated %d images with %d layers and %d containers from %s to %s\n",
+		report.Images, report.Layers, report.Containers, report.From, report.To)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/spf13/cobra"
 )
 
@@ -35,6 +36,7 @@ var (
 var (
 	rmOptions   = entities.VolumeRmOptions{}
 	stopTimeout int
+	rmBulk      common.BulkFlags
 )
 
 func init() {
@@ -48,6 +50,8 @@ func init() {
 	timeFlagName := "time"
 	flags.IntVarP(&stopTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for running containers to stop before killing the container")
 	_ = rmCommand.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
+
+	common.DefineBulkFlags(rmCommand, &rmBulk)
 }
 
 func rm(cmd *cobra.Command, args []string) error {
@@ -57,6 +61,10 @@ func rm(cmd *cobra.Command, args []string) error {
 	if (len(args) > 0 && rmOptions.All) || (len(args) < 1 && !rmOptions.All) {
 		return errors.New("choose either one or more volumes or all")
 	}
+	if err := rmBulk.Validate(); err != nil {
+		return err
+	}
+	rmOptions.BulkOptions = rmBulk.Options()
 	if cmd.Flag("time").Changed {
 		if !rmOptions.Force {
 			return errors.New("--force option must be specified to use the --time option")
@@ -72,6 +80,19 @@ func rm(cmd *cobra.Command, args []string) error {
 		setExitCode(err)
 		return err
 	}
+	if rmBulk.JSON() {
+		results := make([]reports.BulkResult, 0, len(responses))
+		for _, r := range responses {
+			if r.Err != nil {
+				if rmOptions.Force && strings.Contains(r.Err.Error(), define.ErrNoSuchVolume.Error()) {
+					continue
+				}
+				setExitCode(r.Err)
+			}
+			results = append(results, reports.NewBulkResult(r.Id, "", r.Err))
+		}
+		return common.PrintBulkResults(results)
+	}
 	for _, r := range responses {
 		if r.Err == nil {
 			fmt.Println(r.Id)
@@ -723,13 +723,16 @@ func process() error {
 	unitsInfoMap := generateUnitsInfoMap(units)
 
 	for _, unit := range units {
-		var service *parser.UnitFile
+		var service, socket *parser.UnitFile
 		var err error
 
 		switch {
 		case strings.HasSuffix(unit.Filename, ".container"):
 			warnIfAmbiguousName(unit, quadlet.ContainerGroup)
 			service, err = quadlet.ConvertContainer(unit, isUserFlag, unitsInfoMap)
+			if err == nil {
+				socket, err = quadlet.ConvertContainerSocket(unit, unitsInfoMap)
+			}
 		case strings.HasSuffix(unit.Filename, ".volume"):
 			warnIfAmbiguousName(unit, quadlet.VolumeGroup)
 			service, err = quadlet.ConvertVolume(unit, unit.Filename, unitsInfoMap, isUserFlag)
@@ -754,21 +757,27 @@ func process() error {
 			continue
 		}
 
-		service.Path = path.Join(outputPath, service.Filename)
+		generated := []*parser.UnitFile{service}
+		if socket != nil {
+			generated = append(generated, socket)
+		}
+		for _, service := range generated {
+			service.Path = path.Join(outputPath, service.Filename)
 
-		if dryRunFlag {
-			data, err := service.ToString()
-			if err != nil {
-				reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+			if dryRunFlag {
+				data, err := service.ToString()
+				if err != nil {
+					reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+					continue
+				}
+				fmt.Printf("---%s---\n%s\n", service.Path, data)
 				continue
 			}
-			fmt.Printf("---%s---\n%s\n", service.Path, data)
-			continue
-		}
-		if err := generateServiceFile(service); err != nil {
-			reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			if err := generateServiceFile(service); err != nil {
+				reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			}
+			enableServiceFile(outputPath, service)
 		}
-		enableServiceFile(outputPath, service)
 	}
 	return prevError
 }
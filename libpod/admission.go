@@ -0,0 +1,54 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/admission"
+)
+
+// admitContainer evaluates the admission policy, if any, against the
+// configuration of a container about to be created.  It is done here rather
+// than in the callers so that every way of creating containers, including
+// kube play service containers and checkpoint restores, is checked.
+func (r *Runtime) admitContainer(ctr *Container) error {
+	policies, err := admission.Load()
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+
+	admitted := &admission.Container{
+		Name:        ctr.config.Name,
+		Image:       ctr.config.RootfsImageName,
+		Privileged:  ctr.config.Privileged,
+		Labels:      ctr.config.Labels,
+		RuntimeSpec: ctr.config.Spec,
+		Infra:       ctr.config.IsInfra || ctr.config.IsService,
+	}
+	if ctr.config.Spec != nil {
+		for _, m := range ctr.config.Spec.Mounts {
+			if m.Type == define.TypeBind {
+				admitted.HostMounts = append(admitted.HostMounts, m.Source)
+			}
+		}
+	}
+	for _, overlay := range ctr.config.OverlayVolumes {
+		admitted.HostMounts = append(admitted.HostMounts, overlay.Source)
+	}
+	// Devices are given as source[:destination[:permissions]].
+	for _, device := range ctr.config.DeviceHostSrc {
+		source, _, _ := strings.Cut(device.Path, ":")
+		admitted.HostDevices = append(admitted.HostDevices, source)
+	}
+	if ctr.config.RootfsImageID != "" {
+		sources, err := r.ImagePullSources(ctr.config.RootfsImageID)
+		if err != nil {
+			return err
+		}
+		admitted.ImageSources = sources
+	}
+
+	return policies.Admit(admitted)
+}
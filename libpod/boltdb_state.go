@@ -487,6 +487,30 @@ func (s *BoltState) GetDBConfig() (*DBConfig, error) {
 	return cfg, nil
 }
 
+// SetGraphDriver changes the graph driver stored in the database.
+func (s *BoltState) SetGraphDriver(driver string) error {
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	db, err := s.getDBCon()
+	if err != nil {
+		return err
+	}
+	defer s.deferredCloseDBCon(db)
+
+	return db.Update(func(tx *bolt.Tx) error {
+		configBucket, err := getRuntimeConfigBucket(tx)
+		if err != nil {
+			return err
+		}
+		if err := configBucket.Put(graphDriverKey, []byte(driver)); err != nil {
+			return fmt.Errorf("updating graph driver in database: %w", err)
+		}
+		return nil
+	})
+}
+
 // ValidateDBConfig validates paths in the given runtime against the database
 func (s *BoltState) ValidateDBConfig(runtime *Runtime) error {
 	if !s.valid {
@@ -1009,6 +1033,13 @@ func (s *BoltState) AllContainers(loadState bool) ([]*Container, error) {
 	return ctrs, nil
 }
 
+// QueryContainers retrieves all the containers in the database, the query is
+// not evaluated by the BoltDB state.
+// If `loadState` is set, the containers' state will be loaded as well.
+func (s *BoltState) QueryContainers(loadState bool, _ *ContainerQuery) ([]*Container, error) {
+	return s.AllContainers(loadState)
+}
+
 // GetNetworks returns the networks this container is a part of.
 func (s *BoltState) GetNetworks(ctr *Container) (map[string]types.PerNetworkOptions, error) {
 	if !s.valid {
@@ -9,7 +9,9 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/containers/common/libnetwork/pasta"
@@ -19,6 +21,7 @@ import (
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/lock"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/storage"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
@@ -127,6 +130,10 @@ type Container struct {
 
 	slirp4netnsSubnet *net.IPNet
 	pastaResult       *pasta.SetupResult
+
+	// timingLock protects the timing spans in the state, which may be
+	// recorded concurrently.
+	timingLock sync.Mutex
 }
 
 // ContainerState contains the current state of the container
@@ -235,6 +242,10 @@ type ContainerState struct {
 	CheckpointPath   string    `json:"checkpointPath,omitempty"`
 	RestoreLog       string    `json:"restoreLog,omitempty"`
 	Restored         bool      `json:"restored,omitempty"`
+
+	// Timing holds the durations of the steps of the last start of the
+	// container, if timing is enabled for it.
+	Timing []define.TimingSpan `json:"timing,omitempty"`
 }
 
 // ContainerNamedVolume is a named volume that will be mounted into the
@@ -642,6 +653,18 @@ func (c *Container) LogTag() string {
 	return c.config.LogTag
 }
 
+// LogRateLimit returns the maximum rate of log lines of the container, or nil
+// if its logs are not rate limited.
+func (c *Container) LogRateLimit() *logs.RateLimit {
+	return c.config.LogRateLimit
+}
+
+// logRateLimitStatsPath returns the path to the counters of the log rate
+// limit of the container.
+func (c *Container) logRateLimitStatsPath() string {
+	return filepath.Join(c.config.StaticDir, "log-ratelimit.json")
+}
+
 // RestartPolicy returns the container's restart policy.
 func (c *Container) RestartPolicy() string {
 	return c.config.RestartPolicy
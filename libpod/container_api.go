@@ -12,8 +12,10 @@ import (
 	"time"
 
 	"github.com/containers/common/pkg/resize"
+	encconfig "github.com/containers/ocicrypt/config"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/storage/pkg/archive"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
@@ -30,7 +32,11 @@ import (
 // Init requires that all dependency containers be started (e.g. pod infra
 // containers). The `recursive` parameter will, if set to true, start these
 // dependency containers before initializing this container.
-func (c *Container) Init(ctx context.Context, recursive bool) error {
+func (c *Container) Init(ctx context.Context, recursive bool) (finalErr error) {
+	ctx, span := tracing.Start(ctx, "Container.Init")
+	span.SetAttribute("container.id", c.ID())
+	defer span.EndWithError(&finalErr)
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -86,6 +92,10 @@ func (c *Container) initUnlocked(ctx context.Context, recursive bool) error {
 // running before starting the container. The recursive parameter, if set, will start all
 // dependencies before starting this container.
 func (c *Container) Start(ctx context.Context, recursive bool) (finalErr error) {
+	ctx, span := tracing.Start(ctx, "Container.Start")
+	span.SetAttribute("container.id", c.ID())
+	defer span.EndWithError(&finalErr)
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -182,6 +192,16 @@ func (c *Container) Attach(ctx context.Context, streams *define.AttachStreams, k
 		}
 	}
 
+	// Record the terminal of the session if configured.
+	recorder, err := c.recordAttach()
+	if err != nil {
+		return nil, err
+	}
+	if recorder != nil {
+		streams = recorder.recordStreams(streams)
+		resize = recorder.recordResize(resize)
+	}
+
 	attachChan := make(chan error)
 
 	// We need to ensure that we don't return until start() fired in attach.
@@ -207,6 +227,9 @@ func (c *Container) Attach(ctx context.Context, streams *define.AttachStreams, k
 		if err := c.ociRuntime.Attach(c, opts); err != nil {
 			attachChan <- err
 		}
+		if recorder != nil {
+			recorder.close()
+		}
 		close(attachChan)
 	}()
 
@@ -370,6 +393,18 @@ func (c *Container) HTTPAttach(r *http.Request, w http.ResponseWriter, streams *
 		return fmt.Errorf("must specify at least one of stream or logs: %w", define.ErrInvalidArg)
 	}
 
+	// Record the terminal of the session if configured.
+	if streamAttach {
+		recorder, err := c.recordAttach()
+		if err != nil {
+			return err
+		}
+		if recorder != nil {
+			defer recorder.close()
+			streams = recorder.recordHTTPStreams(streams)
+		}
+	}
+
 	// We are NOT holding the lock for the duration of the function.
 	locked = false
 	c.lock.Unlock()
@@ -398,7 +433,11 @@ func (c *Container) AttachResize(newSize resize.TerminalSize) error {
 
 	logrus.Infof("Resizing TTY of container %s", c.ID())
 
-	return c.ociRuntime.AttachResize(c, newSize)
+	if err := c.ociRuntime.AttachResize(c, newSize); err != nil {
+		return err
+	}
+	recordSessionResize(c.ID(), newSize)
+	return nil
 }
 
 // Mount mounts a container's filesystem on the host
@@ -1025,6 +1064,10 @@ type ContainerCheckpointOptions struct {
 	// FileLocks tells the API to checkpoint/restore a container
 	// with file-locks
 	FileLocks bool
+	// EncryptConfig tells the API to encrypt the exported checkpoint
+	// archive or checkpoint image.  Encrypted checkpoints have to be
+	// decrypted before they are restored.
+	EncryptConfig *encconfig.EncryptConfig
 }
 
 // Checkpoint checkpoints a container
@@ -10,7 +10,9 @@ import (
 	"github.com/containers/common/pkg/secrets"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/pkg/namespaces"
+	"github.com/containers/podman/v5/pkg/seccompnotify"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/storage"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -223,6 +225,10 @@ type ContainerSecurityConfig struct {
 	AddCurrentUserPasswdEntry bool `json:"addCurrentUserPasswdEntry,omitempty"`
 	// LabelNested, allow labeling separation from within a container
 	LabelNested bool `json:"label_nested"`
+	// SeccompNotifyPolicy is the policy of the seccomp user-notify
+	// listener of the container.  The listener is started with conmon and
+	// allows, denies or emulates the syscalls of the policy.
+	SeccompNotifyPolicy *seccompnotify.Policy `json:"seccompNotifyPolicy,omitempty"`
 }
 
 // ContainerNameSpaceConfig is an embedded sub-config providing
@@ -378,6 +384,9 @@ type ContainerMiscConfig struct {
 	LogSize int64 `json:"logSize"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
+	// LogRateLimit is the maximum rate of log lines of the container.
+	// Excess lines are dropped and replaced by a marker line.
+	LogRateLimit *logs.RateLimit `json:"logRateLimit,omitempty"`
 	// File containing the conmon PID
 	ConmonPidFile string `json:"conmonPidFile,omitempty"`
 	// RestartPolicy indicates what action the container will take upon
@@ -455,6 +464,8 @@ type ContainerMiscConfig struct {
 	MountAllDevices bool `json:"mountAllDevices"`
 	// ReadWriteTmpfs indicates whether all tmpfs should be mounted readonly when in ReadOnly mode
 	ReadWriteTmpfs bool `json:"readWriteTmpfs"`
+	// Timing records the durations of the steps of starting the container
+	Timing bool `json:"timing,omitempty"`
 }
 
 // InfraInherit contains the compatible options inheritable from the infra container
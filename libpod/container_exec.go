@@ -79,6 +79,10 @@ type ExecConfig struct {
 	// exiting, and the exit command being executed. If set to 0, there is
 	// no delay. If set, ExitCommand must also be set.
 	ExitCommandDelay uint `json:"exitCommandDelay,omitempty"`
+	// RecordFile is the path of a file to record the terminal of the exec
+	// session to, in asciicast v2 format. Only available if Terminal is
+	// true. The session is only recorded when started with Exec.
+	RecordFile string `json:"recordFile,omitempty"`
 }
 
 // ExecSession contains information on a single exec session attached to a given
@@ -180,6 +184,9 @@ func (c *Container) ExecCreate(config *ExecConfig) (string, error) {
 	if config.ExitCommandDelay > 0 && len(config.ExitCommand) == 0 {
 		return "", fmt.Errorf("must provide a non-empty exit command if giving an exit command delay: %w", define.ErrInvalidArg)
 	}
+	if config.RecordFile != "" && !config.Terminal {
+		return "", fmt.Errorf("can only record exec sessions with a terminal: %w", define.ErrInvalidArg)
+	}
 
 	// Verify that we are in a good state to continue
 	if !c.ensureState(define.ContainerStateRunning) {
@@ -321,6 +328,16 @@ func (c *Container) execStartAndAttach(sessionID string, streams *define.AttachS
 		return err
 	}
 
+	// Record the terminal of the session if requested or configured.
+	recorder, err := c.recordExec(session, newSize, isHealthcheck)
+	if err != nil {
+		return err
+	}
+	if recorder != nil {
+		defer recorder.close()
+		streams = recorder.recordStreams(streams)
+	}
+
 	pid, attachChan, err := c.ociRuntime.ExecContainer(c, session.ID(), opts, streams, newSize)
 	if err != nil {
 		return err
@@ -479,6 +496,16 @@ func (c *Container) ExecHTTPStartAndAttach(sessionID string, r *http.Request, w
 		streams.Stderr = session.Config.AttachStderr
 	}
 
+	// Record the terminal of the session if requested or configured.
+	recorder, err := c.recordExec(session, newSize, false)
+	if err != nil {
+		return err
+	}
+	if recorder != nil {
+		defer recorder.close()
+		streams = recorder.recordHTTPStreams(streams)
+	}
+
 	holdConnOpen := make(chan bool)
 
 	defer func() {
@@ -754,7 +781,11 @@ func (c *Container) ExecResize(sessionID string, newSize resize.TerminalSize) er
 
 	// Make sure the exec session is still running.
 
-	return c.ociRuntime.ExecAttachResize(c, sessionID, newSize)
+	if err := c.ociRuntime.ExecAttachResize(c, sessionID, newSize); err != nil {
+		return err
+	}
+	recordSessionResize(sessionID, newSize)
+	return nil
 }
 
 func (c *Container) Exec(config *ExecConfig, streams *define.AttachStreams, resize <-chan resize.TerminalSize) (int, error) {
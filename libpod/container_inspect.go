@@ -9,6 +9,7 @@ import (
 
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/driver"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/pkg/signal"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage/types"
@@ -171,6 +172,7 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		IsService:               c.IsService(),
 		KubeExitCodePropagation: config.KubeExitCodePropagation.String(),
 		LockNumber:              c.lock.ID(),
+		Timing:                  c.state.Timing,
 	}
 
 	if config.RootfsImageID != "" { // May not be set if the container was created with --rootfs
@@ -325,6 +327,9 @@ func (c *Container) GetSecurityOptions() []string {
 	if seccomp, ok := ctrSpec.Annotations[define.InspectAnnotationSeccomp]; ok {
 		SecurityOpt = append(SecurityOpt, fmt.Sprintf("seccomp=%s", seccomp))
 	}
+	if seccompNotify, ok := ctrSpec.Annotations[define.InspectAnnotationSeccompNotify]; ok {
+		SecurityOpt = append(SecurityOpt, fmt.Sprintf("seccomp-notify=%s", seccompNotify))
+	}
 	if apparmor, ok := ctrSpec.Annotations[define.InspectAnnotationApparmor]; ok {
 		SecurityOpt = append(SecurityOpt, fmt.Sprintf("apparmor=%s", apparmor))
 	}
@@ -510,6 +515,18 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	logConfig.Path = c.config.LogPath
 	logConfig.Size = units.HumanSize(float64(c.config.LogSize))
 	logConfig.Tag = c.config.LogTag
+	if c.config.LogRateLimit != nil {
+		stats, err := logs.ReadRateLimitStats(c.logRateLimitStatsPath())
+		if err != nil {
+			logrus.Errorf("Reading log rate limit counters of container %s: %v", c.ID(), err)
+		}
+		logConfig.RateLimit = &define.InspectLogRateLimit{
+			Rate:       c.config.LogRateLimit.String(),
+			Burst:      c.config.LogRateLimit.Burst,
+			Lines:      stats.Lines,
+			Suppressed: stats.Suppressed,
+		}
+	}
 
 	hostConfig.LogConfig = logConfig
 
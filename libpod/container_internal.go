@@ -190,6 +190,7 @@ func (c *Container) waitForExitFileAndSync() error {
 // The exit file is used to supply container exit time and exit code.
 // This assumes the exit file already exists.
 // Also check for an oom file to determine if the container was oom killed or not.
+// The state is only updated, not saved.
 func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 	c.state.FinishedTime = ctime.Created(fi)
 	statusCodeStr, err := os.ReadFile(exitFile)
@@ -213,10 +214,7 @@ func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 
 	c.state.Exited = true
 
-	// Write an event for the container's death
-	c.newContainerExitedEvent(c.state.ExitCode)
-
-	return c.runtime.state.AddContainerExitCode(c.ID(), c.state.ExitCode)
+	return nil
 }
 
 func (c *Container) shouldRestart() bool {
@@ -529,6 +527,7 @@ func (c *Container) setupStorage(ctx context.Context) error {
 		}
 		return fmt.Errorf("creating container storage: %w", containerInfoErr)
 	}
+	c.runtime.recordImageUse(c.config.RootfsImageID)
 
 	// Only reconfig IDMappings if layer was mounted from storage.
 	// If it's an external overlay do not reset IDmappings.
@@ -1040,7 +1039,9 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	}
 
 	// Generate the OCI newSpec
+	endSpan := c.startTimingSpan(timingSpecGeneration, "")
 	newSpec, cleanupFunc, err := c.generateSpec(ctx)
+	endSpan()
 	if err != nil {
 		return err
 	}
@@ -1137,9 +1138,11 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 		if c.config.StartupHealthCheckConfig != nil {
 			timer = c.config.StartupHealthCheckConfig.Interval.String()
 		}
+		endSpan := c.startTimingSpan(timingHealthcheck, "create")
 		if err := c.createTimer(timer, c.config.StartupHealthCheckConfig != nil); err != nil {
 			logrus.Error(err)
 		}
+		endSpan()
 	}
 
 	defer c.newContainerEvent(events.Init)
@@ -1266,12 +1269,13 @@ func (c *Container) start() error {
 		logrus.Debugf("Starting container %s with command %v", c.ID(), c.config.Spec.Process.Args)
 	}
 
-	if err := c.ociRuntime.StartContainer(c); err != nil {
+	if err := c.startOCIContainer(); err != nil {
 		return err
 	}
 	logrus.Debugf("Started container %s", c.ID())
 
 	c.state.State = define.ContainerStateRunning
+	c.runtime.recordImageUse(c.config.RootfsImageID)
 
 	// Unless being ignored, set the MAINPID to conmon.
 	if c.config.SdNotifyMode != define.SdNotifyModeIgnore {
@@ -1295,9 +1299,11 @@ func (c *Container) start() error {
 		if err := c.updateHealthStatus(define.HealthCheckStarting); err != nil {
 			logrus.Error(err)
 		}
+		endSpan := c.startTimingSpan(timingHealthcheck, "start")
 		if err := c.startTimer(c.config.StartupHealthCheckConfig != nil); err != nil {
 			logrus.Error(err)
 		}
+		endSpan()
 	}
 
 	c.newContainerEvent(events.Start)
@@ -1305,6 +1311,13 @@ func (c *Container) start() error {
 	return c.save()
 }
 
+// startOCIContainer starts the container in the OCI runtime and records the
+// runtime start timing span, also if starting fails.
+func (c *Container) startOCIContainer() error {
+	defer c.startTimingSpan(timingRuntimeStart, c.ociRuntime.Name())()
+	return c.ociRuntime.StartContainer(c)
+}
+
 // waitForHealthy, when sdNotifyMode == SdNotifyModeHealthy, waits up to the DefaultWaitInterval
 // for the container to get into the healthy state and reports the status to the notify socket.
 // The function unlocks the container lock, so it must be called from the same thread that locks
@@ -2590,14 +2603,31 @@ func (c *Container) SortUserVolumes(ctrSpec *spec.Spec) ([]*ContainerNamedVolume
 
 // Check for an exit file, and handle one if present
 func (c *Container) checkExitFile() error {
+	exited, err := c.applyExitFile()
+	if err != nil || !exited {
+		return err
+	}
+
+	// Write an event for the container's death
+	c.newContainerExitedEvent(c.state.ExitCode)
+
+	return c.runtime.state.AddContainerExitCode(c.ID(), c.state.ExitCode)
+}
+
+// applyExitFile updates the state with the exit file of the container, if
+// the container exited, and returns whether it did.  Unlike checkExitFile,
+// the exit is not recorded, so it can be applied to a state that was loaded
+// from the database without saving it: the container is handled as exited
+// by the next command that syncs it.
+func (c *Container) applyExitFile() (bool, error) {
 	// If the container's not running, nothing to do.
 	if !c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused, define.ContainerStateStopping) {
-		return nil
+		return false, nil
 	}
 
 	exitFile, err := c.exitFilePath()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// Check for the exit file
@@ -2605,10 +2635,10 @@ func (c *Container) checkExitFile() error {
 	if err != nil {
 		if os.IsNotExist(err) {
 			// Container is still running, no error
-			return nil
+			return false, nil
 		}
 
-		return fmt.Errorf("running stat on container %s exit file: %w", c.ID(), err)
+		return false, fmt.Errorf("running stat on container %s exit file: %w", c.ID(), err)
 	}
 
 	// Alright, it exists. Transition to Stopped state.
@@ -2617,7 +2647,7 @@ func (c *Container) checkExitFile() error {
 	c.state.ConmonPID = 0
 
 	// Read the exit file to get our stopped time and exit code.
-	return c.handleExitFile(exitFile, info)
+	return true, c.handleExitFile(exitFile, info)
 }
 
 func (c *Container) hasNamespace(namespace spec.LinuxNamespaceType) bool {
@@ -630,7 +630,10 @@ func (c *Container) generateSpec(ctx context.Context) (s *spec.Spec, cleanupFunc
 	}
 
 	// Warning: precreate hooks may alter g.Config in place.
-	if c.state.ExtensionStageHooks, err = c.setupOCIHooks(ctx, g.Config); err != nil {
+	endSpan := c.startTimingSpan(timingHooks, "")
+	c.state.ExtensionStageHooks, err = c.setupOCIHooks(ctx, g.Config)
+	endSpan()
+	if err != nil {
 		return nil, nil, fmt.Errorf("setting up OCI Hooks: %w", err)
 	}
 	if len(c.config.EnvSecrets) > 0 {
@@ -715,6 +718,10 @@ func (c *Container) generateSpec(ctx context.Context) (s *spec.Spec, cleanupFunc
 
 	c.addMaskedPaths(&g)
 
+	if err := c.addSeccompNotify(&g); err != nil {
+		return nil, nil, err
+	}
+
 	return g.Config, cleanupFunc, nil
 }
 
@@ -1154,6 +1161,25 @@ func (c *Container) exportCheckpoint(options ContainerCheckpointOptions) error {
 		return fmt.Errorf("reading checkpoint directory %q: %w", c.ID(), err)
 	}
 
+	if options.EncryptConfig != nil {
+		encryptDir, err := os.MkdirTemp("", "checkpoint_encrypt_")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(encryptDir)
+
+		err = crutils.CREncryptCheckpoint(encryptDir, input, options.EncryptConfig)
+		input.Close()
+		if err != nil {
+			return err
+		}
+		input, err = crutils.CREncryptedCheckpointArchive(encryptDir)
+		if err != nil {
+			return fmt.Errorf("reading encrypted checkpoint of %q: %w", c.ID(), err)
+		}
+	}
+	defer input.Close()
+
 	outFile, err := os.Create(options.TargetFile)
 	if err != nil {
 		return fmt.Errorf("creating checkpoint export file %q: %w", options.TargetFile, err)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/rootless"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/runtime-tools/generate"
@@ -43,6 +44,8 @@ func (c *Container) prepare() error {
 		tmpStateLock                    sync.Mutex
 	)
 
+	c.resetTiming()
+
 	wg.Add(2)
 
 	go func() {
@@ -50,6 +53,8 @@ func (c *Container) prepare() error {
 		// Set up network namespace if not already set up
 		noNetNS := c.state.NetNS == ""
 		if c.config.CreateNetNS && noNetNS && !c.config.PostConfigureNetNS {
+			defer c.startTimingSpan(timingNetworkSetup, c.networkSetupDetail())()
+
 			c.reservedPorts, createNetNSErr = c.bindPorts()
 			if createNetNSErr != nil {
 				return
@@ -70,7 +75,9 @@ func (c *Container) prepare() error {
 	// Mount storage if not mounted
 	go func() {
 		defer wg.Done()
+		endSpan := c.startTimingSpan(timingStorageMount, "")
 		mountPoint, mountStorageErr = c.mountStorage()
+		endSpan()
 
 		if mountStorageErr != nil {
 			return
@@ -404,6 +411,13 @@ func (c *Container) addMaskedPaths(g *generate.Generator) {
 	// There are currently no FreeBSD-specific masked paths
 }
 
+func (c *Container) addSeccompNotify(g *generate.Generator) error {
+	if c.config.SeccompNotifyPolicy != nil {
+		return fmt.Errorf("seccomp notify: %w", define.ErrOSNotSupported)
+	}
+	return nil
+}
+
 func (c *Container) hasPrivateUTS() bool {
 	// Currently we always use a private UTS namespace on FreeBSD. This
 	// should be optional but needs a FreeBSD section in the OCI runtime
@@ -71,6 +71,8 @@ func (c *Container) prepare() error {
 	shutdown.Inhibit()
 	defer shutdown.Uninhibit()
 
+	c.resetTiming()
+
 	wg.Add(2)
 
 	go func() {
@@ -86,6 +88,8 @@ func (c *Container) prepare() error {
 		// Set up network namespace if not already set up
 		noNetNS := c.state.NetNS == ""
 		if c.config.CreateNetNS && noNetNS && !c.config.PostConfigureNetNS {
+			defer c.startTimingSpan(timingNetworkSetup, c.networkSetupDetail())()
+
 			c.reservedPorts, createNetNSErr = c.bindPorts()
 			if createNetNSErr != nil {
 				return
@@ -107,7 +111,9 @@ func (c *Container) prepare() error {
 	// Mount storage if not mounted
 	go func() {
 		defer wg.Done()
+		endSpan := c.startTimingSpan(timingStorageMount, "")
 		mountPoint, mountStorageErr = c.mountStorage()
+		endSpan()
 
 		if mountStorageErr != nil {
 			return
@@ -819,6 +825,28 @@ func (c *Container) addMaskedPaths(g *generate.Generator) {
 	}
 }
 
+// addSeccompNotify passes the syscalls of the seccomp notify policy of the
+// container to its listener.
+func (c *Container) addSeccompNotify(g *generate.Generator) error {
+	if c.config.SeccompNotifyPolicy == nil {
+		return nil
+	}
+	seccomp, err := c.config.SeccompNotifyPolicy.Apply(g.Config.Linux.Seccomp)
+	if err != nil {
+		return fmt.Errorf("applying seccomp notify policy: %w", err)
+	}
+	seccomp.ListenerPath = c.seccompNotifySocketPath()
+	g.Config.Linux.Seccomp = seccomp
+	return nil
+}
+
+// seccompNotifySocketPath is the socket of the seccomp notify listener.  It
+// is not in the run directory of the container, whose path is often too
+// long for a unix socket.
+func (c *Container) seccompNotifySocketPath() string {
+	return filepath.Join(c.runtime.config.Engine.TmpDir, "seccomp", c.ID()+".sock")
+}
+
 func (c *Container) hasPrivateUTS() bool {
 	privateUTS := false
 	if c.config.Spec.Linux != nil {
@@ -0,0 +1,43 @@
+//go:build !remote
+
+package libpod
+
+// ContainerQuery describes conditions on the indexed fields of containers
+// that the state can evaluate while loading containers, so that containers
+// which cannot match a filter are never decoded.
+// All non-empty fields must match; the values of a single field are
+// alternatives.
+// A query only narrows down the candidates: states may return containers
+// that do not match it, so ContainerFilter functions must still be applied.
+// Only fields which do not change while a container exists are indexed; the
+// status and exit code of a container must be filtered after its state has
+// been synced with the OCI runtime.
+type ContainerQuery struct {
+	// IDPrefixes matches containers whose ID starts with one of the
+	// prefixes.
+	IDPrefixes []string
+	// NameSubstrings matches containers whose name contains one of the
+	// strings.
+	NameSubstrings []string
+	// Labels must all be set on the container.
+	Labels []ContainerQueryLabel
+	// PodIDs matches containers in one of the pods. If it is non-nil but
+	// empty, no container matches.
+	PodIDs []string
+	// Images matches containers whose image ID equals one of the values
+	// or whose image name contains one of them.
+	Images []string
+}
+
+// ContainerQueryLabel is a label condition of a ContainerQuery.
+type ContainerQueryLabel struct {
+	// Key of the label.
+	Key string
+	// Value of the label. If empty, any value matches.
+	Value string
+}
+
+// matchesNothing returns true if no container can match the query.
+func (q *ContainerQuery) matchesNothing() bool {
+	return q != nil && q.PodIDs != nil && len(q.PodIDs) == 0
+}
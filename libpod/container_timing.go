@@ -0,0 +1,81 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+)
+
+// Names of the timing spans of starting a container.
+const (
+	timingStorageMount   = "storage-mount"
+	timingNetworkSetup   = "network-setup"
+	timingSpecGeneration = "spec-generation"
+	timingHooks          = "hooks"
+	timingConmonSpawn    = "conmon-spawn"
+	timingRuntimeCreate  = "runtime-create"
+	timingRuntimeStart   = "runtime-start"
+	timingHealthcheck    = "healthcheck-timer"
+)
+
+// startTimingSpan starts measuring a step of starting the container if
+// timing is enabled for it. The returned function ends the span and adds it
+// to the state of the container, which the caller has to save.
+func (c *Container) startTimingSpan(name, detail string) func() {
+	if !c.config.Timing {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		span := define.TimingSpan{
+			Name:     name,
+			Detail:   detail,
+			Start:    start,
+			Duration: time.Since(start),
+		}
+		// Spans may end concurrently, e.g. storage and network setup.
+		c.timingLock.Lock()
+		defer c.timingLock.Unlock()
+		c.state.Timing = append(c.state.Timing, span)
+	}
+}
+
+// resetTiming drops the timing spans of the previous start of the container.
+func (c *Container) resetTiming() {
+	c.state.Timing = nil
+}
+
+// networkSetupDetail returns the tool setting up the network of the
+// container, for the network setup timing span.
+func (c *Container) networkSetupDetail() string {
+	switch {
+	case c.config.NetMode.IsPasta():
+		return "pasta"
+	case c.config.NetMode.IsSlirp4netns():
+		return "slirp4netns"
+	case c.config.NetMode.IsBridge():
+		return c.runtime.config.Network.NetworkBackend
+	}
+	return string(c.config.NetMode)
+}
+
+// Timing returns the timing spans of the last start of the container, if
+// timing is enabled for it.
+// If the container was started by this process and has been removed since,
+// e.g. by --rm, the spans known to this process are returned.
+func (c *Container) Timing() ([]define.TimingSpan, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			if !errors.Is(err, define.ErrNoSuchCtr) && !errors.Is(err, define.ErrCtrRemoved) {
+				return nil, err
+			}
+		}
+	}
+	return append([]define.TimingSpan(nil), c.state.Timing...), nil
+}
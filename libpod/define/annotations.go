@@ -57,6 +57,12 @@ const (
 	// If an annotation with this key is found in the OCI spec, it will be
 	// used in the output of Inspect().
 	InspectAnnotationSeccomp = "io.podman.annotations.seccomp"
+	// InspectAnnotationSeccompNotify is used by Inspect to identify
+	// containers with a seccomp notify policy. It is used to populate the
+	// output of the SecurityOpt setting in Inspect.
+	// If an annotation with this key is found in the OCI spec, it will be
+	// used in the output of Inspect().
+	InspectAnnotationSeccompNotify = "io.podman.annotations.seccomp-notify"
 	// InspectAnnotationApparmor is used by Inspect to identify containers
 	// with special Apparmor-related settings. It is used to populate the
 	// output of the SecurityOpt setting.
@@ -169,6 +175,21 @@ const (
 	// KubeImageAutomountAnnotation
 	KubeImageAutomountAnnotation = "io.podman.annotations.kube.image.volumes.mount"
 
+	// EphemeralContainerAnnotation marks temporary debug containers of a
+	// pod, created by pod debug or for the ephemeral containers of a kube
+	// yaml.  The value is the name of the target container, if any.
+	EphemeralContainerAnnotation = "io.podman.annotations.ephemeral"
+
+	// KubeImagePullSecretsAnnotation is used by kube play to record the
+	// comma-separated image pull secrets of the pod of a container, so
+	// kube generate can emit them again.
+	KubeImagePullSecretsAnnotation = "io.podman.annotations.kube.image.pull.secrets"
+
+	// LogRateLimitAnnotation is used by kube play to set the log rate limit
+	// of the containers of a pod, e.g. rate=100/s,burst=200.  Append
+	// /<container name> to set it for a single container.
+	LogRateLimitAnnotation = "io.podman.annotations.log.ratelimit"
+
 	// TotalAnnotationSizeLimitB is the max length of annotations allowed by Kubernetes.
 	TotalAnnotationSizeLimitB int = 256 * (1 << 10) // 256 kB
 )
@@ -177,7 +198,7 @@ const (
 // already reserved annotation that Podman sets during container creation.
 func IsReservedAnnotation(value string) bool {
 	switch value {
-	case InspectAnnotationCIDFile, InspectAnnotationAutoremove, InspectAnnotationPrivileged, InspectAnnotationPublishAll, InspectAnnotationInit, InspectAnnotationLabel, InspectAnnotationSeccomp, InspectAnnotationApparmor, InspectResponseTrue, InspectResponseFalse, VolumesFromAnnotation:
+	case InspectAnnotationCIDFile, InspectAnnotationAutoremove, InspectAnnotationPrivileged, InspectAnnotationPublishAll, InspectAnnotationInit, InspectAnnotationLabel, InspectAnnotationSeccomp, InspectAnnotationSeccompNotify, InspectAnnotationApparmor, InspectResponseTrue, InspectResponseFalse, VolumesFromAnnotation, KubeImagePullSecretsAnnotation, EphemeralContainerAnnotation:
 		return true
 
 	default:
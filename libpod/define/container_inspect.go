@@ -200,6 +200,21 @@ type InspectLogConfig struct {
 	Tag string `json:"Tag"`
 	// Size specifies a maximum size of the container log
 	Size string `json:"Size"`
+	// RateLimit is the log rate limit of the container, if any.
+	RateLimit *InspectLogRateLimit `json:"RateLimit,omitempty"`
+}
+
+// InspectLogRateLimit holds the log rate limit of a container and how many
+// lines it let through and suppressed.
+type InspectLogRateLimit struct {
+	// Rate is the number of lines per second the container may log.
+	Rate string `json:"Rate"`
+	// Burst is the number of lines the container may log at once.
+	Burst uint64 `json:"Burst"`
+	// Lines is the number of lines written to the log.
+	Lines uint64 `json:"Lines"`
+	// Suppressed is the number of lines dropped by the rate limit.
+	Suppressed uint64 `json:"Suppressed"`
 }
 
 // InspectBlkioWeightDevice holds information about the relative weight
@@ -794,6 +809,7 @@ type InspectContainerData struct {
 	IsService               bool                        `json:"IsService"`
 	KubeExitCodePropagation string                      `json:"KubeExitCodePropagation"`
 	LockNumber              uint32                      `json:"lockNumber"`
+	Timing                  []TimingSpan                `json:"Timing,omitempty"`
 	Config                  *InspectContainerConfig     `json:"Config"`
 	HostConfig              *InspectContainerHostConfig `json:"HostConfig"`
 }
@@ -1,5 +1,10 @@
 package define
 
+import (
+	"github.com/containers/storage/pkg/archive"
+	digest "github.com/opencontainers/go-digest"
+)
+
 // extra type to use as enum
 type DiffType uint8
 
@@ -24,3 +29,21 @@ func (d DiffType) String() string {
 		return "unknown"
 	}
 }
+
+// FileDiff describes the content changes of a regular file in a container
+// compared to its image.
+type FileDiff struct {
+	// Path of the file in the container.
+	Path string `json:"path"`
+	// Kind of the change.
+	Kind archive.ChangeType `json:"-"`
+	// Binary is set if the old or new content is not text or too large to
+	// be compared line by line.  Only the sizes and digests are set then.
+	Binary    bool          `json:"binary"`
+	OldSize   int64         `json:"oldSize"`
+	NewSize   int64         `json:"newSize"`
+	OldDigest digest.Digest `json:"oldDigest,omitempty"`
+	NewDigest digest.Digest `json:"newDigest,omitempty"`
+	// Diff is the unified diff of the old and new content of text files.
+	Diff string `json:"diff,omitempty"`
+}
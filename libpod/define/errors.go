@@ -217,4 +217,8 @@ var (
 	// ErrRemovingCtrs indicates that there was an error removing all
 	// containers from a pod.
 	ErrRemovingCtrs = errors.New("removing pod containers")
+
+	// ErrAdmissionDenied indicates that the creation of a container was
+	// denied by the admission policy.
+	ErrAdmissionDenied = errors.New("container creation denied by admission policy")
 )
@@ -3,6 +3,7 @@ package define
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/containers/image/v5/manifest"
 )
@@ -154,4 +155,9 @@ type StartupHealthCheck struct {
 	// as passed.
 	// If set to 0, a single success will mark the HC as passed.
 	Successes int `json:",omitempty"`
+	// MaxDuration is the time after the start of the container at which
+	// a failing startup HC ends and the regular HC takes over, like the
+	// start period of Docker healthchecks. The container is not restarted.
+	// If set to 0, the startup HC runs until it passes.
+	MaxDuration time.Duration `json:",omitempty"`
 }
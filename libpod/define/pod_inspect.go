@@ -44,6 +44,9 @@ type InspectPodData struct {
 	// InfraConfig is the configuration of the infra container of the pod.
 	// Will only be set if CreateInfra is true.
 	InfraConfig *InspectPodInfraConfig `json:"InfraConfig,omitempty"`
+	// InfraHealth is the health status of the infra container of the pod,
+	// if it has a healthcheck.
+	InfraHealth string `json:"InfraHealth,omitempty"`
 	// SharedNamespaces contains a list of namespaces that will be shared by
 	// containers within the pod. Can only be set if CreateInfra is true.
 	SharedNamespaces []string `json:"SharedNamespaces,omitempty"`
@@ -98,6 +101,8 @@ type InspectPodInfraConfig struct {
 	// HostNetwork is whether the infra container (and thus the whole pod)
 	// will use the host's network and not create a network namespace.
 	HostNetwork bool
+	// NoImage is whether the infra container runs without an image.
+	NoImage bool `json:"NoImage,omitempty"`
 	// StaticIP is a static IPv4 that will be assigned to the infra
 	// container and then used by the pod.
 	// swagger:strfmt ipv4
@@ -0,0 +1,16 @@
+package define
+
+import "time"
+
+// TimingSpan is the duration of a step of starting a container, recorded
+// for containers created with timing enabled.
+type TimingSpan struct {
+	// Name of the step, e.g. "storage-mount" or "runtime-start".
+	Name string `json:"Name"`
+	// Detail describes the step further, e.g. the network backend.
+	Detail string `json:"Detail,omitempty"`
+	// Start is the time the step started.
+	Start time.Time `json:"Start"`
+	// Duration of the step.
+	Duration time.Duration `json:"Duration"`
+}
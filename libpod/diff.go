@@ -3,11 +3,27 @@
 package libpod
 
 import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/layers"
+	"github.com/containers/storage"
 	"github.com/containers/storage/pkg/archive"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 var initInodes = map[string]bool{
@@ -81,3 +97,254 @@ func (r *Runtime) getLayerID(id string, diffType define.DiffType) (string, error
 	}
 	return "", fmt.Errorf("%s not found: %w", id, lastErr)
 }
+
+// maxTextDiffSize is the maximum size of files compared line by line.
+const maxTextDiffSize = 1024 * 1024
+
+// GetDiffContent returns the content changes of the regular files in the
+// container compared to its image.  If paths are given, only files at or
+// below them are compared.
+func (r *Runtime) GetDiffContent(ctr *Container, paths []string) ([]define.FileDiff, error) {
+	changes, err := r.GetDiff("", ctr.ID(), define.DiffContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID, _ := ctr.Image()
+	if imageID == "" {
+		return nil, fmt.Errorf("container %s was not created from an image: %w", ctr.ID(), define.ErrInvalidArg)
+	}
+	img, _, err := r.libimageRuntime.LookupImage(imageID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("looking up image of container %s: %w", ctr.ID(), err)
+	}
+	oldRoot, err := img.Mount(context.Background(), nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("mounting image of container %s: %w", ctr.ID(), err)
+	}
+	defer func() {
+		if err := img.Unmount(false); err != nil {
+			logrus.Errorf("Unmounting image %s: %v", img.ID(), err)
+		}
+	}()
+	newRoot, err := r.store.Mount(ctr.ID(), ctr.MountLabel())
+	if err != nil {
+		return nil, fmt.Errorf("mounting container %s: %w", ctr.ID(), err)
+	}
+	defer func() {
+		if _, err := r.store.Unmount(ctr.ID(), false); err != nil {
+			logrus.Errorf("Unmounting container %s: %v", ctr.ID(), err)
+		}
+	}()
+
+	var diffs []define.FileDiff
+	for _, change := range changes {
+		if !MatchesDiffPaths(change.Path, paths) {
+			continue
+		}
+		diff, err := fileContentDiff(oldRoot, newRoot, change)
+		if err != nil {
+			return nil, err
+		}
+		if diff != nil {
+			diffs = append(diffs, *diff)
+		}
+	}
+	return diffs, nil
+}
+
+// ExportDiff writes the changes of the container compared to its image as a
+// tar archive in the layer format to out, i.e. deleted files are recorded as
+// whiteout files.  If paths are given, only changes at or below them are
+// written.
+func (r *Runtime) ExportDiff(ctr *Container, paths []string, out io.Writer) error {
+	layerID, err := r.getLayerID(ctr.ID(), define.DiffContainer)
+	if err != nil {
+		return err
+	}
+	layer, err := r.store.Layer(layerID)
+	if err != nil {
+		return err
+	}
+	compression := archive.Uncompressed
+	diff, err := r.store.Diff(layer.Parent, layerID, &storage.DiffOptions{Compression: &compression})
+	if err != nil {
+		return fmt.Errorf("getting changes of container %s: %w", ctr.ID(), err)
+	}
+	defer diff.Close()
+
+	tr := tar.NewReader(diff)
+	tw := tar.NewWriter(out)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading changes of container %s: %w", ctr.ID(), err)
+		}
+		path := filepath.Join("/", hdr.Name)
+		dir, base := filepath.Split(path)
+		switch {
+		case base == archive.WhiteoutOpaqueDir:
+			path = filepath.Clean(dir)
+		case strings.HasPrefix(base, archive.WhiteoutPrefix):
+			path = filepath.Join(dir, strings.TrimPrefix(base, archive.WhiteoutPrefix))
+		}
+		if initInodes[path] {
+			continue
+		}
+		// Keep the parent directories of the selected paths so the
+		// archive preserves their metadata.
+		if !MatchesDiffPaths(path, paths) && (hdr.Typeflag != tar.TypeDir || !isDiffPathParent(path, paths)) {
+			continue
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// MatchesDiffPaths returns whether path is at or below one of paths.  All
+// paths match if paths is empty.
+func MatchesDiffPaths(path string, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		if isSubDir(path, filepath.Join("/", p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDiffPathParent returns whether path is a parent directory of one of paths.
+func isDiffPathParent(path string, paths []string) bool {
+	for _, p := range paths {
+		if isSubDir(filepath.Join("/", p), path) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileContent is the content of a regular file.
+type fileContent struct {
+	size   int64
+	digest digest.Digest
+	// text is the content if it is text and not larger than
+	// maxTextDiffSize.
+	text   string
+	isText bool
+}
+
+// fileContentDiff compares the content of the file changed by change in the
+// old and new root.  Nil is returned if the file is not a regular file in
+// either root or the content did not change.
+func fileContentDiff(oldRoot, newRoot string, change archive.Change) (*define.FileDiff, error) {
+	var oldContent, newContent *fileContent
+	var err error
+	if change.Kind != archive.ChangeAdd {
+		if oldContent, err = readFileContent(oldRoot, change.Path); err != nil {
+			return nil, err
+		}
+	}
+	if change.Kind != archive.ChangeDelete {
+		if newContent, err = readFileContent(newRoot, change.Path); err != nil {
+			return nil, err
+		}
+	}
+	if oldContent == nil && newContent == nil {
+		return nil, nil
+	}
+
+	diff := &define.FileDiff{Path: change.Path, Kind: change.Kind}
+	fromFile, toFile := "/dev/null", "/dev/null"
+	var oldLines, newLines []string
+	if oldContent != nil {
+		diff.OldSize, diff.OldDigest = oldContent.size, oldContent.digest
+		diff.Binary = !oldContent.isText
+		fromFile, oldLines = "a"+change.Path, splitLines(oldContent.text)
+	}
+	if newContent != nil {
+		diff.NewSize, diff.NewDigest = newContent.size, newContent.digest
+		diff.Binary = diff.Binary || !newContent.isText
+		toFile, newLines = "b"+change.Path, splitLines(newContent.text)
+	}
+	if diff.OldDigest == diff.NewDigest {
+		return nil, nil
+	}
+	if diff.Binary {
+		return diff, nil
+	}
+	diff.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        oldLines,
+		B:        newLines,
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comparing %s: %w", change.Path, err)
+	}
+	return diff, nil
+}
+
+// readFileContent reads the file at path in root.  Nil is returned if it
+// does not exist or is not a regular file.
+func readFileContent(root, path string) (*fileContent, error) {
+	dir, err := securejoin.SecureJoin(root, filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	// Do not follow a symlink which may point outside of root.
+	f, err := os.OpenFile(filepath.Join(dir, filepath.Base(path)), os.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK, 0)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, unix.ELOOP) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !st.Mode().IsRegular() {
+		return nil, nil
+	}
+
+	var text bytes.Buffer
+	var reader io.Reader = f
+	if st.Size() <= maxTextDiffSize {
+		reader = io.TeeReader(f, &text)
+	}
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(digester.Hash(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	content := &fileContent{size: size, digest: digester.Digest()}
+	if size <= maxTextDiffSize && int64(text.Len()) == size && utf8.Valid(text.Bytes()) && !bytes.ContainsRune(text.Bytes(), 0) {
+		content.text, content.isText = text.String(), true
+	}
+	return content, nil
+}
+
+// splitLines splits text into lines for difflib, each ending with a newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
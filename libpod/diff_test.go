@@ -0,0 +1,73 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/storage/pkg/archive"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesDiffPaths(t *testing.T) {
+	assert.True(t, MatchesDiffPaths("/etc/hosts", nil))
+	assert.True(t, MatchesDiffPaths("/etc/hosts", []string{"/etc"}))
+	assert.True(t, MatchesDiffPaths("/etc/hosts", []string{"etc/"}))
+	assert.True(t, MatchesDiffPaths("/etc/hosts", []string{"/usr", "/etc/hosts"}))
+	assert.True(t, MatchesDiffPaths("/etc/hosts", []string{"/"}))
+	assert.False(t, MatchesDiffPaths("/etc", []string{"/etc/hosts"}))
+	assert.False(t, MatchesDiffPaths("/etcd/conf", []string{"/etc"}))
+
+	assert.True(t, isDiffPathParent("/", []string{"/etc/hosts"}))
+	assert.True(t, isDiffPathParent("/etc", []string{"/etc/hosts"}))
+	assert.False(t, isDiffPathParent("/usr", []string{"/etc/hosts"}))
+}
+
+func TestFileContentDiff(t *testing.T) {
+	oldRoot, newRoot := t.TempDir(), t.TempDir()
+	writeFile := func(root, path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(content), 0o644))
+	}
+	writeFile(oldRoot, "/etc/app.conf", "a\nb\nc\n")
+	writeFile(newRoot, "/etc/app.conf", "a\nB\nc\n")
+	writeFile(newRoot, "/etc/new.conf", "new")
+	writeFile(oldRoot, "/bin/tool", "\x7fELF\x00\x01")
+	writeFile(newRoot, "/bin/tool", "\x7fELF\x00\x02\x03")
+	writeFile(oldRoot, "/etc/same", "same\n")
+	writeFile(newRoot, "/etc/same", "same\n")
+	require.NoError(t, os.Symlink("/etc/app.conf", filepath.Join(newRoot, "/etc/link")))
+
+	diff, err := fileContentDiff(oldRoot, newRoot, archive.Change{Path: "/etc/app.conf", Kind: archive.ChangeModify})
+	require.NoError(t, err)
+	assert.False(t, diff.Binary)
+	assert.Equal(t, int64(6), diff.OldSize)
+	assert.Equal(t, digest.FromString("a\nB\nc\n"), diff.NewDigest)
+	assert.Equal(t, "--- a/etc/app.conf\n+++ b/etc/app.conf\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", diff.Diff)
+
+	diff, err = fileContentDiff(oldRoot, newRoot, archive.Change{Path: "/etc/new.conf", Kind: archive.ChangeAdd})
+	require.NoError(t, err)
+	assert.Equal(t, "--- /dev/null\n+++ b/etc/new.conf\n@@ -0,0 +1 @@\n+new\n", diff.Diff)
+
+	diff, err = fileContentDiff(oldRoot, newRoot, archive.Change{Path: "/etc/app.conf", Kind: archive.ChangeDelete})
+	require.NoError(t, err)
+	assert.Equal(t, "--- a/etc/app.conf\n+++ /dev/null\n@@ -1,3 +0,0 @@\n-a\n-b\n-c\n", diff.Diff)
+
+	diff, err = fileContentDiff(oldRoot, newRoot, archive.Change{Path: "/bin/tool", Kind: archive.ChangeModify})
+	require.NoError(t, err)
+	assert.True(t, diff.Binary)
+	assert.Empty(t, diff.Diff)
+	assert.Equal(t, int64(6), diff.OldSize)
+	assert.Equal(t, int64(7), diff.NewSize)
+
+	// Unchanged content and files which are not regular files are skipped.
+	for _, path := range []string{"/etc/same", "/etc/link", "/etc"} {
+		diff, err = fileContentDiff(oldRoot, newRoot, archive.Change{Path: path, Kind: archive.ChangeModify})
+		require.NoError(t, err)
+		assert.Nil(t, diff, path)
+	}
+}
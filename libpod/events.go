@@ -64,6 +64,11 @@ func (c *Container) newContainerEventWithInspectData(status events.Status, healt
 		PodID:      c.PodID(),
 		Attributes: c.Labels(),
 	}
+	if status == events.Start {
+		for _, span := range c.state.Timing {
+			e.Details.Attributes["timing."+span.Name] = span.Duration.String()
+		}
+	}
 
 	if inspectData {
 		err := func() error {
@@ -181,6 +186,19 @@ func (r *Runtime) NewSystemEvent(status events.Status) {
 	}
 }
 
+// NewImageEvent creates a new event for an image.  It is meant for image
+// operations which are not done through libimage, whose events are written
+// automatically.
+func (r *Runtime) NewImageEvent(status events.Status, id, name string) {
+	e := events.NewEvent(status)
+	e.ID = id
+	e.Name = name
+	e.Type = events.Image
+	if err := r.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write image event: %q", err)
+	}
+}
+
 // newVolumeEvent creates a new event for a libpod volume
 func (v *Volume) newVolumeEvent(status events.Status) {
 	e := events.NewEvent(status)
@@ -45,6 +45,11 @@ type Event struct {
 	HealthFailingStreak int `json:"health_failing_streak,omitempty"`
 	// Error code for certain events involving errors.
 	Error string `json:"error,omitempty"`
+	// SequenceID is the position of the event in the events log.  It
+	// increases monotonically and can be used to resume reading events
+	// after the last one seen, see ReadOptions.AfterID.  Events written
+	// by older versions have no sequence ID.
+	SequenceID uint64 `json:",omitempty"`
 
 	Details
 }
@@ -92,6 +97,8 @@ type ReadResult struct {
 
 // ReadOptions describe the attributes needed to read event logs
 type ReadOptions struct {
+	// AfterID only reads events with a sequence ID greater than AfterID
+	AfterID uint64
 	// EventChannel is the comm path back to user
 	EventChannel chan ReadResult
 	// Filters are key/value pairs that describe to limit output
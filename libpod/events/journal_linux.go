@@ -7,11 +7,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/containers/podman/v5/pkg/util"
+	"github.com/containers/storage/pkg/lockfile"
 	"github.com/coreos/go-systemd/v22/journal"
 	"github.com/coreos/go-systemd/v22/sdjournal"
 	"github.com/sirupsen/logrus"
@@ -27,16 +30,35 @@ type EventJournalD struct {
 
 // newEventJournalD creates a new journald Eventer
 func newEventJournalD(options EventerOptions) (Eventer, error) {
+	// The sequence file of the events lives next to the events log file.
+	if err := os.MkdirAll(filepath.Dir(options.LogFilePath), 0700); err != nil {
+		return nil, fmt.Errorf("creating events dirs: %w", err)
+	}
 	return EventJournalD{options}, nil
 }
 
 // Write to journald
 func (e EventJournalD) Write(ee Event) error {
+	// The lock makes sure that sequence IDs are sent to the journal in
+	// order.
+	lock, err := lockfile.GetLockFile(e.options.LogFilePath + ".lock")
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	ee.SequenceID, err = nextSequenceID(sequenceFilePath(e.options.LogFilePath), lastJournalSequenceID)
+	if err != nil {
+		return err
+	}
+
 	m := make(map[string]string)
 	m["SYSLOG_IDENTIFIER"] = "podman"
 	m["PODMAN_EVENT"] = ee.Status.String()
 	m["PODMAN_TYPE"] = ee.Type.String()
 	m["PODMAN_TIME"] = ee.Time.Format(time.RFC3339Nano)
+	m["PODMAN_SEQUENCE_ID"] = strconv.FormatUint(ee.SequenceID, 10)
 
 	// Add specialized information based on the podman type
 	switch ee.Type {
@@ -111,7 +133,7 @@ func (e EventJournalD) Read(ctx context.Context, options ReadOptions) (retErr er
 		}
 	}
 
-	j, err := sdjournal.NewJournal()
+	j, err := openEventJournal()
 	if err != nil {
 		return err
 	}
@@ -122,23 +144,8 @@ func (e EventJournalD) Read(ctx context.Context, options ReadOptions) (retErr er
 			}
 		}
 	}()
-	err = j.SetDataThreshold(0)
-	if err != nil {
-		return fmt.Errorf("cannot set data threshold for journal: %v", err)
-	}
-	// match only podman journal entries
-	podmanJournal := sdjournal.Match{Field: "SYSLOG_IDENTIFIER", Value: "podman"}
-	if err := j.AddMatch(podmanJournal.String()); err != nil {
-		return fmt.Errorf("failed to add SYSLOG_IDENTIFIER journal filter for event log: %w", err)
-	}
 
-	// make sure we only read events for the current user
-	uidMatch := sdjournal.Match{Field: "_UID", Value: strconv.Itoa(rootless.GetRootlessUID())}
-	if err := j.AddMatch(uidMatch.String()); err != nil {
-		return fmt.Errorf("failed to add _UID journal filter for event log: %w", err)
-	}
-
-	if len(options.Since) == 0 && len(options.Until) == 0 && options.Stream {
+	if len(options.Since) == 0 && len(options.Until) == 0 && options.Stream && options.AfterID == 0 {
 		if err := j.SeekTail(); err != nil {
 			return fmt.Errorf("failed to seek end of journal: %w", err)
 		}
@@ -187,6 +194,9 @@ func (e EventJournalD) Read(ctx context.Context, options ReadOptions) (retErr er
 				}
 				continue
 			}
+			if options.AfterID > 0 && newEvent.SequenceID <= options.AfterID {
+				continue
+			}
 			if applyFilters(newEvent, filterMap) {
 				options.EventChannel <- ReadResult{Event: newEvent}
 			}
@@ -195,6 +205,73 @@ func (e EventJournalD) Read(ctx context.Context, options ReadOptions) (retErr er
 	return nil
 }
 
+// openEventJournal opens the journal matching only the podman events of the
+// current user.
+func openEventJournal() (_ *sdjournal.Journal, retErr error) {
+	j, err := sdjournal.NewJournal()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := j.Close(); err != nil {
+				logrus.Errorf("Unable to close journal :%v", err)
+			}
+		}
+	}()
+	err = j.SetDataThreshold(0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot set data threshold for journal: %v", err)
+	}
+	// match only podman journal entries
+	podmanJournal := sdjournal.Match{Field: "SYSLOG_IDENTIFIER", Value: "podman"}
+	if err := j.AddMatch(podmanJournal.String()); err != nil {
+		return nil, fmt.Errorf("failed to add SYSLOG_IDENTIFIER journal filter for event log: %w", err)
+	}
+
+	// make sure we only read events for the current user
+	uidMatch := sdjournal.Match{Field: "_UID", Value: strconv.Itoa(rootless.GetRootlessUID())}
+	if err := j.AddMatch(uidMatch.String()); err != nil {
+		return nil, fmt.Errorf("failed to add _UID journal filter for event log: %w", err)
+	}
+	return j, nil
+}
+
+// lastJournalSequenceID returns the sequence ID of the newest event in the
+// journal.  It is only needed when the sequence file is gone, e.g. after a
+// reboot, while the journal persisted.
+func lastJournalSequenceID() (uint64, error) {
+	j, err := openEventJournal()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := j.Close(); err != nil {
+			logrus.Errorf("Unable to close journal :%v", err)
+		}
+	}()
+
+	if err := j.SeekTail(); err != nil {
+		return 0, fmt.Errorf("failed to seek end of journal: %w", err)
+	}
+	for {
+		ret, err := j.Previous()
+		if err != nil {
+			return 0, fmt.Errorf("failed to move journal cursor to previous entry: %w", err)
+		}
+		if ret == 0 {
+			// no event has a sequence ID yet
+			return 0, nil
+		}
+		value, err := j.GetDataValue("PODMAN_SEQUENCE_ID")
+		if err != nil {
+			// written by an older version, keep looking
+			continue
+		}
+		return strconv.ParseUint(value, 10, 64)
+	}
+}
+
 func newEventFromJournalEntry(entry *sdjournal.JournalEntry) (*Event, error) {
 	newEvent := Event{}
 	eventType, err := StringToType(entry.Fields["PODMAN_TYPE"])
@@ -213,6 +290,12 @@ func newEventFromJournalEntry(entry *sdjournal.JournalEntry) (*Event, error) {
 	newEvent.Time = eventTime
 	newEvent.Status = eventStatus
 	newEvent.Name = entry.Fields["PODMAN_NAME"]
+	if seq, ok := entry.Fields["PODMAN_SEQUENCE_ID"]; ok {
+		newEvent.SequenceID, err = strconv.ParseUint(seq, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing event sequence ID: %w", err)
+		}
+	}
 
 	switch eventType {
 	case Container, Pod:
@@ -51,6 +51,11 @@ func (e EventLogFile) Write(ee Event) error {
 	lock.Lock()
 	defer lock.Unlock()
 
+	ee.SequenceID, err = nextSequenceID(sequenceFilePath(e.options.LogFilePath), e.lastSequenceID)
+	if err != nil {
+		return err
+	}
+
 	eventJSONString, err := ee.ToJSONString()
 	if err != nil {
 		return err
@@ -63,6 +68,35 @@ func (e EventLogFile) Write(ee Event) error {
 	return e.writeString(eventJSONString)
 }
 
+// lastSequenceID returns the highest sequence ID in the log file.
+func (e EventLogFile) lastSequenceID() (uint64, error) {
+	f, err := os.Open(e.options.LogFilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer f.Close()
+
+	var last uint64
+	// Events may carry the inspect data of a container, so lines can be
+	// longer than what a bufio.Scanner handles by default.
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadString('\n')
+		if event, err := newEventFromJSONString(line); err == nil {
+			last = max(last, event.SequenceID)
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return last, nil
+			}
+			return 0, err
+		}
+	}
+}
+
 func (e EventLogFile) writeString(s string) error {
 	f, err := os.OpenFile(e.options.LogFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0700)
 	if err != nil {
@@ -78,7 +112,7 @@ func writeToFile(s string, f *os.File) error {
 
 func (e EventLogFile) getTail(options ReadOptions) (*tail.Tail, error) {
 	seek := tail.SeekInfo{Offset: 0, Whence: io.SeekEnd}
-	if options.FromStart || !options.Stream {
+	if options.FromStart || !options.Stream || options.AfterID > 0 {
 		seek.Whence = 0
 	}
 	stream := options.Stream
@@ -200,6 +234,9 @@ func (e EventLogFile) Read(ctx context.Context, options ReadOptions) error {
 			if skipRotate {
 				continue
 			}
+			if options.AfterID > 0 && event.SequenceID <= options.AfterID {
+				continue
+			}
 			if applyFilters(event, filterMap) {
 				options.EventChannel <- ReadResult{Event: event}
 			}
@@ -3,7 +3,9 @@
 package events
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -137,3 +139,46 @@ func TestRenameLog(t *testing.T) {
 	require.NoError(t, os.Remove(target.Name()))
 	require.Equal(t, beforeRename, afterRename)
 }
+
+func TestSequenceIDs(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "events.log")
+	eventer, err := newLogFileEventer(EventerOptions{LogFilePath: logPath})
+	require.NoError(t, err)
+
+	readAfter := func(afterID uint64) []uint64 {
+		eventChannel := make(chan ReadResult)
+		err := eventer.Read(context.Background(), ReadOptions{AfterID: afterID, EventChannel: eventChannel})
+		require.NoError(t, err)
+		var ids []uint64
+		for res := range eventChannel {
+			require.NoError(t, res.Error)
+			ids = append(ids, res.Event.SequenceID)
+		}
+		return ids
+	}
+
+	for range 3 {
+		event := NewEvent(Create)
+		event.Type = Volume
+		require.NoError(t, eventer.Write(event))
+	}
+	require.Equal(t, []uint64{1, 2, 3}, readAfter(0))
+	require.Equal(t, []uint64{3}, readAfter(2))
+	require.Empty(t, readAfter(3))
+
+	// Without the sequence file, the sequence continues after the last
+	// event in the log.
+	require.NoError(t, os.Remove(sequenceFilePath(logPath)))
+	event := NewEvent(Remove)
+	event.Type = Volume
+	require.NoError(t, eventer.Write(event))
+	require.Equal(t, []uint64{4}, readAfter(3))
+
+	// Neither does a corrupted sequence file break the sequence.
+	require.NoError(t, os.WriteFile(sequenceFilePath(logPath), []byte("\x00\x00"), 0o600))
+	require.NoError(t, eventer.Write(event))
+	require.Equal(t, []uint64{5}, readAfter(4))
+	content, err := os.ReadFile(sequenceFilePath(logPath))
+	require.NoError(t, err)
+	require.Equal(t, "5", string(content))
+}
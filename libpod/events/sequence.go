@@ -0,0 +1,51 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/sirupsen/logrus"
+)
+
+// sequenceFilePath returns the path of the file storing the last sequence
+// ID handed out for the events log at logFilePath.
+func sequenceFilePath(logFilePath string) string {
+	return logFilePath + ".seq"
+}
+
+// nextSequenceID returns the next sequence ID of the events and stores it
+// in path.  If path does not exist yet or cannot be parsed, the sequence
+// continues after the ID returned by last, which is expected to find the
+// last ID in the backend.  The caller must hold the lock of the events log.
+func nextSequenceID(path string, last func() (uint64, error)) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+	id, parseErr := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil || parseErr != nil {
+		if err == nil {
+			// The file is not synced, so it may be empty or
+			// garbled after a crash.  It is rewritten below.
+			logrus.Warnf("Parsing event sequence file %s, continuing after the last event: %v", path, parseErr)
+		}
+		id, err = last()
+		if err != nil {
+			return 0, fmt.Errorf("looking up last event sequence ID: %w", err)
+		}
+	}
+
+	id++
+	// Events are written often, syncing the file every time is too costly.
+	opts := &ioutils.AtomicFileWriterOptions{NoSync: true}
+	if err := ioutils.AtomicWriteFileWithOpts(path, []byte(strconv.FormatUint(id, 10)), 0o600, opts); err != nil {
+		return 0, fmt.Errorf("writing event sequence file: %w", err)
+	}
+	return id, nil
+}
@@ -243,48 +243,52 @@ func (c *Container) incrementStartupHCSuccessCounter(ctx context.Context) {
 	logrus.Debugf("Startup healthcheck for container %s succeeded, success counter now %d", c.ID(), c.state.StartupHCSuccessCount)
 
 	// Did we exceed threshold?
-	recreateTimer := false
 	if c.config.StartupHealthCheckConfig.Successes == 0 || c.state.StartupHCSuccessCount >= c.config.StartupHealthCheckConfig.Successes {
-		c.state.StartupHCPassed = true
-		c.state.StartupHCSuccessCount = 0
-		c.state.StartupHCFailureCount = 0
+		logrus.Infof("Startup healthcheck for container %s passed, recreating timer", c.ID())
+		c.endStartupHealthCheck(ctx)
+		return
+	}
 
-		recreateTimer = true
+	if err := c.save(); err != nil {
+		logrus.Errorf("Error saving container %s state: %v", c.ID(), err)
 	}
+}
+
+// End the startup HC and start the regular HC.
+// Must be called with the container locked.
+func (c *Container) endStartupHealthCheck(ctx context.Context) {
+	c.state.StartupHCPassed = true
+	c.state.StartupHCSuccessCount = 0
+	c.state.StartupHCFailureCount = 0
 
 	if err := c.save(); err != nil {
 		logrus.Errorf("Error saving container %s state: %v", c.ID(), err)
 		return
 	}
 
-	if recreateTimer {
-		logrus.Infof("Startup healthcheck for container %s passed, recreating timer", c.ID())
-
-		oldUnit := c.state.HCUnitName
-		// Create the new, standard healthcheck timer first.
-		if err := c.createTimer(c.HealthCheckConfig().Interval.String(), false); err != nil {
-			logrus.Errorf("Error recreating container %s healthcheck: %v", c.ID(), err)
-			return
-		}
-		if err := c.startTimer(false); err != nil {
-			logrus.Errorf("Error restarting container %s healthcheck timer: %v", c.ID(), err)
-		}
+	oldUnit := c.state.HCUnitName
+	// Create the new, standard healthcheck timer first.
+	if err := c.createTimer(c.HealthCheckConfig().Interval.String(), false); err != nil {
+		logrus.Errorf("Error recreating container %s healthcheck: %v", c.ID(), err)
+		return
+	}
+	if err := c.startTimer(false); err != nil {
+		logrus.Errorf("Error restarting container %s healthcheck timer: %v", c.ID(), err)
+	}
 
-		// This kills the process the healthcheck is running.
-		// Which happens to be us.
-		// So this has to be last - after this, systemd serves us a
-		// SIGTERM and we exit.
-		// Special case, via SIGTERM we exit(1) which means systemd logs a failure in the unit.
-		// We do not want this as the unit will be leaked on failure states unless "reset-failed"
-		// is called. Fundamentally this is expected so switch it to exit 0.
-		// NOTE: This is only safe while being called from "podman healthcheck run" which we know
-		// is the case here as we should not alter the exit code of another process that just
-		// happened to call this.
-		shutdown.SetExitCode(0)
-		if err := c.removeTransientFiles(ctx, true, oldUnit); err != nil {
-			logrus.Errorf("Error removing container %s healthcheck: %v", c.ID(), err)
-			return
-		}
+	// This kills the process the healthcheck is running.
+	// Which happens to be us.
+	// So this has to be last - after this, systemd serves us a
+	// SIGTERM and we exit.
+	// Special case, via SIGTERM we exit(1) which means systemd logs a failure in the unit.
+	// We do not want this as the unit will be leaked on failure states unless "reset-failed"
+	// is called. Fundamentally this is expected so switch it to exit 0.
+	// NOTE: This is only safe while being called from "podman healthcheck run" which we know
+	// is the case here as we should not alter the exit code of another process that just
+	// happened to call this.
+	shutdown.SetExitCode(0)
+	if err := c.removeTransientFiles(ctx, true, oldUnit); err != nil {
+		logrus.Errorf("Error removing container %s healthcheck: %v", c.ID(), err)
 	}
 }
 
@@ -315,6 +319,14 @@ func (c *Container) incrementStartupHCFailureCounter(ctx context.Context) {
 
 	logrus.Debugf("Startup healthcheck for container %s failed, failure counter now %d", c.ID(), c.state.StartupHCFailureCount)
 
+	// A startup HC with a maximum duration hands over to the regular HC
+	// once it elapsed, rather than failing.
+	if maxDuration := c.config.StartupHealthCheckConfig.MaxDuration; maxDuration > 0 && time.Since(c.state.StartedTime) >= maxDuration {
+		logrus.Infof("Startup healthcheck for container %s did not pass within %s, recreating timer", c.ID(), maxDuration)
+		c.endStartupHealthCheck(ctx)
+		return
+	}
+
 	if c.config.StartupHealthCheckConfig.Retries != 0 && c.state.StartupHCFailureCount >= c.config.StartupHealthCheckConfig.Retries {
 		logrus.Infof("Restarting container %s as startup healthcheck failed", c.ID())
 		// Restart the container
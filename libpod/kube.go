@@ -613,6 +613,11 @@ func (p *Pod) podWithContainers(ctx context.Context, containers []*Container, po
 				podAnnotations[define.InfraNameAnnotation] = infraName
 			}
 		} else {
+			// Ephemeral containers are temporary additions to a
+			// running pod and not part of its definition.
+			if _, ok := ctr.config.Spec.Annotations[define.EphemeralContainerAnnotation]; ok {
+				continue
+			}
 			for k, v := range ctr.config.Spec.Annotations {
 				if !podmanOnly && (define.IsReservedAnnotation(k)) {
 					continue
@@ -681,7 +686,7 @@ func (p *Pod) podWithContainers(ctx context.Context, containers []*Container, po
 	}
 	podName := removeUnderscores(p.Name())
 
-	return newPodObject(
+	pod := newPodObject(
 		podName,
 		podAnnotations,
 		podInitCtrs,
@@ -691,7 +696,9 @@ func (p *Pod) podWithContainers(ctx context.Context, containers []*Container, po
 		hostNetwork,
 		hostUsers,
 		hostname,
-		stopTimeout), nil
+		stopTimeout)
+	setRuntimeClassAndPullSecrets(pod, containers, cfg)
+	return pod, nil
 }
 
 func newPodObject(podName string, annotations map[string]string, initCtrs, containers []v1.Container, volumes []v1.Volume, dnsOptions *v1.PodDNSConfig, hostNetwork, hostUsers bool, hostname string, stopTimeout *uint) *v1.Pod {
@@ -889,10 +896,43 @@ func simplePodWithV1Containers(ctx context.Context, ctrs []*Container, getServic
 		policy = *restartPolicy
 	}
 	pod.Spec.RestartPolicy = getPodRestartPolicy(policy)
+	setRuntimeClassAndPullSecrets(pod, ctrs, cfg)
 
 	return pod, nil
 }
 
+// setRuntimeClassAndPullSecrets sets the runtime class of the pod if all its
+// containers use the same OCI runtime of containers.conf other than the
+// default one, and the image pull secrets recorded by kube play.
+func setRuntimeClassAndPullSecrets(pod *v1.Pod, ctrs []*Container, cfg *config.Config) {
+	ociRuntimes := make(map[string]bool)
+	var pullSecrets []v1.LocalObjectReference
+	for _, ctr := range ctrs {
+		if ctr.IsInfra() {
+			continue
+		}
+		if ctr.config.OCIRuntime != ctr.runtime.defaultOCIRuntime.Name() {
+			ociRuntimes[ctr.config.OCIRuntime] = true
+		} else {
+			ociRuntimes[""] = true
+		}
+		for _, name := range strings.Split(ctr.config.Spec.Annotations[define.KubeImagePullSecretsAnnotation], ",") {
+			secret := v1.LocalObjectReference{Name: name}
+			if name != "" && !slices.Contains(pullSecrets, secret) {
+				pullSecrets = append(pullSecrets, secret)
+			}
+		}
+	}
+	if len(ociRuntimes) == 1 {
+		for name := range ociRuntimes {
+			if _, ok := cfg.Engine.OCIRuntimes[name]; ok && name != "" {
+				pod.Spec.RuntimeClassName = &name
+			}
+		}
+	}
+	pod.Spec.ImagePullSecrets = pullSecrets
+}
+
 // getPodRestartPolicy returns the pod restart policy to be set in the generated kube yaml
 func getPodRestartPolicy(policy string) v1.RestartPolicy {
 	switch policy {
@@ -963,7 +1003,7 @@ func containerToV1Container(ctx context.Context, c *Container, getService bool)
 	// missing.
 	if image == "" && c.IsInfra() {
 		image = c.runtime.config.Engine.InfraImage
-		if _, err := c.runtime.libimageRuntime.Pull(ctx, image, config.PullPolicyMissing, nil); err != nil {
+		if _, err := c.runtime.PullImage(ctx, image, config.PullPolicyMissing, nil); err != nil {
 			return kubeContainer, nil, nil, nil, err
 		}
 	}
@@ -0,0 +1,155 @@
+package logs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/storage/pkg/ioutils"
+)
+
+// RateLimit is the maximum rate at which a container may write log lines.
+type RateLimit struct {
+	// Rate is the number of lines per second a container may write on
+	// average.
+	Rate float64 `json:"rate"`
+	// Burst is the number of lines a container may write at once before
+	// Rate applies.
+	Burst uint64 `json:"burst"`
+}
+
+// RateLimitStats counts the log lines of a rate limited container.
+type RateLimitStats struct {
+	// Lines is the number of lines written to the log.
+	Lines uint64 `json:"lines"`
+	// Suppressed is the number of lines dropped by the rate limit.
+	Suppressed uint64 `json:"suppressed"`
+}
+
+// ParseRateLimit parses the values of the rate and burst log options.  The
+// rate has the form N/s, N/m or N/h.  The burst defaults to the number of
+// lines allowed per second but at least one line.
+func ParseRateLimit(rate, burst string) (*RateLimit, error) {
+	if rate == "" {
+		return nil, errors.New("log option burst requires log option rate")
+	}
+	count, unit, ok := strings.Cut(rate, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid log rate %q: must be in the form N/s, N/m or N/h", rate)
+	}
+	n, err := strconv.ParseUint(count, 10, 64)
+	if err != nil || n == 0 {
+		return nil, fmt.Errorf("invalid log rate %q: %q is not a positive number of lines", rate, count)
+	}
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return nil, fmt.Errorf("invalid log rate %q: unit must be one of s, m or h", rate)
+	}
+
+	limit := &RateLimit{Rate: float64(n) / per.Seconds()}
+	if burst == "" {
+		limit.Burst = max(uint64(limit.Rate), 1)
+		return limit, nil
+	}
+	limit.Burst, err = strconv.ParseUint(burst, 10, 64)
+	if err != nil || limit.Burst == 0 {
+		return nil, fmt.Errorf("invalid log burst %q: must be a positive number of lines", burst)
+	}
+	return limit, nil
+}
+
+// String returns the rate of the limit in lines per second.
+func (r *RateLimit) String() string {
+	return strconv.FormatFloat(r.Rate, 'f', -1, 64) + "/s"
+}
+
+// Limiter enforces a RateLimit with a token bucket, one token per line.
+type Limiter struct {
+	limit   RateLimit
+	tokens  float64
+	last    time.Time
+	pending uint64
+	stats   RateLimitStats
+}
+
+// NewLimiter returns a limiter with a full bucket.  The counters start
+// at stats, so that they keep counting across restarts of a container.
+func NewLimiter(limit RateLimit, stats RateLimitStats, now time.Time) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   now,
+		stats:  stats,
+	}
+}
+
+// Allow reports whether a line logged at the given time is within the
+// limit and counts it.
+func (l *Limiter) Allow(now time.Time) bool {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.tokens+elapsed.Seconds()*l.limit.Rate, float64(l.limit.Burst))
+		l.last = now
+	}
+	if l.tokens < 1 {
+		l.pending++
+		l.stats.Suppressed++
+		return false
+	}
+	l.tokens--
+	l.stats.Lines++
+	return true
+}
+
+// Suppressed returns the number of lines suppressed since the last call.
+func (l *Limiter) Suppressed() uint64 {
+	n := l.pending
+	l.pending = 0
+	return n
+}
+
+// Stats returns the counters of the limiter.
+func (l *Limiter) Stats() RateLimitStats {
+	return l.stats
+}
+
+// SuppressedMessage is the log line written in place of n suppressed lines.
+func SuppressedMessage(n uint64) string {
+	return fmt.Sprintf("%d lines suppressed", n)
+}
+
+// ReadRateLimitStats reads the counters of a rate limited container.  A
+// missing file means that nothing was logged yet.
+func ReadRateLimitStats(path string) (RateLimitStats, error) {
+	var stats RateLimitStats
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return stats, nil
+		}
+		return stats, err
+	}
+	if err := json.Unmarshal(content, &stats); err != nil {
+		return stats, fmt.Errorf("parsing log rate limit counters %s: %w", path, err)
+	}
+	return stats, nil
+}
+
+// WriteRateLimitStats replaces the counters of a rate limited container.
+func WriteRateLimitStats(path string, stats RateLimitStats) error {
+	content, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFileWithOpts(path, content, 0o600, &ioutils.AtomicFileWriterOptions{NoSync: true})
+}
@@ -0,0 +1,82 @@
+package logs
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    string
+		burst   string
+		want    *RateLimit
+		wantErr bool
+	}{
+		{name: "per second", rate: "100/s", burst: "200", want: &RateLimit{Rate: 100, Burst: 200}},
+		{name: "per minute", rate: "120/m", want: &RateLimit{Rate: 2, Burst: 2}},
+		{name: "per hour defaults burst to one", rate: "60/h", want: &RateLimit{Rate: 1.0 / 60, Burst: 1}},
+		{name: "burst without rate", burst: "10", wantErr: true},
+		{name: "no unit", rate: "100", wantErr: true},
+		{name: "bad unit", rate: "100/d", wantErr: true},
+		{name: "zero rate", rate: "0/s", wantErr: true},
+		{name: "negative rate", rate: "-1/s", wantErr: true},
+		{name: "zero burst", rate: "1/s", burst: "0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRateLimit(tt.rate, tt.burst)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(RateLimit{Rate: 2, Burst: 3}, RateLimitStats{Lines: 5}, now)
+
+	// the burst is available at once
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow(now))
+	}
+	assert.False(t, l.Allow(now))
+	assert.False(t, l.Allow(now))
+	assert.Equal(t, uint64(2), l.Suppressed())
+	assert.Equal(t, uint64(0), l.Suppressed())
+
+	// half a second refills one token
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, l.Allow(now))
+	assert.False(t, l.Allow(now))
+
+	// the bucket never holds more than the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow(now))
+	}
+	assert.False(t, l.Allow(now))
+
+	assert.Equal(t, RateLimitStats{Lines: 12, Suppressed: 4}, l.Stats())
+}
+
+func TestRateLimitStatsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log-ratelimit.json")
+
+	stats, err := ReadRateLimitStats(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimitStats{}, stats)
+
+	err = WriteRateLimitStats(path, RateLimitStats{Lines: 10, Suppressed: 3})
+	assert.NoError(t, err)
+	stats, err = ReadRateLimitStats(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimitStats{Lines: 10, Suppressed: 3}, stats)
+}
@@ -231,4 +231,6 @@ type HTTPAttachStreams struct {
 	Stdin  bool
 	Stdout bool
 	Stderr bool
+	// recorder, if set, records the output of a session with a terminal.
+	recorder *sessionRecorder
 }
@@ -506,10 +506,12 @@ func (r *ConmonOCIRuntime) HTTPAttach(ctr *Container, req *http.Request, w http.
 	attachStdout := true
 	attachStderr := true
 	attachStdin := true
+	var recorder *sessionRecorder
 	if streams != nil {
 		attachStdout = streams.Stdout
 		attachStderr = streams.Stderr
 		attachStdin = streams.Stdin
+		recorder = streams.recorder
 	}
 
 	logrus.Debugf("Going to hijack container %s attach connection", ctr.ID())
@@ -638,7 +640,7 @@ func (r *ConmonOCIRuntime) HTTPAttach(ctr *Container, req *http.Request, w http.
 			// anything from here.
 			logrus.Debugf("Performing terminal HTTP attach for container %s", ctr.ID())
 			if attachStdout {
-				err = httpAttachTerminalCopy(conn, httpBuf, ctr.ID())
+				err = httpAttachTerminalCopy(conn, httpBuf, ctr.ID(), recorder)
 			}
 		} else {
 			logrus.Debugf("Performing non-terminal HTTP attach for container %s", ctr.ID())
@@ -970,7 +972,7 @@ func getPreserveFdExtraFiles(preserveFD []uint, preserveFDs uint) (uint, []*os.F
 }
 
 // createOCIContainer generates this container's main conmon instance and prepares it for starting
-func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *ContainerCheckpointOptions) (int64, error) {
+func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *ContainerCheckpointOptions) (_ int64, retErr error) {
 	var stderrBuf bytes.Buffer
 
 	parentSyncPipe, childSyncPipe, err := newPipe()
@@ -1007,8 +1009,40 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 		pidfile = filepath.Join(ctr.state.RunDir, "pidfile")
 	}
 
+	// The helpers serving the container next to conmon.
+	var helpers []*conmonHelper
+	defer func() {
+		if retErr != nil {
+			for _, h := range helpers {
+				h.kill(ctr)
+			}
+		}
+	}()
+
+	logPath, logDriver := ctr.LogPath(), ctr.LogDriver()
+	if ctr.config.LogRateLimit != nil {
+		// conmon writes the logs to the relay, which passes them on to
+		// the log driver within the rate limit.
+		relay, fifo, err := r.startLogRelay(ctr, logTag)
+		if err != nil {
+			return 0, err
+		}
+		helpers = append(helpers, relay)
+		logPath, logDriver = fifo, define.KubernetesLogging
+	}
+
+	if ctr.config.SeccompNotifyPolicy != nil {
+		// The runtime connects to the listener while creating the
+		// container.
+		listener, err := r.startSeccompNotify(ctr)
+		if err != nil {
+			return 0, err
+		}
+		helpers = append(helpers, listener)
+	}
+
 	persistDir := filepath.Join(r.persistDir, ctr.ID())
-	args, err := r.sharedConmonArgs(ctr, ctr.ID(), ctr.bundlePath(), pidfile, ctr.LogPath(), r.exitsDir, persistDir, ociLog, ctr.LogDriver(), logTag)
+	args, err := r.sharedConmonArgs(ctr, ctr.ID(), ctr.bundlePath(), pidfile, logPath, r.exitsDir, persistDir, ociLog, logDriver, logTag)
 	if err != nil {
 		return 0, err
 	}
@@ -1187,7 +1221,9 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 	if restoreOptions != nil {
 		runtimeRestoreStarted = time.Now()
 	}
+	endSpan := ctr.startTimingSpan(timingConmonSpawn, r.conmonPath)
 	err = cmd.Start()
+	endSpan()
 
 	// regardless of whether we errored or not, we no longer need the children pipes
 	childSyncPipe.Close()
@@ -1212,7 +1248,9 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 		return 0, fmt.Errorf("conmon failed: %w", err)
 	}
 
+	endSpan = ctr.startTimingSpan(timingRuntimeCreate, r.name)
 	pid, err := readConmonPipeData(r.name, parentSyncPipe, ociLog)
+	endSpan()
 	if err != nil {
 		if err2 := r.DeleteContainer(ctr); err2 != nil {
 			logrus.Errorf("Removing container %s from runtime after creation failed", ctr.ID())
@@ -1229,6 +1267,9 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 		logrus.Infof("Got Conmon PID as %d", conmonPID)
 		ctr.state.ConmonPID = conmonPID
 	}
+	for _, h := range helpers {
+		h.attach(ctr, conmonPID)
+	}
 
 	runtimeRestoreDuration := func() int64 {
 		if restoreOptions != nil && restoreOptions.PrintStats {
@@ -1341,7 +1382,8 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 	if ctr.config.LogSize > 0 {
 		size = ctr.config.LogSize
 	}
-	if size > 0 {
+	// The log relay of rate limited containers enforces the size itself.
+	if size > 0 && ctr.config.LogRateLimit == nil {
 		args = append(args, "--log-size-max", strconv.FormatInt(size, 10))
 	}
 
@@ -1493,8 +1535,9 @@ func (r *ConmonOCIRuntime) getOCIRuntimeVersion() (string, error) {
 // Copy data from container to HTTP connection, for terminal attach.
 // Container is the container's attach socket connection, http is a buffer for
 // the HTTP connection. cid is the ID of the container the attach session is
-// running for (used solely for error messages).
-func httpAttachTerminalCopy(container *net.UnixConn, http *bufio.ReadWriter, cid string) error {
+// running for (used solely for error messages). The data is recorded by
+// recorder, if set.
+func httpAttachTerminalCopy(container *net.UnixConn, http *bufio.ReadWriter, cid string, recorder *sessionRecorder) error {
 	buf := make([]byte, bufferSize)
 	for {
 		numR, err := container.Read(buf)
@@ -1518,6 +1561,9 @@ func httpAttachTerminalCopy(container *net.UnixConn, http *bufio.ReadWriter, cid
 			} else if numW+1 != numR {
 				return io.ErrShortWrite
 			}
+			if recorder != nil {
+				recorder.output(buf[1:numR])
+			}
 			// We need to force the buffer to write immediately, so
 			// there isn't a delay on the terminal side.
 			if err2 := http.Flush(); err2 != nil {
@@ -548,10 +548,12 @@ func attachExecHTTP(c *Container, sessionID string, r *http.Request, w http.Resp
 	attachStdout := true
 	attachStderr := true
 	attachStdin := true
+	var recorder *sessionRecorder
 	if streams != nil {
 		attachStdout = streams.Stdout
 		attachStderr = streams.Stderr
 		attachStdin = streams.Stdin
+		recorder = streams.recorder
 	}
 
 	// Perform hijack
@@ -630,7 +632,7 @@ func attachExecHTTP(c *Container, sessionID string, r *http.Request, w http.Resp
 			// anything from here.
 			logrus.Debugf("Performing terminal HTTP attach for container %s", c.ID())
 			if attachStdout {
-				err = httpAttachTerminalCopy(conn, httpBuf, c.ID())
+				err = httpAttachTerminalCopy(conn, httpBuf, c.ID(), recorder)
 			}
 		} else {
 			logrus.Debugf("Performing non-terminal HTTP attach for container %s", c.ID())
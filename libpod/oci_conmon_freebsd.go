@@ -31,3 +31,19 @@ func (r *ConmonOCIRuntime) moveConmonToCgroupAndSignal(ctr *Container, cmd *exec
 func moveToRuntimeCgroup() error {
 	return errors.New("moveToRuntimeCgroup not supported on freebsd")
 }
+
+// conmonHelper is a process serving a container next to conmon, there are
+// none on freebsd.
+type conmonHelper struct{}
+
+func (h *conmonHelper) attach(ctr *Container, conmonPID int) {}
+
+func (h *conmonHelper) kill(ctr *Container) {}
+
+func (r *ConmonOCIRuntime) startLogRelay(ctr *Container, logTag string) (*conmonHelper, string, error) {
+	return nil, "", errors.New("log rate limits are not supported on freebsd")
+}
+
+func (r *ConmonOCIRuntime) startSeccompNotify(ctr *Container) (*conmonHelper, error) {
+	return nil, errors.New("seccomp notify is not supported on freebsd")
+}
@@ -0,0 +1,177 @@
+//go:build !remote && linux
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/containers/common/pkg/cgroups"
+	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/storage/pkg/reexec"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// conmonHelperReady is sent by a conmon helper once it is ready to
+	// serve the container.
+	conmonHelperReady = "ready"
+
+	// conmonHelperGrace is how long a conmon helper may keep running
+	// after conmon exited, e.g. to relay the last logs of the container.
+	conmonHelperGrace = 10 * time.Second
+)
+
+// conmonHelper is a process reexec'd by Podman to serve a container next
+// to conmon, e.g. the log relay.  Like conmon, it has to outlive Podman.
+// Once conmon is running, the helper joins its cgroup, so that it is
+// accounted and stopped together with conmon, and exits once conmon exited.
+type conmonHelper struct {
+	// name of the helper in messages.
+	name string
+	cmd  *exec.Cmd
+	// conmon is the pipe the PID of conmon is sent on.
+	conmon *os.File
+}
+
+// startConmonHelper reexecs command with the config as its only argument
+// and waits until the helper is ready.
+func startConmonHelper(ctr *Container, command, name string, config any) (*conmonHelper, error) {
+	arg, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	readyR, readyW, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating %s pipe: %w", name, err)
+	}
+	defer readyR.Close()
+	conmonR, conmonW, err := os.Pipe()
+	if err != nil {
+		readyW.Close()
+		return nil, fmt.Errorf("creating %s pipe: %w", name, err)
+	}
+
+	cmd := reexec.Command(command, string(arg))
+	cmd.ExtraFiles = []*os.File{readyW, conmonR}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	err = cmd.Start()
+	readyW.Close()
+	conmonR.Close()
+	if err != nil {
+		conmonW.Close()
+		return nil, fmt.Errorf("starting %s: %w", name, err)
+	}
+	// Reap the helper if this process lives longer, e.g. the API service.
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	h := &conmonHelper{name: name, cmd: cmd, conmon: conmonW}
+	msg, err := io.ReadAll(readyR)
+	if err != nil || string(msg) != conmonHelperReady {
+		h.kill(ctr)
+		if err == nil {
+			err = errors.New(string(msg))
+		}
+		return nil, fmt.Errorf("starting %s: %w", name, err)
+	}
+	return h, nil
+}
+
+// attach moves the helper into the cgroup of conmon and hands it the PID
+// of conmon, whose exit it waits for.  A conmonPID of 0 leaves the helper
+// where it is, running until it is done.
+func (h *conmonHelper) attach(ctr *Container, conmonPID int) {
+	defer h.conmon.Close()
+	if conmonPID > 0 {
+		cgroup, err := cgroups.GetCgroupProcess(conmonPID)
+		if err == nil {
+			err = cgroups.MoveUnderCgroup(cgroup, "", []uint32{uint32(h.cmd.Process.Pid)})
+		}
+		if err != nil {
+			// Like for conmon, see moveConmonToCgroupAndSignal.
+			logLevel := logrus.WarnLevel
+			if rootless.IsRootless() {
+				logLevel = logrus.InfoLevel
+			}
+			logrus.StandardLogger().Logf(logLevel, "Failed to add %s of container %s to the cgroup of conmon: %v", h.name, ctr.ID(), err)
+		}
+	}
+	if _, err := h.conmon.WriteString(strconv.Itoa(conmonPID)); err != nil {
+		logrus.Errorf("Passing conmon PID to %s of container %s: %v", h.name, ctr.ID(), err)
+	}
+}
+
+// kill kills the helper, e.g. when the container failed to start.
+func (h *conmonHelper) kill(ctr *Container) {
+	h.conmon.Close()
+	if err := h.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		logrus.Errorf("Killing %s of container %s: %v", h.name, ctr.ID(), err)
+	}
+}
+
+// conmonHelperMain is the main function of a reexec'd conmon helper.
+// os.Args = {command name} {config as JSON}, fd 3 is the ready pipe, fd 4
+// the pipe of the conmon PID.  setup parses the config and prepares the
+// helper, the returned function serves the container until it is done.
+func conmonHelperMain(command string, setup func(arg string) (func() error, error)) {
+	ready := os.NewFile(3, "ready")
+	conmon := os.NewFile(4, "conmon")
+	run, err := func() (func() error, error) {
+		if len(os.Args) != 2 {
+			return nil, fmt.Errorf("%s takes exactly one argument", command)
+		}
+		return setup(os.Args[1])
+	}()
+	if err != nil {
+		fmt.Fprint(ready, err.Error())
+		os.Exit(1)
+	}
+	fmt.Fprint(ready, conmonHelperReady)
+	ready.Close()
+
+	go watchConmon(conmon)
+	if err := run(); err != nil {
+		logrus.Error(err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// watchConmon exits the helper once conmon exited and the grace period is
+// over, or right away if Podman failed to start conmon.
+func watchConmon(conmon *os.File) {
+	msg, err := io.ReadAll(conmon)
+	conmon.Close()
+	if err != nil || len(msg) == 0 {
+		// Podman closed the pipe without passing the PID
+		os.Exit(1)
+	}
+	pid, err := strconv.Atoi(string(msg))
+	if err != nil || pid <= 0 {
+		return
+	}
+
+	pidFd, err := unix.PidfdOpen(pid, 0)
+	if err != nil {
+		pidFd = -1
+	} else {
+		defer unix.Close(pidFd)
+	}
+	if err := waitForConmonExit(context.Background(), pid, pidFd, time.Second); err != nil {
+		logrus.Errorf("Waiting for conmon %d: %v", pid, err)
+		return
+	}
+	time.Sleep(conmonHelperGrace)
+	os.Exit(0)
+}
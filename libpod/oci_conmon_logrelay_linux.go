@@ -0,0 +1,288 @@
+//go:build !remote && linux
+
+package libpod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/logs"
+	"github.com/containers/storage/pkg/reexec"
+	"github.com/coreos/go-systemd/v22/journal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// logRelayCommand is the reexec key of the process that enforces the
+	// log rate limit of a container between conmon and the log driver.
+	logRelayCommand = "podman-log-relay"
+
+	// logRelayInterval is how often the relay writes the suppressed
+	// lines marker and its counters.
+	logRelayInterval = time.Second
+)
+
+func init() {
+	reexec.Register(logRelayCommand, logRelayMain)
+}
+
+// logRelayConfig is passed to the relay as its only argument.
+type logRelayConfig struct {
+	// FIFO is the log file of conmon.
+	FIFO string `json:"fifo"`
+	// Driver is the log driver of the container, k8s-file or journald.
+	Driver string `json:"driver"`
+	// Path is the log file of the k8s-file driver.
+	Path string `json:"path,omitempty"`
+	// Size is the maximum size of the log file of the k8s-file driver.
+	Size int64 `json:"size,omitempty"`
+	// ID, Name and Tag identify the container in the journal.
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Tag  string `json:"tag,omitempty"`
+	// RateLimit is the log rate limit of the container.
+	RateLimit logs.RateLimit `json:"rateLimit"`
+	// Stats is the file the counters of the limiter are written to.
+	Stats string `json:"stats"`
+}
+
+// startLogRelay starts the process that relays the logs of the container
+// from conmon to its log driver while enforcing its log rate limit.  It
+// returns the relay and the path conmon has to write the logs to, in the
+// k8s-file format.
+func (r *ConmonOCIRuntime) startLogRelay(ctr *Container, logTag string) (*conmonHelper, string, error) {
+	fifo := filepath.Join(ctr.state.RunDir, "ctr.log.fifo")
+	// A relay of a previous run may still hold the old pipe open.
+	if err := os.Remove(fifo); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, "", fmt.Errorf("removing log pipe: %w", err)
+	}
+	if err := unix.Mkfifo(fifo, 0o600); err != nil {
+		return nil, "", fmt.Errorf("creating log pipe: %w", err)
+	}
+
+	size := r.logSizeMax
+	if ctr.config.LogSize > 0 {
+		size = ctr.config.LogSize
+	}
+	config := logRelayConfig{
+		FIFO:      fifo,
+		Driver:    ctr.LogDriver(),
+		Path:      ctr.LogPath(),
+		Size:      size,
+		ID:        ctr.ID(),
+		Name:      ctr.Name(),
+		Tag:       logTag,
+		RateLimit: *ctr.config.LogRateLimit,
+		Stats:     ctr.logRateLimitStatsPath(),
+	}
+	if config.Driver != define.JournaldLogging {
+		config.Driver = define.KubernetesLogging
+	}
+	relay, err := startConmonHelper(ctr, logRelayCommand, "log relay", config)
+	if err != nil {
+		return nil, "", err
+	}
+	return relay, fifo, nil
+}
+
+// logRelayMain is the main function of the reexec'd log relay, see
+// conmonHelperMain.
+func logRelayMain() {
+	conmonHelperMain(logRelayCommand, func(arg string) (func() error, error) {
+		relay, err := newLogRelay(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			if err := relay.run(); err != nil {
+				return fmt.Errorf("relaying logs of container %s: %w", relay.config.ID, err)
+			}
+			return nil
+		}, nil
+	})
+}
+
+type logRelay struct {
+	config  logRelayConfig
+	limiter *logs.Limiter
+	stats   logs.RateLimitStats
+	out     *os.File
+	written int64
+	// conmon splits long lines into partial entries, those are kept
+	// or dropped together with the final entry of the line.
+	partial bool
+	allowed bool
+}
+
+func newLogRelay(arg string) (*logRelay, error) {
+	l := new(logRelay)
+	if err := json.Unmarshal([]byte(arg), &l.config); err != nil {
+		return nil, fmt.Errorf("parsing log relay config: %w", err)
+	}
+
+	stats, err := logs.ReadRateLimitStats(l.config.Stats)
+	if err != nil {
+		return nil, err
+	}
+	l.stats = stats
+	l.limiter = logs.NewLimiter(l.config.RateLimit, stats, time.Now())
+
+	switch l.config.Driver {
+	case define.JournaldLogging:
+		if !journal.Enabled() {
+			return nil, errors.New("journald is not available")
+		}
+	default:
+		l.out, err = os.OpenFile(l.config.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening log file: %w", err)
+		}
+		info, err := l.out.Stat()
+		if err != nil {
+			return nil, err
+		}
+		l.written = info.Size()
+	}
+	return l, nil
+}
+
+// run relays the logs until conmon closes the pipe.
+func (l *logRelay) run() error {
+	// Blocks until conmon opens the pipe.
+	in, err := os.Open(l.config.FIFO)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if l.out != nil {
+		defer l.out.Close()
+	}
+
+	lines := make(chan string)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(in)
+		for {
+			line, err := reader.ReadString('\n')
+			if len(line) > 0 {
+				lines <- line
+			}
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(logRelayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				l.partial = false
+				if err := l.flush(time.Now()); err != nil {
+					return err
+				}
+				return <-readErr
+			}
+			if err := l.relay(line); err != nil {
+				return err
+			}
+		case now := <-ticker.C:
+			if err := l.flush(now); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// relay writes a log entry of conmon if it is within the rate limit.
+func (l *logRelay) relay(line string) error {
+	entry, err := logs.NewLogLine(strings.TrimSuffix(line, "\n"))
+	if err != nil {
+		logrus.Warnf("Skipping log entry of container %s: %v", l.config.ID, err)
+		return nil
+	}
+	if !l.partial {
+		l.allowed = l.limiter.Allow(time.Now())
+	}
+	l.partial = entry.Partial()
+	if !l.allowed {
+		return nil
+	}
+	return l.write(line, entry)
+}
+
+// flush writes the suppressed lines marker and the counters, unless the
+// marker would end up in the middle of a line.
+func (l *logRelay) flush(now time.Time) error {
+	if l.partial && l.allowed {
+		return nil
+	}
+	if n := l.limiter.Suppressed(); n > 0 {
+		marker := &logs.LogLine{
+			Device:       "stderr",
+			ParseLogType: logs.FullLogType,
+			Time:         now,
+			Msg:          logs.SuppressedMessage(n),
+		}
+		line := fmt.Sprintf("%s %s %s %s\n", now.Format(logs.LogTimeFormat), marker.Device, marker.ParseLogType, marker.Msg)
+		if err := l.write(line, marker); err != nil {
+			return err
+		}
+	}
+	if stats := l.limiter.Stats(); stats != l.stats {
+		if err := logs.WriteRateLimitStats(l.config.Stats, stats); err != nil {
+			return fmt.Errorf("writing log rate limit counters: %w", err)
+		}
+		l.stats = stats
+	}
+	return nil
+}
+
+// write writes a log entry to the log driver of the container.
+func (l *logRelay) write(line string, entry *logs.LogLine) error {
+	if l.config.Driver == define.JournaldLogging {
+		priority := journal.PriInfo
+		if entry.Device == "stderr" {
+			priority = journal.PriErr
+		}
+		fields := map[string]string{
+			"CONTAINER_ID":      l.config.ID[:12],
+			"CONTAINER_ID_FULL": l.config.ID,
+			"CONTAINER_NAME":    l.config.Name,
+		}
+		if l.config.Tag != "" {
+			fields["CONTAINER_TAG"] = l.config.Tag
+			fields["SYSLOG_IDENTIFIER"] = l.config.Tag
+		}
+		if entry.Partial() {
+			fields["CONTAINER_PARTIAL_MESSAGE"] = "true"
+		}
+		return journal.Send(entry.Msg, priority, fields)
+	}
+
+	// Like conmon, start over once the log file reaches its maximum size.
+	if l.config.Size > 0 && l.written+int64(len(line)) > l.config.Size {
+		if err := l.out.Truncate(0); err != nil {
+			return err
+		}
+		l.written = 0
+	}
+	n, err := io.WriteString(l.out, line)
+	l.written += int64(n)
+	return err
+}
@@ -0,0 +1,80 @@
+//go:build !remote && linux
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman/v5/pkg/seccompnotify"
+	"github.com/containers/storage/pkg/reexec"
+)
+
+// seccompNotifyCommand is the reexec key of the seccomp notify listener of
+// a container.
+const seccompNotifyCommand = "podman-seccomp-notify"
+
+func init() {
+	reexec.Register(seccompNotifyCommand, seccompNotifyMain)
+}
+
+// seccompNotifyConfig is passed to the listener as its only argument.
+type seccompNotifyConfig struct {
+	// Socket is the listenerPath of the seccomp filter.
+	Socket string `json:"socket"`
+	// Policy is the seccomp notify policy of the container.
+	Policy *seccompnotify.Policy `json:"policy"`
+	// ID is the ID of the container.
+	ID string `json:"id"`
+}
+
+// startSeccompNotify starts the seccomp notify listener of the container.
+// The OCI runtime passes it the notify file descriptor when it creates the
+// container, the listener exits once all processes of the container exited.
+func (r *ConmonOCIRuntime) startSeccompNotify(ctr *Container) (*conmonHelper, error) {
+	socket := ctr.seccompNotifySocketPath()
+	if err := os.MkdirAll(filepath.Dir(socket), 0o700); err != nil {
+		return nil, fmt.Errorf("creating seccomp notify socket directory: %w", err)
+	}
+	// A listener of a previous run leaves its socket behind.
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("removing seccomp notify socket: %w", err)
+	}
+
+	config := seccompNotifyConfig{
+		Socket: socket,
+		Policy: ctr.config.SeccompNotifyPolicy,
+		ID:     ctr.ID(),
+	}
+	return startConmonHelper(ctr, seccompNotifyCommand, "seccomp notify listener", config)
+}
+
+// seccompNotifyMain is the main function of the reexec'd listener, see
+// conmonHelperMain.  Its socket accepts connections of the OCI runtime once
+// it is ready.
+func seccompNotifyMain() {
+	conmonHelperMain(seccompNotifyCommand, func(arg string) (func() error, error) {
+		var config seccompNotifyConfig
+		if err := json.Unmarshal([]byte(arg), &config); err != nil {
+			return nil, fmt.Errorf("parsing seccomp notify config: %w", err)
+		}
+		if config.Policy == nil {
+			return nil, errors.New("no seccomp notify policy")
+		}
+		listener, err := seccompnotify.Listen(config.Socket, config.Policy)
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			// Closing the listener removes its socket.
+			err := listener.Serve()
+			_ = listener.Close()
+			if err != nil {
+				return fmt.Errorf("seccomp notify listener of container %s: %w", config.ID, err)
+			}
+			return nil
+		}, nil
+	})
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/pkg/namespaces"
+	"github.com/containers/podman/v5/pkg/seccompnotify"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage"
@@ -630,6 +632,18 @@ func WithSdNotifyMode(mode string) CtrCreateOption {
 	}
 }
 
+// WithTiming records the durations of the steps of starting the container.
+func WithTiming() CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.Timing = true
+		return nil
+	}
+}
+
 // WithShmSize sets the size of /dev/shm tmpfs mount.
 func WithShmSize(size int64) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1167,6 +1181,42 @@ func WithLogTag(tag string) CtrCreateOption {
 	}
 }
 
+// WithLogRateLimit sets the maximum rate of log lines of the container.
+func WithLogRateLimit(limit *logs.RateLimit) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		if limit == nil || limit.Rate <= 0 || limit.Burst == 0 {
+			return fmt.Errorf("log rate limit must have a rate and a burst: %w", define.ErrInvalidArg)
+		}
+
+		ctr.config.LogRateLimit = limit
+
+		return nil
+	}
+}
+
+// WithSeccompNotifyPolicy sets the policy of the seccomp user-notify
+// listener of the container.
+func WithSeccompNotifyPolicy(policy *seccompnotify.Policy) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		if policy == nil {
+			return fmt.Errorf("seccomp notify policy must not be empty: %w", define.ErrInvalidArg)
+		}
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid seccomp notify policy: %w", err)
+		}
+
+		ctr.config.SeccompNotifyPolicy = policy
+
+		return nil
+	}
+}
+
 // WithCgroupsMode disables the creation of Cgroups for the conmon process.
 func WithCgroupsMode(mode string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -668,6 +668,7 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 	var inspectMounts []define.InspectMount
 	var devices []define.InspectDevice
 	var infraSecurity []string
+	var infraHealth string
 	if p.state.InfraContainerID != "" {
 		infra, err := p.runtime.GetContainer(p.state.InfraContainerID)
 		if err != nil {
@@ -675,6 +676,7 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		}
 		infraConfig = new(define.InspectPodInfraConfig)
 		infraConfig.HostNetwork = p.NetworkMode() == "host"
+		infraConfig.NoImage = infra.config.Rootfs != "" && infra.config.RootfsImageID == ""
 		infraConfig.StaticIP = infra.config.ContainerNetworkConfig.StaticIP
 		infraConfig.NoManageResolvConf = infra.config.UseImageResolvConf
 		infraConfig.NoManageHosts = infra.config.UseImageHosts
@@ -690,6 +692,12 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Like the states of the containers, the health status is
+		// informational and errors are not fatal.
+		infraHealth, err = infra.HealthCheckStatus()
+		if err != nil {
+			logrus.Warnf("Getting health status of infra container of pod %s: %v", p.ID(), err)
+		}
 
 		if len(infra.config.ContainerNetworkConfig.DNSServer) > 0 {
 			infraConfig.DNSServer = make([]string, 0, len(infra.config.ContainerNetworkConfig.DNSServer))
@@ -741,6 +749,7 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		CreateInfra:         infraConfig != nil,
 		InfraContainerID:    p.state.InfraContainerID,
 		InfraConfig:         infraConfig,
+		InfraHealth:         infraHealth,
 		SharedNamespaces:    sharesNS,
 		NumContainers:       uint(len(containers)),
 		Containers:          ctrs,
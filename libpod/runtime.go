@@ -736,6 +736,10 @@ func (r *Runtime) libimageEvents() {
 				if err := r.eventer.Write(e); err != nil {
 					logrus.Errorf("Unable to write image event: %q", err)
 				}
+				switch libimageEvent.Type {
+				case libimage.EventTypeImagePull, libimage.EventTypeImageLoad:
+					r.recordImagePull(libimageEvent.ID)
+				}
 			}
 
 			if sawShutdown {
@@ -977,6 +981,11 @@ func (r *Runtime) StorageConfig() storage.StoreOptions {
 	return r.storageConfig
 }
 
+// GetStore returns the c/storage store of the runtime.
+func (r *Runtime) GetStore() storage.Store {
+	return r.store
+}
+
 func (r *Runtime) GarbageCollect() error {
 	return r.store.GarbageCollect()
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage"
 	"github.com/containers/storage/pkg/stringid"
@@ -45,14 +46,22 @@ type CtrCreateOption func(*Container) error
 type ContainerFilter func(*Container) bool
 
 // NewContainer creates a new container from a given OCI config.
-func (r *Runtime) NewContainer(ctx context.Context, rSpec *spec.Spec, spec *specgen.SpecGenerator, infra bool, options ...CtrCreateOption) (*Container, error) {
+func (r *Runtime) NewContainer(ctx context.Context, rSpec *spec.Spec, spec *specgen.SpecGenerator, infra bool, options ...CtrCreateOption) (_ *Container, retErr error) {
 	if !r.valid {
 		return nil, define.ErrRuntimeStopped
 	}
+	ctx, span := tracing.Start(ctx, "Runtime.NewContainer")
+	defer span.EndWithError(&retErr)
 	if infra {
 		options = append(options, withIsInfra())
 	}
-	return r.newContainer(ctx, rSpec, options...)
+	ctr, err := r.newContainer(ctx, rSpec, options...)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+	span.SetAttribute("container.id", ctr.ID())
+	return ctr, nil
 }
 
 func (r *Runtime) PrepareVolumeOnCreateContainer(ctx context.Context, ctr *Container) error {
@@ -302,6 +311,9 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 	if err := ctr.validate(); err != nil {
 		return nil, err
 	}
+	if err := r.admitContainer(ctr); err != nil {
+		return nil, err
+	}
 	if ctr.config.IsInfra {
 		ctr.config.StopTimeout = 10
 	}
@@ -550,6 +562,13 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		}
 	}
 
+	if ctr.config.LogRateLimit != nil {
+		switch ctr.config.LogDriver {
+		case define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging:
+			return nil, fmt.Errorf("log rate limits are not supported with the %s log driver: %w", ctr.config.LogDriver, define.ErrInvalidArg)
+		}
+	}
+
 	if useDevShm && !MountExists(ctr.config.Spec.Mounts, "/dev/shm") && ctr.config.ShmDir == "" && !ctr.config.NoShm {
 		ctr.config.ShmDir = filepath.Join(ctr.bundlePath(), "shm")
 		if err := os.MkdirAll(ctr.config.ShmDir, 0700); err != nil {
@@ -590,7 +609,11 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 // is running). If removeVolume is specified, anonymous named volumes used by the
 // container will be removed also (iff the container is the sole user of the
 // volumes). Timeout sets the stop timeout for the container if it is running.
-func (r *Runtime) RemoveContainer(ctx context.Context, c *Container, force bool, removeVolume bool, timeout *uint) error {
+func (r *Runtime) RemoveContainer(ctx context.Context, c *Container, force bool, removeVolume bool, timeout *uint) (finalErr error) {
+	ctx, span := tracing.Start(ctx, "Runtime.RemoveContainer")
+	span.SetAttribute("container.id", c.ID())
+	defer span.EndWithError(&finalErr)
+
 	opts := ctrRmOpts{
 		Force:        force,
 		RemoveVolume: removeVolume,
@@ -1237,11 +1260,23 @@ func (r *Runtime) LookupContainerID(idOrName string) (string, error) {
 // the output. Multiple filters are handled by ANDing their output, so only
 // containers matching all filters are returned
 func (r *Runtime) GetContainers(loadState bool, filters ...ContainerFilter) ([]*Container, error) {
+	return r.QueryContainers(loadState, nil, filters...)
+}
+
+// QueryContainers is like GetContainers but only loads the containers which
+// may match the query from the state. Since the query is evaluated by the
+// database where possible, this avoids loading all containers when only a
+// few match. The filters are applied to the loaded containers.
+// If loadState is set, the states of all containers are loaded with a single
+// query and the filters are applied to these states, updated with the exit
+// files of containers that exited since, instead of loading the state of each
+// container again.
+func (r *Runtime) QueryContainers(loadState bool, query *ContainerQuery, filters ...ContainerFilter) ([]*Container, error) {
 	if !r.valid {
 		return nil, define.ErrRuntimeStopped
 	}
 
-	ctrs, err := r.state.AllContainers(loadState)
+	ctrs, err := r.state.QueryContainers(loadState, query)
 	if err != nil {
 		return nil, err
 	}
@@ -1250,8 +1285,22 @@ func (r *Runtime) GetContainers(loadState bool, filters ...ContainerFilter) ([]*
 
 	for _, ctr := range ctrs {
 		include := true
-		for _, filter := range filters {
-			include = include && filter(ctr)
+		if loadState {
+			if err := ctr.Batch(func(c *Container) error {
+				if _, err := c.applyExitFile(); err != nil {
+					logrus.Warnf("Checking if container %s exited: %v", c.ID(), err)
+				}
+				for _, filter := range filters {
+					include = include && filter(c)
+				}
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		} else {
+			for _, filter := range filters {
+				include = include && filter(ctr)
+			}
 		}
 
 		if include {
@@ -8,11 +8,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"time"
 
 	buildahDefine "github.com/containers/buildah/define"
 	"github.com/containers/buildah/imagebuildah"
 	"github.com/containers/common/libimage"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/util"
@@ -115,6 +120,172 @@ func (r *Runtime) newImageBuildCompleteEvent(idOrName string) {
 	}
 }
 
+// imageLastUsedKey is the key of the data item in the containers storage
+// recording when an image was last used by a container.
+const imageLastUsedKey = "podman-last-used"
+
+// imageLastPulledKey is the key of the data item in the containers storage
+// recording when an image was last pulled or loaded.
+const imageLastPulledKey = "podman-last-pulled"
+
+// imageUseRecordInterval is how long a recorded use of an image is kept
+// before it is updated.  Containers may be started in quick succession, and
+// every update rewrites the image metadata of the storage.
+const imageUseRecordInterval = time.Minute
+
+// recordImageUse records that the image with the specified ID has just been
+// used to create or start a container.  Failures are not fatal since they must
+// not affect the container.
+func (r *Runtime) recordImageUse(imageID string) {
+	if imageID == "" {
+		return
+	}
+	lastUsed, err := r.imageTime(imageID, imageLastUsedKey)
+	if err != nil {
+		logrus.Debugf("Retrieving last use of image %s: %v", imageID, err)
+	} else if time.Since(lastUsed) < imageUseRecordInterval {
+		return
+	}
+	r.setImageTime(imageID, imageLastUsedKey)
+}
+
+// recordImagePull records that the image with the specified ID has just been
+// pulled or loaded.
+func (r *Runtime) recordImagePull(imageID string) {
+	if imageID == "" {
+		return
+	}
+	r.setImageTime(imageID, imageLastPulledKey)
+}
+
+// setImageTime sets the data item key of the image to the current time.
+// Failures are only logged.
+func (r *Runtime) setImageTime(imageID, key string) {
+	now, err := time.Now().UTC().MarshalText()
+	if err != nil {
+		logrus.Debugf("Encoding %s of image %s: %v", key, imageID, err)
+		return
+	}
+	// Images in read-only stores cannot be updated.
+	if err := r.store.SetImageBigData(imageID, key, now, nil); err != nil {
+		logrus.Debugf("Recording %s of image %s: %v", key, imageID, err)
+	}
+}
+
+// imageTime returns the time recorded in the data item key of the image, or
+// the zero time if none was recorded.
+func (r *Runtime) imageTime(imageID, key string) (time.Time, error) {
+	var t time.Time
+	data, err := r.store.ImageBigData(imageID, key)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return t, nil
+		}
+		return t, err
+	}
+	if err := t.UnmarshalText(data); err != nil {
+		return t, fmt.Errorf("decoding %s of image %s: %w", key, imageID, err)
+	}
+	return t, nil
+}
+
+// ImageLastUsed returns when the image with the specified ID was last used by
+// a container.  The zero time is returned if no use has been recorded.  Uses
+// are recorded at most once per minute.
+func (r *Runtime) ImageLastUsed(imageID string) (time.Time, error) {
+	return r.imageTime(imageID, imageLastUsedKey)
+}
+
+// ImageLastPulled returns when the image with the specified ID was last
+// pulled or loaded.  The zero time is returned if no pull has been recorded,
+// e.g. for images pulled by older versions of Podman.
+func (r *Runtime) ImageLastPulled(imageID string) (time.Time, error) {
+	return r.imageTime(imageID, imageLastPulledKey)
+}
+
+// imagePullSourcesKey is the key of the data item in the containers storage
+// recording the registry repositories an image was pulled from.
+const imagePullSourcesKey = "podman-pull-sources"
+
+// PullImage pulls name like libimage.Runtime.Pull and records the registry
+// repository each pulled image was copied from, see ImagePullSources.  Unlike
+// the names of an image, which can be changed with podman tag, the sources
+// tell where the image actually comes from.
+func (r *Runtime) PullImage(ctx context.Context, name string, pullPolicy config.PullPolicy, options *libimage.PullOptions) ([]*libimage.Image, error) {
+	pullOptions := &libimage.PullOptions{}
+	if options != nil {
+		*pullOptions = *options
+	}
+
+	// The lookup function is only called for images which are actually
+	// copied, one at a time, and the last call is for the successful copy.
+	var source string
+	lookup := pullOptions.SourceLookupReferenceFunc
+	pullOptions.SourceLookupReferenceFunc = func(ref types.ImageReference) (types.ImageReference, error) {
+		if lookup != nil {
+			var err error
+			if ref, err = lookup(ref); err != nil {
+				return nil, err
+			}
+		}
+		source = ""
+		if named := ref.DockerReference(); named != nil && ref.Transport().Name() == docker.Transport.Name() {
+			source = named.Name()
+		}
+		return ref, nil
+	}
+
+	pulledImages, err := r.libimageRuntime.Pull(ctx, name, pullPolicy, pullOptions)
+	if err != nil {
+		return nil, err
+	}
+	if source != "" {
+		for _, img := range pulledImages {
+			r.recordImagePullSource(img.ID(), source)
+		}
+	}
+	return pulledImages, nil
+}
+
+// recordImagePullSource adds source to the pull sources of the image with the
+// specified ID.  Failures are only logged since the image has been pulled
+// already.
+func (r *Runtime) recordImagePullSource(imageID, source string) {
+	sources, err := r.ImagePullSources(imageID)
+	if err != nil {
+		logrus.Debugf("Retrieving pull sources of image %s: %v", imageID, err)
+	}
+	if slices.Contains(sources, source) {
+		return
+	}
+	data, err := json.Marshal(append(sources, source))
+	if err != nil {
+		logrus.Debugf("Encoding pull sources of image %s: %v", imageID, err)
+		return
+	}
+	if err := r.store.SetImageBigData(imageID, imagePullSourcesKey, data, nil); err != nil {
+		logrus.Warnf("Recording pull source of image %s: %v", imageID, err)
+	}
+}
+
+// ImagePullSources returns the registry repositories the image with the
+// specified ID was pulled from by PullImage.  It is empty for images which
+// were built, committed, loaded or pulled by older versions of Podman.
+func (r *Runtime) ImagePullSources(imageID string) ([]string, error) {
+	data, err := r.store.ImageBigData(imageID, imagePullSourcesKey)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var sources []string
+	if err := json.Unmarshal(data, &sources); err != nil {
+		return nil, fmt.Errorf("decoding pull sources of image %s: %w", imageID, err)
+	}
+	return sources, nil
+}
+
 // Build adds the runtime to the imagebuildah call
 func (r *Runtime) Build(ctx context.Context, options buildahDefine.BuildOptions, dockerfiles ...string) (string, reference.Canonical, error) {
 	if options.Runtime == "" {
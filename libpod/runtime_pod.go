@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
 // Contains the public Runtime API for pods
@@ -29,10 +30,13 @@ type PodFilter func(*Pod) bool
 // If force is specified with removeCtrs, all containers will be stopped before
 // being removed
 // Otherwise, the pod will not be removed if any containers are running
-func (r *Runtime) RemovePod(ctx context.Context, p *Pod, removeCtrs, force bool, timeout *uint) (map[string]error, error) {
+func (r *Runtime) RemovePod(ctx context.Context, p *Pod, removeCtrs, force bool, timeout *uint) (_ map[string]error, finalErr error) {
 	if !r.valid {
 		return nil, define.ErrRuntimeStopped
 	}
+	ctx, span := tracing.Start(ctx, "Runtime.RemovePod")
+	span.SetAttribute("pod.id", p.ID())
+	defer span.EndWithError(&finalErr)
 
 	if !p.valid {
 		if ok, _ := r.state.HasPod(p.ID()); !ok {
@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/hashicorp/go-multierror"
 	"github.com/sirupsen/logrus"
 )
@@ -19,6 +20,8 @@ func (r *Runtime) NewPod(ctx context.Context, p specgen.PodSpecGenerator, option
 	if !r.valid {
 		return nil, define.ErrRuntimeStopped
 	}
+	_, span := tracing.Start(ctx, "Runtime.NewPod")
+	defer span.EndWithError(&deferredErr)
 
 	pod := newPod(r)
 
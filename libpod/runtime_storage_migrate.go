@@ -0,0 +1,435 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/ioutils"
+	stypes "github.com/containers/storage/types"
+	"github.com/sirupsen/logrus"
+)
+
+// storageMigration records the progress of a storage migration in the graph
+// root, so that an interrupted migration can be resumed.
+type storageMigration struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Containers are the IDs of the containers that were migrated
+	// completely.
+	Containers []string `json:"containers,omitempty"`
+}
+
+func (r *Runtime) storageMigrationPath() string {
+	return filepath.Join(r.storageConfig.GraphRoot, "storage-migration.json")
+}
+
+func (r *Runtime) readStorageMigration() (*storageMigration, error) {
+	content, err := os.ReadFile(r.storageMigrationPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	migration := new(storageMigration)
+	if err := json.Unmarshal(content, migration); err != nil {
+		return nil, fmt.Errorf("parsing storage migration state %s: %w", r.storageMigrationPath(), err)
+	}
+	return migration, nil
+}
+
+func (r *Runtime) writeStorageMigration(migration *storageMigration) error {
+	content, err := json.Marshal(migration)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(r.storageMigrationPath(), content, 0o600)
+}
+
+// MigrateStorage moves all images and containers to a new graph driver.  The
+// containers keep their IDs, names and volumes.  Running containers are
+// stopped.  Everything is copied to the new driver before the old driver is
+// wiped, so an interrupted migration can be resumed by running it again.
+func (r *Runtime) MigrateStorage(ctx context.Context, options entities.SystemStorageMigrateOptions) (entities.SystemStorageMigrateReport, error) {
+	report := entities.SystemStorageMigrateReport{
+		From: r.store.GraphDriverName(),
+		To:   options.Driver,
+	}
+	if options.Driver == "" {
+		return report, fmt.Errorf("no graph driver to migrate to given: %w", define.ErrInvalidArg)
+	}
+	if options.Driver == report.From {
+		return report, fmt.Errorf("storage already uses the %s graph driver: %w", options.Driver, define.ErrInvalidArg)
+	}
+
+	// Hold the alive lock so that no other Podman command runs while
+	// the storage is migrated.
+	aliveLock, err := r.getRuntimeAliveLock()
+	if err != nil {
+		return report, fmt.Errorf("retrieving alive lock: %w", err)
+	}
+	aliveLock.Lock()
+	defer aliveLock.Unlock()
+
+	if !r.valid {
+		return report, define.ErrRuntimeStopped
+	}
+
+	migration, err := r.readStorageMigration()
+	if err != nil {
+		return report, err
+	}
+	if migration != nil {
+		if migration.To != options.Driver {
+			return report, fmt.Errorf("an interrupted migration to the %s graph driver must be resumed first: %w", migration.To, define.ErrInvalidArg)
+		}
+		report.Resumed = true
+	} else {
+		migration = &storageMigration{From: report.From, To: options.Driver}
+	}
+
+	layers, err := r.store.Layers()
+	if err != nil {
+		return report, err
+	}
+	images, err := r.store.Images()
+	if err != nil {
+		return report, err
+	}
+	containers, err := r.store.Containers()
+	if err != nil {
+		return report, err
+	}
+
+	// Only image layers are copied.  Container layers are recreated
+	// with the containers, and layers with an ID mapping applied to an
+	// image are recreated on demand.
+	skip := make(map[string]bool)
+	for _, ctr := range containers {
+		skip[ctr.LayerID] = true
+	}
+	for _, img := range images {
+		for _, layer := range img.MappedTopLayers {
+			skip[layer] = true
+		}
+	}
+	imageLayers := make(map[string]*storage.Layer, len(layers))
+	for i := range layers {
+		if layers[i].ReadOnly || skip[layers[i].ID] {
+			continue
+		}
+		imageLayers[layers[i].ID] = &layers[i]
+		report.Layers++
+		// The uncompressed size is only valid with a digest, and -1
+		// for layers pulled by table of contents.
+		size := layers[i].UncompressedSize
+		if layers[i].UncompressedDigest == "" || size < 0 {
+			size, err = r.store.DiffSize("", layers[i].ID)
+			if err != nil {
+				return report, fmt.Errorf("getting size of layer %s: %w", layers[i].ID, err)
+			}
+		}
+		report.Size += size
+	}
+	for _, img := range images {
+		if !img.ReadOnly {
+			report.Images++
+		}
+	}
+	for _, ctr := range containers {
+		report.Containers++
+		size, err := r.store.DiffSize("", ctr.LayerID)
+		if err != nil {
+			return report, fmt.Errorf("getting size of container %s: %w", ctr.ID, err)
+		}
+		report.Size += size
+	}
+	if options.DryRun {
+		return report, nil
+	}
+
+	running, err := r.GetRunningContainers()
+	if err != nil {
+		return report, err
+	}
+	for _, ctr := range running {
+		logrus.Infof("Stopping container %s", ctr.ID())
+		if err := ctr.Stop(); err != nil {
+			return report, fmt.Errorf("cannot stop container %s: %w", ctr.ID(), err)
+		}
+	}
+
+	if err := r.writeStorageMigration(migration); err != nil {
+		return report, fmt.Errorf("writing storage migration state: %w", err)
+	}
+
+	storeOpts := r.storageConfig
+	storeOpts.GraphDriverName = options.Driver
+	// Options of the old driver are invalid for the new one.
+	storeOpts.GraphDriverOptions = nil
+	for _, opt := range r.storageConfig.GraphDriverOptions {
+		if strings.HasPrefix(opt, options.Driver+".") {
+			storeOpts.GraphDriverOptions = append(storeOpts.GraphDriverOptions, opt)
+		}
+	}
+	store, err := storage.GetStore(storeOpts)
+	if err != nil {
+		return report, fmt.Errorf("initializing storage with the %s graph driver: %w", options.Driver, err)
+	}
+	defer func() {
+		if _, err := store.Shutdown(false); err != nil {
+			logrus.Errorf("Shutting down storage with the %s graph driver: %v", options.Driver, err)
+		}
+	}()
+
+	copied := make(map[string]bool)
+	var copyLayer func(layer *storage.Layer) error
+	copyLayer = func(layer *storage.Layer) error {
+		if copied[layer.ID] {
+			return nil
+		}
+		if parent, ok := imageLayers[layer.Parent]; ok {
+			if err := copyLayer(parent); err != nil {
+				return err
+			}
+		}
+		copied[layer.ID] = true
+		if _, err := store.Layer(layer.ID); err == nil {
+			// migrated before the migration was interrupted
+			return nil
+		}
+		logrus.Debugf("Migrating layer %s", layer.ID)
+		if err := migrateLayer(r.store, store, layer); err != nil {
+			return fmt.Errorf("migrating layer %s: %w", layer.ID, err)
+		}
+		return nil
+	}
+	for _, layer := range imageLayers {
+		if err := copyLayer(layer); err != nil {
+			return report, err
+		}
+	}
+
+	for i := range images {
+		img := &images[i]
+		if img.ReadOnly {
+			continue
+		}
+		if _, err := store.Image(img.ID); err == nil {
+			continue
+		}
+		logrus.Debugf("Migrating image %s", img.ID)
+		if err := migrateImage(r.store, store, img); err != nil {
+			return report, fmt.Errorf("migrating image %s: %w", img.ID, err)
+		}
+	}
+
+	for i := range containers {
+		ctr := &containers[i]
+		if slices.Contains(migration.Containers, ctr.ID) {
+			continue
+		}
+		// A partially migrated container is migrated again.
+		if _, err := store.Container(ctr.ID); err == nil {
+			if err := store.DeleteContainer(ctr.ID); err != nil {
+				return report, fmt.Errorf("removing partially migrated container %s: %w", ctr.ID, err)
+			}
+		}
+		logrus.Debugf("Migrating container %s", ctr.ID)
+		if err := r.migrateContainer(r.store, store, ctr); err != nil {
+			return report, fmt.Errorf("migrating container %s: %w", ctr.ID, err)
+		}
+		migration.Containers = append(migration.Containers, ctr.ID)
+		if err := r.writeStorageMigration(migration); err != nil {
+			return report, fmt.Errorf("writing storage migration state: %w", err)
+		}
+	}
+
+	// Everything is available with the new driver now.
+	if err := r.store.Wipe(); err != nil {
+		return report, fmt.Errorf("removing storage of the %s graph driver: %w", report.From, err)
+	}
+	if err := r.state.SetGraphDriver(options.Driver); err != nil {
+		return report, err
+	}
+	if err := os.Remove(r.storageMigrationPath()); err != nil {
+		return report, fmt.Errorf("removing storage migration state: %w", err)
+	}
+
+	if defaults, err := stypes.DefaultStoreOptions(); err == nil && defaults.GraphDriverName != "" && defaults.GraphDriverName != options.Driver {
+		logrus.Warnf("The storage configuration still selects the %s graph driver, set driver = %q in storage.conf", defaults.GraphDriverName, options.Driver)
+	}
+	return report, nil
+}
+
+// migrateLayer copies an image layer to another store, keeping its ID.
+func migrateLayer(from, to storage.Store, layer *storage.Layer) error {
+	uncompressed := archive.Uncompressed
+	diff, err := from.Diff("", layer.ID, &storage.DiffOptions{Compression: &uncompressed})
+	if err != nil {
+		return err
+	}
+	defer diff.Close()
+
+	options := &storage.LayerOptions{
+		IDMappingOptions: stypes.IDMappingOptions{
+			HostUIDMapping: len(layer.UIDMap) == 0,
+			HostGIDMapping: len(layer.GIDMap) == 0,
+			UIDMap:         layer.UIDMap,
+			GIDMap:         layer.GIDMap,
+		},
+		OriginalDigest:     layer.CompressedDigest,
+		UncompressedDigest: layer.UncompressedDigest,
+		Flags:              layer.Flags,
+	}
+	if layer.CompressedDigest != "" {
+		size := layer.CompressedSize
+		options.OriginalSize = &size
+	}
+	for _, key := range layer.BigDataNames {
+		data, err := from.LayerBigData(layer.ID, key)
+		if err != nil {
+			return err
+		}
+		defer data.Close()
+		options.BigData = append(options.BigData, storage.LayerBigDataOption{Key: key, Data: data})
+	}
+
+	_, _, err = to.PutLayer(layer.ID, layer.Parent, layer.Names, layer.MountLabel, false, options, diff)
+	return err
+}
+
+// migrateImage copies the record and data of an image to another store,
+// whose layers were migrated already.
+func migrateImage(from, to storage.Store, img *storage.Image) error {
+	options := &storage.ImageOptions{
+		CreationDate: img.Created,
+		Digest:       img.Digest,
+		Metadata:     img.Metadata,
+		NamesHistory: img.NamesHistory,
+		Flags:        img.Flags,
+	}
+	for _, key := range img.BigDataNames {
+		data, err := from.ImageBigData(img.ID, key)
+		if err != nil {
+			return err
+		}
+		options.BigData = append(options.BigData, storage.ImageBigDataOption{Key: key, Data: data, Digest: img.BigDataDigests[key]})
+	}
+	_, err := to.CreateImage(img.ID, img.Names, img.TopLayer, img.Metadata, options)
+	return err
+}
+
+// migrateContainer recreates a container in another store, keeping its ID
+// and the ID of its layer, copies its writable layer and directories, and
+// points the configuration of the Libpod container to the new directories.
+func (r *Runtime) migrateContainer(from, to storage.Store, ctr *storage.Container) error {
+	options := &storage.ContainerOptions{
+		IDMappingOptions: stypes.IDMappingOptions{
+			HostUIDMapping: len(ctr.UIDMap) == 0,
+			HostGIDMapping: len(ctr.GIDMap) == 0,
+			UIDMap:         ctr.UIDMap,
+			GIDMap:         ctr.GIDMap,
+		},
+		Flags:    ctr.Flags,
+		Metadata: ctr.Metadata,
+	}
+	for _, key := range ctr.BigDataNames {
+		data, err := from.ContainerBigData(ctr.ID, key)
+		if err != nil {
+			return err
+		}
+		options.BigData = append(options.BigData, storage.ContainerBigDataOption{Key: key, Data: data})
+	}
+	if _, err := to.CreateContainer(ctr.ID, ctr.Names, ctr.ImageID, ctr.LayerID, ctr.Metadata, options); err != nil {
+		return err
+	}
+
+	uncompressed := archive.Uncompressed
+	diff, err := from.Diff("", ctr.LayerID, &storage.DiffOptions{Compression: &uncompressed})
+	if err != nil {
+		return err
+	}
+	_, err = to.ApplyDiff(ctr.LayerID, diff)
+	diff.Close()
+	if err != nil {
+		return fmt.Errorf("copying writable layer: %w", err)
+	}
+
+	oldDir, err := from.ContainerDirectory(ctr.ID)
+	if err != nil {
+		return err
+	}
+	newDir, err := to.ContainerDirectory(ctr.ID)
+	if err != nil {
+		return err
+	}
+	if err := archive.NewDefaultArchiver().CopyWithTar(oldDir, newDir); err != nil {
+		return fmt.Errorf("copying container directory: %w", err)
+	}
+	oldRunDir, err := from.ContainerRunDirectory(ctr.ID)
+	if err != nil {
+		return err
+	}
+	newRunDir, err := to.ContainerRunDirectory(ctr.ID)
+	if err != nil {
+		return err
+	}
+
+	// Containers of other tools have no Libpod configuration.
+	c, err := r.state.Container(ctr.ID)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchCtr) {
+			return nil
+		}
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+	move := func(path string) string {
+		for old, dir := range map[string]string{oldDir: newDir, oldRunDir: newRunDir} {
+			if rel, err := filepath.Rel(old, path); err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+				return filepath.Join(dir, rel)
+			}
+		}
+		return path
+	}
+	c.config.StaticDir = move(c.config.StaticDir)
+	c.config.LogPath = move(c.config.LogPath)
+	c.config.ConmonPidFile = move(c.config.ConmonPidFile)
+	c.config.PidFile = move(c.config.PidFile)
+	c.config.ShmDir = move(c.config.ShmDir)
+	for i, mount := range c.config.Mounts {
+		c.config.Mounts[i] = move(mount)
+	}
+	if c.config.Spec != nil {
+		for i := range c.config.Spec.Mounts {
+			c.config.Spec.Mounts[i].Source = move(c.config.Spec.Mounts[i].Source)
+		}
+	}
+	if err := r.state.RewriteContainerConfig(c, c.config); err != nil {
+		return fmt.Errorf("rewriting config: %w", err)
+	}
+
+	c.state.RunDir = move(c.state.RunDir)
+	c.state.ConfigPath = move(c.state.ConfigPath)
+	c.state.Mountpoint = ""
+	for dest, src := range c.state.BindMounts {
+		c.state.BindMounts[dest] = move(src)
+	}
+	return r.state.SaveContainer(c)
+}
@@ -0,0 +1,378 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/common/pkg/resize"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/asciicast"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	sessionTypeExec   = "exec"
+	sessionTypeAttach = "attach"
+
+	// Default size of the terminal of a recording if the size of the
+	// terminal of the session is not known.
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+)
+
+// sessionRecordingConfigs are the containers.conf files of the administrator
+// the session recording directory is read from. The configuration files of
+// the user and the CONTAINERS_CONF environment variable are deliberately not
+// used, so that users cannot turn off recording mandated by the
+// administrator. Overridden in tests.
+var sessionRecordingConfigs = []string{config.DefaultContainersConfig, config.OverrideContainersConfig}
+
+// sessionRecordingConfig is the part of containers.conf configuring the
+// recording of the terminal sessions of exec and attach.
+type sessionRecordingConfig struct {
+	Engine struct {
+		// SessionRecordingDir is the directory to record all exec and
+		// attach sessions with a terminal into. Sessions are not
+		// recorded if empty.
+		SessionRecordingDir string `toml:"session_recording_dir,omitempty"`
+	} `toml:"engine"`
+}
+
+// sessionRecordingDir returns the directory to record sessions into, or an
+// empty string if sessions are not recorded. Like for containers.conf, each
+// file overrides the settings of the previous ones, and each file is
+// followed by the files of its drop-in directory in alphanumeric order.
+func sessionRecordingDir() (string, error) {
+	var files []string
+	for _, path := range sessionRecordingConfigs {
+		files = append(files, path)
+		entries, err := os.ReadDir(path + ".d")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("reading configuration directory: %w", err)
+		}
+		var dropIns []string
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".conf") {
+				dropIns = append(dropIns, filepath.Join(path+".d", entry.Name()))
+			}
+		}
+		sort.Strings(dropIns)
+		files = append(files, dropIns...)
+	}
+
+	conf := new(sessionRecordingConfig)
+	for _, file := range files {
+		if _, err := toml.DecodeFile(file, conf); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return "", fmt.Errorf("decoding configuration file %q: %w", file, err)
+		}
+	}
+	dir := conf.Engine.SessionRecordingDir
+	if dir != "" && !filepath.IsAbs(dir) {
+		return "", fmt.Errorf("session recording directory %q must be an absolute path", dir)
+	}
+	return dir, nil
+}
+
+// sessionRecorders are the recorders of the sessions in progress, by the ID
+// of the exec session or, for attach sessions, of the container. Resizes of
+// the terminal are requested separately from the session itself, e.g. by a
+// separate request of the REST API, and are recorded by looking up the
+// recorders here.
+var sessionRecorders = struct {
+	lock sync.Mutex
+	byID map[string]map[*sessionRecorder]struct{}
+}{byID: make(map[string]map[*sessionRecorder]struct{})}
+
+// recordSessionResize records the new size of the terminal of the sessions
+// with the given ID.
+func recordSessionResize(id string, size resize.TerminalSize) {
+	sessionRecorders.lock.Lock()
+	defer sessionRecorders.lock.Unlock()
+	for recorder := range sessionRecorders.byID[id] {
+		recorder.resize(size)
+	}
+}
+
+// sessionRecorder records the terminal of an exec or attach session in the
+// asciicast v2 format.
+// The header of the recording is written with the first event, so that it
+// contains the initial size of the terminal, which is sent asynchronously.
+type sessionRecorder struct {
+	id     string
+	lock   sync.Mutex
+	header asciicast.Header
+	start  time.Time
+	// started is set once the header was written.
+	started bool
+	// recordings of the session: the one in the directory configured by
+	// the administrator and the one requested for the session, if any.
+	recordings []*sessionRecording
+}
+
+// sessionRecording is a file a session is recorded into.
+type sessionRecording struct {
+	file   *os.File
+	writer *asciicast.Writer
+	// failed is set after an error, further events are not recorded.
+	failed bool
+}
+
+// newSessionRecorder returns a recorder for a session of the container, or
+// nil if the session is not recorded. The session is recorded into the
+// directory configured for session recording and, if set, into path. The
+// user cannot opt out of the recording configured by the administrator,
+// path is an additional copy. The initial size of the terminal is size, if
+// known. The recorder must be closed when the session ends.
+func (c *Container) newSessionRecorder(path string, metadata *asciicast.Metadata, command []string, size *resize.TerminalSize) (_ *sessionRecorder, retErr error) {
+	id := metadata.SessionID
+	if id == "" {
+		id = c.ID()
+	}
+	dir, err := sessionRecordingDir()
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("creating session recording directory: %w", err)
+		}
+		name := fmt.Sprintf("%s-%s-%s-%s.cast", c.Name(), metadata.Type, time.Now().UTC().Format("20060102T150405Z"), id[:12])
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	if path != "" {
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	var recordings []*sessionRecording
+	defer func() {
+		if retErr != nil {
+			for _, recording := range recordings {
+				recording.file.Close()
+			}
+		}
+	}()
+	for _, path := range paths {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("creating session recording: %w", err)
+		}
+		recordings = append(recordings, &sessionRecording{file: file})
+		logrus.Debugf("Recording %s session of container %s to %s", metadata.Type, c.ID(), path)
+	}
+
+	metadata.ContainerID = c.ID()
+	metadata.ContainerName = c.Name()
+	metadata.User = currentUserName()
+	recorder := &sessionRecorder{
+		id:         id,
+		recordings: recordings,
+		header: asciicast.Header{
+			Width:   defaultRecordingWidth,
+			Height:  defaultRecordingHeight,
+			Command: strings.Join(command, " "),
+			Title:   fmt.Sprintf("podman %s %s", metadata.Type, c.Name()),
+			Podman:  metadata,
+		},
+		start: time.Now(),
+	}
+	if size != nil {
+		recorder.resize(*size)
+	}
+
+	sessionRecorders.lock.Lock()
+	defer sessionRecorders.lock.Unlock()
+	if sessionRecorders.byID[id] == nil {
+		sessionRecorders.byID[id] = make(map[*sessionRecorder]struct{})
+	}
+	sessionRecorders.byID[id][recorder] = struct{}{}
+	return recorder, nil
+}
+
+// recordAttach returns a recorder for an attach session of the container, or
+// nil if the session is not recorded.
+func (c *Container) recordAttach() (*sessionRecorder, error) {
+	if !c.Terminal() {
+		return nil, nil
+	}
+	var command []string
+	if c.config.Spec.Process != nil {
+		command = c.config.Spec.Process.Args
+	}
+	return c.newSessionRecorder("", &asciicast.Metadata{
+		Type:          sessionTypeAttach,
+		ContainerUser: c.config.User,
+	}, command, nil)
+}
+
+// recordExec returns a recorder for the exec session, or nil if the session is
+// not recorded. Health checks are never recorded.
+func (c *Container) recordExec(session *ExecSession, size *resize.TerminalSize, isHealthcheck bool) (*sessionRecorder, error) {
+	if !session.Config.Terminal || isHealthcheck {
+		return nil, nil
+	}
+	return c.newSessionRecorder(session.Config.RecordFile, &asciicast.Metadata{
+		Type:          sessionTypeExec,
+		SessionID:     session.ID(),
+		ContainerUser: session.Config.User,
+	}, session.Config.Command, size)
+}
+
+// currentUserName returns the name of the user running Podman.
+func currentUserName() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return strconv.Itoa(os.Getuid())
+}
+
+// write writes the header, unless it was written already, and passes the
+// writers of the recordings that did not fail to fn. Must be called with the
+// lock held.
+func (r *sessionRecorder) write(fn func(*asciicast.Writer) error) {
+	if !r.started {
+		r.started = true
+		for _, recording := range r.recordings {
+			writer, err := asciicast.NewWriter(recording.file, r.header, r.start)
+			if err != nil {
+				recording.fail(err)
+				continue
+			}
+			recording.writer = writer
+		}
+	}
+	for _, recording := range r.recordings {
+		if recording.failed {
+			continue
+		}
+		if err := fn(recording.writer); err != nil {
+			recording.fail(err)
+		}
+	}
+}
+
+// fail stops the recording after an error. The session itself and its
+// other recordings are not interrupted.
+func (r *sessionRecording) fail(err error) {
+	logrus.Errorf("Recording session to %s: %v", r.file.Name(), err)
+	r.failed = true
+}
+
+func (r *sessionRecorder) output(p []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	r.write(func(w *asciicast.Writer) error {
+		return w.WriteOutput(now, p)
+	})
+}
+
+func (r *sessionRecorder) resize(size resize.TerminalSize) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	// The first size is the initial size of the terminal.
+	if !r.started {
+		r.header.Width = size.Width
+		r.header.Height = size.Height
+		return
+	}
+	now := time.Now()
+	r.write(func(w *asciicast.Writer) error {
+		return w.WriteEvent(now, asciicast.EventResize, asciicast.ResizeData(size.Width, size.Height))
+	})
+}
+
+// close ends the recording with a marker event for the end of the session.
+func (r *sessionRecorder) close() {
+	sessionRecorders.lock.Lock()
+	delete(sessionRecorders.byID[r.id], r)
+	if len(sessionRecorders.byID[r.id]) == 0 {
+		delete(sessionRecorders.byID, r.id)
+	}
+	sessionRecorders.lock.Unlock()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	r.write(func(w *asciicast.Writer) error {
+		return w.WriteEvent(now, asciicast.EventMarker, "end")
+	})
+	for _, recording := range r.recordings {
+		if err := recording.file.Close(); err != nil {
+			logrus.Errorf("Closing session recording %s: %v", recording.file.Name(), err)
+		}
+	}
+}
+
+// recordStreams returns a copy of streams recording the output of the
+// session. With a terminal, all output is sent to the output stream.
+func (r *sessionRecorder) recordStreams(streams *define.AttachStreams) *define.AttachStreams {
+	if streams == nil || streams.OutputStream == nil {
+		return streams
+	}
+	recorded := *streams
+	recorded.OutputStream = &recordingWriter{w: streams.OutputStream, recorder: r}
+	return &recorded
+}
+
+// recordHTTPStreams returns a copy of streams recording the output of the
+// session streamed over HTTP.
+func (r *sessionRecorder) recordHTTPStreams(streams *HTTPAttachStreams) *HTTPAttachStreams {
+	recorded := &HTTPAttachStreams{Stdin: true, Stdout: true, Stderr: true}
+	if streams != nil {
+		*recorded = *streams
+	}
+	recorded.recorder = r
+	return recorded
+}
+
+// recordResize returns a channel passing on the terminal sizes sent to
+// sizes, recording them.
+func (r *sessionRecorder) recordResize(sizes <-chan resize.TerminalSize) <-chan resize.TerminalSize {
+	if sizes == nil {
+		return nil
+	}
+	recorded := make(chan resize.TerminalSize)
+	go func() {
+		defer close(recorded)
+		for size := range sizes {
+			r.resize(size)
+			recorded <- size
+		}
+	}()
+	return recorded
+}
+
+// recordingWriter records the data written to the wrapped writer.
+type recordingWriter struct {
+	w        io.Writer
+	recorder *sessionRecorder
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if n > 0 {
+		w.recorder.output(p[:n])
+	}
+	return n, err
+}
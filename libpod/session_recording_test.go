@@ -0,0 +1,151 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/common/pkg/resize"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/asciicast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setSessionRecordingConfigs makes the session recording directory be read
+// from the given containers.conf files.
+func setSessionRecordingConfigs(t *testing.T, paths ...string) {
+	saved := sessionRecordingConfigs
+	sessionRecordingConfigs = paths
+	t.Cleanup(func() { sessionRecordingConfigs = saved })
+}
+
+func TestSessionRecordingDir(t *testing.T) {
+	dir := t.TempDir()
+	vendorConf := filepath.Join(dir, "share.conf")
+	adminConf := filepath.Join(dir, "etc.conf")
+	setSessionRecordingConfigs(t, vendorConf, adminConf)
+
+	// Nothing is recorded without configuration files.
+	recordingDir, err := sessionRecordingDir()
+	require.NoError(t, err)
+	assert.Empty(t, recordingDir)
+
+	require.NoError(t, os.WriteFile(vendorConf, []byte("[engine]\nsession_recording_dir = \"/var/log/sessions\"\nunknown = true\n"), 0o600))
+	recordingDir, err = sessionRecordingDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/var/log/sessions", recordingDir)
+
+	// Drop-in files override the files they belong to.
+	require.NoError(t, os.Mkdir(vendorConf+".d", 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(vendorConf+".d", "10-audit.conf"), []byte("[engine]\nsession_recording_dir = \"/var/log/audit\"\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(vendorConf+".d", "20-other.toml"), []byte("[engine]\nsession_recording_dir = \"/ignored\"\n"), 0o600))
+	recordingDir, err = sessionRecordingDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/var/log/audit", recordingDir)
+
+	// Neither CONTAINERS_CONF nor the configuration of the user are read.
+	userConf := filepath.Join(dir, "user.conf")
+	require.NoError(t, os.WriteFile(userConf, []byte("[engine]\nsession_recording_dir = \"\"\n"), 0o600))
+	t.Setenv("CONTAINERS_CONF", userConf)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	recordingDir, err = sessionRecordingDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/var/log/audit", recordingDir)
+
+	require.NoError(t, os.WriteFile(adminConf, []byte("[engine]\nsession_recording_dir = \"sessions\"\n"), 0o600))
+	_, err = sessionRecordingDir()
+	assert.ErrorContains(t, err, "must be an absolute path")
+}
+
+func TestSessionRecorder(t *testing.T) {
+	dir := t.TempDir()
+	setSessionRecordingConfigs(t)
+
+	ctr := &Container{config: &ContainerConfig{ID: "0123456789abcdef0123", Name: "ctr"}}
+
+	// Nothing is recorded unless configured.
+	recorder, err := ctr.newSessionRecorder("", &asciicast.Metadata{Type: sessionTypeAttach}, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, recorder)
+
+	path := filepath.Join(dir, "session.cast")
+	recorder, err = ctr.newSessionRecorder(path, &asciicast.Metadata{Type: sessionTypeExec, SessionID: "abc"}, []string{"sh", "-l"}, &resize.TerminalSize{Width: 120, Height: 40})
+	require.NoError(t, err)
+	require.NotNil(t, recorder)
+
+	var out bytes.Buffer
+	streams := recorder.recordStreams(&define.AttachStreams{OutputStream: &out})
+
+	_, err = streams.OutputStream.Write([]byte("$ "))
+	require.NoError(t, err)
+	// Resizes are recorded by the ID of the session.
+	recordSessionResize("abc", resize.TerminalSize{Width: 100, Height: 30})
+	recordSessionResize(ctr.ID(), resize.TerminalSize{Width: 10, Height: 10})
+	recorder.close()
+	recordSessionResize("abc", resize.TerminalSize{Width: 10, Height: 10})
+
+	assert.Equal(t, "$ ", out.String())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	reader, header, err := asciicast.NewReader(f)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(120), header.Width)
+	assert.Equal(t, uint16(40), header.Height)
+	assert.Equal(t, "sh -l", header.Command)
+	require.NotNil(t, header.Podman)
+	assert.Equal(t, sessionTypeExec, header.Podman.Type)
+	assert.Equal(t, "ctr", header.Podman.ContainerName)
+	assert.Equal(t, "abc", header.Podman.SessionID)
+	assert.NotEmpty(t, header.Podman.User)
+
+	var events []string
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, event.Type+":"+event.Data)
+	}
+	assert.Equal(t, []string{"o:$ ", "r:100x30", "m:end"}, events)
+}
+
+func TestSessionRecorderConfiguredDir(t *testing.T) {
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "containers.conf")
+	recordingDir := filepath.Join(dir, "sessions")
+	require.NoError(t, os.WriteFile(conf, []byte("[engine]\nsession_recording_dir = \""+recordingDir+"\"\n"), 0o600))
+	setSessionRecordingConfigs(t, conf)
+
+	ctr := &Container{config: &ContainerConfig{ID: "0123456789abcdef0123", Name: "ctr"}}
+
+	// A file given for the session does not replace the recording
+	// configured by the administrator.
+	path := filepath.Join(dir, "session.cast")
+	recorder, err := ctr.newSessionRecorder(path, &asciicast.Metadata{Type: sessionTypeExec, SessionID: "0123456789abcdef"}, []string{"sh"}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, recorder)
+	streams := recorder.recordStreams(&define.AttachStreams{OutputStream: io.Discard})
+	_, err = streams.OutputStream.Write([]byte("secret"))
+	require.NoError(t, err)
+	recorder.close()
+
+	entries, err := os.ReadDir(recordingDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Regexp(t, `^ctr-exec-[0-9TZ]+-0123456789ab\.cast$`, entries[0].Name())
+	recorded, err := os.ReadFile(filepath.Join(recordingDir, entries[0].Name()))
+	require.NoError(t, err)
+	copied, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(recorded), `"o","secret"`)
+	assert.Equal(t, recorded, copied)
+}
@@ -302,6 +302,18 @@ func (s *SQLiteState) GetDBConfig() (*DBConfig, error) {
 	return cfg, nil
 }
 
+// SetGraphDriver changes the graph driver stored in the database.
+func (s *SQLiteState) SetGraphDriver(driver string) error {
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	if _, err := s.conn.Exec("UPDATE DBConfig SET GraphDriver=?;", driver); err != nil {
+		return fmt.Errorf("updating graph driver in database: %w", err)
+	}
+	return nil
+}
+
 // ValidateDBConfig validates paths in the given runtime against the database
 func (s *SQLiteState) ValidateDBConfig(runtime *Runtime) (defErr error) {
 	if !s.valid {
@@ -794,14 +806,25 @@ func (s *SQLiteState) ContainerInUse(ctr *Container) ([]string, error) {
 // AllContainers retrieves all the containers in the database
 // If `loadState` is set, the containers' state will be loaded as well.
 func (s *SQLiteState) AllContainers(loadState bool) ([]*Container, error) {
+	return s.QueryContainers(loadState, nil)
+}
+
+// QueryContainers retrieves the containers in the database matching the
+// query, which is evaluated in SQL.  A nil query matches all containers.
+// If `loadState` is set, the containers' state will be loaded as well.
+func (s *SQLiteState) QueryContainers(loadState bool, query *ContainerQuery) ([]*Container, error) {
 	if !s.valid {
 		return nil, define.ErrDBClosed
 	}
 
 	ctrs := []*Container{}
+	if query.matchesNothing() {
+		return ctrs, nil
+	}
+	where, args := containerQueryToSQL(query)
 
 	if loadState {
-		rows, err := s.conn.Query("SELECT ContainerConfig.JSON, ContainerState.JSON AS StateJSON FROM ContainerConfig INNER JOIN ContainerState ON ContainerConfig.ID = ContainerState.ID;")
+		rows, err := s.conn.Query("SELECT ContainerConfig.JSON, ContainerState.JSON AS StateJSON FROM ContainerConfig INNER JOIN ContainerState ON ContainerConfig.ID = ContainerState.ID"+where+";", args...)
 		if err != nil {
 			return nil, fmt.Errorf("retrieving all containers from database: %w", err)
 		}
@@ -831,7 +854,7 @@ func (s *SQLiteState) AllContainers(loadState bool) ([]*Container, error) {
 			return nil, err
 		}
 	} else {
-		rows, err := s.conn.Query("SELECT JSON FROM ContainerConfig;")
+		rows, err := s.conn.Query("SELECT JSON FROM ContainerConfig"+where+";", args...)
 		if err != nil {
 			return nil, fmt.Errorf("retrieving all containers from database: %w", err)
 		}
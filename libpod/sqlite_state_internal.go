@@ -41,6 +41,12 @@ func initSQLiteDB(conn *sql.DB) (defErr error) {
 			return err
 		}
 	}
+	if err := createContainerFilterTables(tx); err != nil {
+		return err
+	}
+	if err := indexContainerFilterFields(tx); err != nil {
+		return err
+	}
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
 	}
@@ -226,9 +232,201 @@ func createSQLiteTables(tx *sql.Tx) error {
 			return fmt.Errorf("creating table %s: %w", tblName, err)
 		}
 	}
+
+	return nil
+}
+
+// createContainerFilterTables creates the tables and indexes of the fields
+// containers are filtered by, if they do not exist yet.
+// They are created in databases of any schema version, without changing the
+// version: older versions of Podman ignore them, so they do not reference
+// ContainerConfig, which these versions change without updating them.
+// Containers added by older versions are indexed by
+// indexContainerFilterFields the next time the database is opened.
+func createContainerFilterTables(tx *sql.Tx) error {
+	const containerLabel = `
+        CREATE TABLE IF NOT EXISTS ContainerLabel(
+                ContainerID TEXT NOT NULL,
+                Name        TEXT NOT NULL,
+                Value       TEXT NOT NULL,
+                PRIMARY KEY (ContainerID, Name)
+        );`
+
+	// Every indexed container has a row in ContainerImage, even if
+	// it has no image.
+	const containerImage = `
+        CREATE TABLE IF NOT EXISTS ContainerImage(
+                ContainerID TEXT PRIMARY KEY NOT NULL,
+                ImageID     TEXT NOT NULL,
+                ImageName   TEXT NOT NULL
+        );`
+
+	tables := map[string]string{
+		"ContainerLabel": containerLabel,
+		"ContainerImage": containerImage,
+	}
+	for tblName, cmd := range tables {
+		if _, err := tx.Exec(cmd); err != nil {
+			return fmt.Errorf("creating table %s: %w", tblName, err)
+		}
+	}
+
+	indexes := map[string]string{
+		"ContainerConfigPodID":  "CREATE INDEX IF NOT EXISTS ContainerConfigPodID ON ContainerConfig(PodID);",
+		"ContainerLabelName":    "CREATE INDEX IF NOT EXISTS ContainerLabelName ON ContainerLabel(Name, Value);",
+		"ContainerImageImageID": "CREATE INDEX IF NOT EXISTS ContainerImageImageID ON ContainerImage(ImageID);",
+	}
+	for idxName, cmd := range indexes {
+		if _, err := tx.Exec(cmd); err != nil {
+			return fmt.Errorf("creating index %s: %w", idxName, err)
+		}
+	}
+	return nil
+}
+
+// indexContainerFilterFields indexes the containers that were added by older
+// versions of Podman, and drops the fields of the containers they removed.
+func indexContainerFilterFields(tx *sql.Tx) error {
+	for _, table := range []string{"ContainerLabel", "ContainerImage"} {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE ContainerID NOT IN (SELECT ID FROM ContainerConfig);", table)); err != nil {
+			return fmt.Errorf("removing filter fields of removed containers from %s: %w", table, err)
+		}
+	}
+
+	configs := make(map[string]*ContainerConfig)
+	rows, err := tx.Query("SELECT ID, JSON FROM ContainerConfig WHERE ID NOT IN (SELECT ContainerID FROM ContainerImage);")
+	if err != nil {
+		return fmt.Errorf("querying containers without filter fields: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, rawJSON string
+		if err := rows.Scan(&id, &rawJSON); err != nil {
+			return fmt.Errorf("scanning container config row: %w", err)
+		}
+		ctrCfg := new(ContainerConfig)
+		if err := json.Unmarshal([]byte(rawJSON), ctrCfg); err != nil {
+			return fmt.Errorf("unmarshalling container %s config: %w", id, err)
+		}
+		configs[id] = ctrCfg
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for id, ctrCfg := range configs {
+		if err := insertContainerFilterFields(tx, id, ctrCfg); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// insertContainerFilterFields adds the labels and the image of a container to
+// the tables containers are filtered by.
+func insertContainerFilterFields(tx *sql.Tx, id string, ctrCfg *ContainerConfig) error {
+	if _, err := tx.Exec("INSERT INTO ContainerImage VALUES (?, ?, ?);", id, ctrCfg.RootfsImageID, ctrCfg.RootfsImageName); err != nil {
+		return fmt.Errorf("adding container %s image to database: %w", id, err)
+	}
+	for name, value := range ctrCfg.Labels {
+		if _, err := tx.Exec("INSERT INTO ContainerLabel VALUES (?, ?, ?);", id, name, value); err != nil {
+			return fmt.Errorf("adding container %s label %s to database: %w", id, name, err)
+		}
+	}
+	return nil
+}
+
+// deleteContainerFilterFields removes the labels and the image of a container
+// from the tables containers are filtered by.
+func deleteContainerFilterFields(tx *sql.Tx, id string) error {
+	if _, err := tx.Exec("DELETE FROM ContainerImage WHERE ContainerID=?;", id); err != nil {
+		return fmt.Errorf("removing container %s image from database: %w", id, err)
+	}
+	if _, err := tx.Exec("DELETE FROM ContainerLabel WHERE ContainerID=?;", id); err != nil {
+		return fmt.Errorf("removing container %s labels from database: %w", id, err)
+	}
+	return nil
+}
+
+// containerQueryToSQL returns the WHERE clause, if any, and its arguments for
+// a container query on ContainerConfig.  The labels and the image are looked
+// up in the indexed ContainerLabel and ContainerImage tables.  Containers
+// that an older version of Podman added since the database was opened are
+// not in these tables yet, so they match any label and image condition.
+func containerQueryToSQL(q *ContainerQuery) (string, []any) {
+	if q == nil {
+		return "", nil
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	anyOf := func(alternatives []string) {
+		conditions = append(conditions, "("+strings.Join(alternatives, " OR ")+")")
+	}
+	placeholders := func(n int) string {
+		return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+	}
+
+	if len(q.IDPrefixes) > 0 {
+		alternatives := make([]string, 0, len(q.IDPrefixes))
+		for _, prefix := range q.IDPrefixes {
+			alternatives = append(alternatives, "ContainerConfig.ID LIKE ?")
+			args = append(args, prefix+"%")
+		}
+		anyOf(alternatives)
+	}
+	if len(q.NameSubstrings) > 0 {
+		alternatives := make([]string, 0, len(q.NameSubstrings))
+		for _, name := range q.NameSubstrings {
+			alternatives = append(alternatives, "instr(ContainerConfig.Name, ?) > 0")
+			args = append(args, name)
+		}
+		anyOf(alternatives)
+	}
+	if len(q.PodIDs) > 0 {
+		conditions = append(conditions, "ContainerConfig.PodID IN ("+placeholders(len(q.PodIDs))+")")
+		for _, id := range q.PodIDs {
+			args = append(args, id)
+		}
+	}
+
+	var (
+		indexed     []string
+		indexedArgs []any
+	)
+	for _, label := range q.Labels {
+		if label.Value == "" {
+			indexed = append(indexed, "ContainerConfig.ID IN (SELECT ContainerID FROM ContainerLabel WHERE Name=?)")
+			indexedArgs = append(indexedArgs, label.Key)
+			continue
+		}
+		indexed = append(indexed, "ContainerConfig.ID IN (SELECT ContainerID FROM ContainerLabel WHERE Name=? AND Value=?)")
+		indexedArgs = append(indexedArgs, label.Key, label.Value)
+	}
+	if len(q.Images) > 0 {
+		alternatives := []string{"ImageID IN (" + placeholders(len(q.Images)) + ")"}
+		for _, image := range q.Images {
+			indexedArgs = append(indexedArgs, image)
+		}
+		for _, image := range q.Images {
+			alternatives = append(alternatives, "instr(ImageName, ?) > 0")
+			indexedArgs = append(indexedArgs, image)
+		}
+		indexed = append(indexed, "ContainerConfig.ID IN (SELECT ContainerID FROM ContainerImage WHERE "+strings.Join(alternatives, " OR ")+")")
+	}
+	if len(indexed) > 0 {
+		conditions = append(conditions, "(ContainerConfig.ID NOT IN (SELECT ContainerID FROM ContainerImage) OR ("+strings.Join(indexed, " AND ")+"))")
+		args = append(args, indexedArgs...)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
 // Get the config of a container with the given ID from the database
 func (s *SQLiteState) getCtrConfig(id string) (*ContainerConfig, error) {
 	row := s.conn.QueryRow("SELECT JSON FROM ContainerConfig WHERE ID=?;", id)
@@ -378,6 +576,12 @@ func (s *SQLiteState) rewriteContainerConfig(ctr *Container, newCfg *ContainerCo
 		ctr.valid = false
 		return define.ErrNoSuchCtr
 	}
+	if err := deleteContainerFilterFields(tx, ctr.ID()); err != nil {
+		return err
+	}
+	if err := insertContainerFilterFields(tx, ctr.ID(), newCfg); err != nil {
+		return err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("committing transaction to rewrite container %s config: %w", ctr.ID(), err)
@@ -436,6 +640,9 @@ func (s *SQLiteState) addContainer(ctr *Container) (defErr error) {
 	if _, err := tx.Exec("INSERT INTO ContainerState VALUES (?, ?, ?, ?);", ctr.ID(), int(ctr.state.State), ctr.state.ExitCode, stateJSON); err != nil {
 		return fmt.Errorf("adding container state to database: %w", err)
 	}
+	if err := insertContainerFilterFields(tx, ctr.ID(), ctr.config); err != nil {
+		return err
+	}
 	for _, dep := range deps {
 		// Check if the dependency is in the same pod
 		var depPod sql.NullString
@@ -525,7 +732,7 @@ func (s *SQLiteState) removeContainerWithTx(id string, tx *sql.Tx) error {
 	if _, err := tx.Exec("DELETE FROM ContainerExecSession WHERE ContainerID=?;", id); err != nil {
 		return fmt.Errorf("removing container %s exec sessions from database: %w", id, err)
 	}
-	return nil
+	return deleteContainerFilterFields(tx, id)
 }
 
 // networkModify allows you to modify or add a new network, to add a new network use the new bool
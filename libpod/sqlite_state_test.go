@@ -0,0 +1,195 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/libpod/lock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Get an empty SQLite state with a lock manager for numLocks locks.
+func getEmptySqliteState(t testing.TB, numLocks uint32) (*SQLiteState, lock.Manager) {
+	tmpDir := t.TempDir()
+
+	lockManager, err := lock.NewInMemoryManager(numLocks)
+	require.NoError(t, err)
+
+	runtime := new(Runtime)
+	runtime.config = new(config.Config)
+	runtime.config.Engine.StaticDir = tmpDir
+	runtime.lockManager = lockManager
+
+	state, err := NewSqliteState(runtime)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		state.Close()
+	})
+
+	return state.(*SQLiteState), lockManager
+}
+
+func queriedIDs(t *testing.T, state State, query *ContainerQuery) []string {
+	ctrs, err := state.QueryContainers(true, query)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		ids = append(ids, ctr.ID())
+	}
+	return ids
+}
+
+func TestSQLiteQueryContainers(t *testing.T) {
+	state, manager := getEmptySqliteState(t, 16)
+
+	ctr1, err := getTestCtr1(manager)
+	require.NoError(t, err)
+	ctr2, err := getTestCtr2(manager)
+	require.NoError(t, err)
+	ctr2.config.Labels = map[string]string{"app": "web"}
+	ctr2.config.RootfsImageName = "quay.io/podman/hello:latest"
+	require.NoError(t, state.AddContainer(ctr1))
+	require.NoError(t, state.AddContainer(ctr2))
+
+	tests := []struct {
+		name     string
+		query    *ContainerQuery
+		expected []string
+	}{
+		{"nil", nil, []string{ctr1.ID(), ctr2.ID()}},
+		{"empty", &ContainerQuery{}, []string{ctr1.ID(), ctr2.ID()}},
+		{"id prefix", &ContainerQuery{IDPrefixes: []string{"222"}}, []string{ctr2.ID()}},
+		{"name", &ContainerQuery{NameSubstrings: []string{"st1", "bogus"}}, []string{ctr1.ID()}},
+		{"label key", &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "app"}}}, []string{ctr2.ID()}},
+		{"label value", &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "a", Value: "b"}}}, []string{ctr1.ID()}},
+		{"all labels", &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "a", Value: "b"}, {Key: "app"}}}, []string{}},
+		{"image name", &ContainerQuery{Images: []string{"podman/hello"}}, []string{ctr2.ID()}},
+		{"image id", &ContainerQuery{Images: []string{ctr1.ID()}}, []string{ctr1.ID()}},
+		{"no pods", &ContainerQuery{PodIDs: []string{}}, []string{}},
+		{"other pod", &ContainerQuery{PodIDs: []string{"bogus"}}, []string{}},
+		{"combined", &ContainerQuery{NameSubstrings: []string{"test"}, Labels: []ContainerQueryLabel{{Key: "app", Value: "web"}}}, []string{ctr2.ID()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.expected, queriedIDs(t, state, tt.query))
+		})
+	}
+
+	// Labels are updated when the config is rewritten.
+	newCfg := *ctr2.config
+	newCfg.Labels = map[string]string{"app": "db"}
+	require.NoError(t, state.RewriteContainerConfig(ctr2, &newCfg))
+	assert.Empty(t, queriedIDs(t, state, &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "app", Value: "web"}}}))
+	assert.ElementsMatch(t, []string{ctr2.ID()}, queriedIDs(t, state, &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "app", Value: "db"}}}))
+
+	// Labels are removed with the container.
+	require.NoError(t, state.RemoveContainer(ctr2))
+	assert.Empty(t, queriedIDs(t, state, &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "app"}}}))
+	var labels int
+	require.NoError(t, state.conn.QueryRow("SELECT COUNT(*) FROM ContainerLabel WHERE ContainerID=?;", ctr2.ID()).Scan(&labels))
+	assert.Zero(t, labels)
+}
+
+func TestSQLiteQueryContainersSchema(t *testing.T) {
+	state, manager := getEmptySqliteState(t, 16)
+
+	// Add a container like older versions of Podman do, without the
+	// fields containers are filtered by.
+	ctr, err := getTestCtr1(manager)
+	require.NoError(t, err)
+	configJSON, err := json.Marshal(ctr.config)
+	require.NoError(t, err)
+	stateJSON, err := json.Marshal(ctr.state)
+	require.NoError(t, err)
+	tx, err := state.conn.Begin()
+	require.NoError(t, err)
+	for _, stmt := range []struct {
+		sql  string
+		args []any
+	}{
+		{"INSERT INTO IDNamespace VALUES (?);", []any{ctr.ID()}},
+		{"INSERT INTO ContainerConfig VALUES (?, ?, ?, ?);", []any{ctr.ID(), ctr.Name(), nil, string(configJSON)}},
+		{"INSERT INTO ContainerState VALUES (?, ?, ?, ?);", []any{ctr.ID(), int(ctr.state.State), ctr.state.ExitCode, string(stateJSON)}},
+	} {
+		_, err := tx.Exec(stmt.sql, stmt.args...)
+		require.NoError(t, err, stmt.sql)
+	}
+	require.NoError(t, tx.Commit())
+
+	query := &ContainerQuery{
+		Labels: []ContainerQueryLabel{{Key: "test", Value: "testing"}},
+		Images: []string{"testimg"},
+	}
+	other := &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "test", Value: "other"}}}
+	// Until the container is indexed, it matches any label and image.
+	assert.ElementsMatch(t, []string{ctr.ID()}, queriedIDs(t, state, query))
+	assert.ElementsMatch(t, []string{ctr.ID()}, queriedIDs(t, state, other))
+
+	// It is indexed when the database is opened again.
+	tx, err = state.conn.Begin()
+	require.NoError(t, err)
+	require.NoError(t, indexContainerFilterFields(tx))
+	require.NoError(t, tx.Commit())
+	assert.ElementsMatch(t, []string{ctr.ID()}, queriedIDs(t, state, query))
+	assert.Empty(t, queriedIDs(t, state, other))
+
+	// Older versions of Podman remove the container without its fields,
+	// which are dropped when the database is opened again.
+	tx, err = state.conn.Begin()
+	require.NoError(t, err)
+	for _, table := range []string{"IDNamespace", "ContainerConfig", "ContainerState"} {
+		_, err := tx.Exec("DELETE FROM "+table+" WHERE ID=?;", ctr.ID())
+		require.NoError(t, err, table)
+	}
+	require.NoError(t, tx.Commit())
+	tx, err = state.conn.Begin()
+	require.NoError(t, err)
+	require.NoError(t, indexContainerFilterFields(tx))
+	require.NoError(t, tx.Commit())
+	var rows int
+	require.NoError(t, state.conn.QueryRow("SELECT (SELECT COUNT(*) FROM ContainerLabel) + (SELECT COUNT(*) FROM ContainerImage);").Scan(&rows))
+	assert.Zero(t, rows)
+}
+
+// BenchmarkSQLiteQueryContainers compares loading all containers and
+// filtering them in Go with evaluating the filter in the database, for a
+// filter matching one percent of the containers.
+func BenchmarkSQLiteQueryContainers(b *testing.B) {
+	for _, numCtrs := range []int{100, 1000, 5000} {
+		state, manager := getEmptySqliteState(b, uint32(numCtrs))
+		for i := range numCtrs {
+			ctr, err := getTestContainer(fmt.Sprintf("%064x", i), fmt.Sprintf("bench%d", i), manager)
+			require.NoError(b, err)
+			if i%100 == 0 {
+				ctr.config.Labels["bench"] = "match"
+			}
+			require.NoError(b, state.AddContainer(ctr))
+		}
+
+		b.Run(fmt.Sprintf("%d/filter", numCtrs), func(b *testing.B) {
+			for range b.N {
+				ctrs, err := state.AllContainers(true)
+				require.NoError(b, err)
+				matches := 0
+				for _, ctr := range ctrs {
+					if ctr.config.Labels["bench"] == "match" {
+						matches++
+					}
+				}
+				require.Equal(b, numCtrs/100, matches)
+			}
+		})
+		b.Run(fmt.Sprintf("%d/query", numCtrs), func(b *testing.B) {
+			query := &ContainerQuery{Labels: []ContainerQueryLabel{{Key: "bench", Value: "match"}}}
+			for range b.N {
+				ctrs, err := state.QueryContainers(true, query)
+				require.NoError(b, err)
+				require.Len(b, ctrs, numCtrs/100)
+			}
+		})
+	}
+}
@@ -41,6 +41,11 @@ type State interface { //nolint:interfacebloat
 	// the program.
 	ValidateDBConfig(runtime *Runtime) error
 
+	// SetGraphDriver changes the c/storage graph driver stored in the
+	// database, once the storage was migrated to the given driver.
+	// This is not implemented by the in-memory state.
+	SetGraphDriver(driver string) error
+
 	// Resolve an ID to a Container Name.
 	GetContainerName(id string) (string, error)
 	// Resolve an ID to a Pod Name.
@@ -95,6 +100,11 @@ type State interface { //nolint:interfacebloat
 	// If a namespace is set, only containers within the namespace will be
 	// returned.
 	AllContainers(loadState bool) ([]*Container, error)
+	// Retrieves the containers presently in state that may match the
+	// query. States which cannot evaluate (parts of) the query return
+	// more containers than match it, up to all containers.
+	// If `loadState` is set, the containers' state will be loaded as well.
+	QueryContainers(loadState bool, query *ContainerQuery) ([]*Container, error)
 
 	// Get networks the container is currently connected to.
 	GetNetworks(ctr *Container) (map[string]types.PerNetworkOptions, error)
@@ -0,0 +1,357 @@
+// Package admission implements the container creation admission policy.
+//
+// The policy is read from files with the PolicySuffix suffix in the
+// containers.conf.d drop-in directories of the administrator and is
+// evaluated by libpod against the configuration of every container before it
+// is created, regardless of whether the container was created via the CLI,
+// Quadlet, kube play, a checkpoint restore or the REST API.
+package admission
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/rootless"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// PolicySuffix is the suffix of admission policy files in the
+	// containers.conf.d directories.
+	PolicySuffix = ".policy.toml"
+	// PolicyEnv is the environment variable which, if set, points to the
+	// only admission policy file to read.  It is only honored for root,
+	// who can change the policy files anyway, and is meant for testing.
+	PolicyEnv = "CONTAINERS_ADMISSION_POLICY"
+
+	// ModeEnforce denies the creation of containers violating the policy.
+	ModeEnforce = "enforce"
+	// ModeAudit only logs policy violations.
+	ModeAudit = "audit"
+
+	// ResourceMemory requires a memory limit.
+	ResourceMemory = "memory"
+	// ResourceCPU requires a CPU quota.
+	ResourceCPU = "cpu"
+	// ResourcePids requires a pids limit.
+	ResourcePids = "pids"
+)
+
+// Policy is the admission policy for creating containers.
+type Policy struct {
+	// Mode is either ModeEnforce (default) or ModeAudit.
+	Mode string `toml:"mode,omitempty"`
+	// DenyPrivileged denies privileged containers.
+	DenyPrivileged bool `toml:"deny_privileged,omitempty"`
+	// AllowedRegistries restricts the images containers can be created
+	// from to the listed registries or repository namespaces.
+	AllowedRegistries []string `toml:"allowed_registries,omitempty"`
+	// RequiredResourceLimits lists the resources (memory, cpu, pids)
+	// each container must be limited in.
+	RequiredResourceLimits []string `toml:"required_resource_limits,omitempty"`
+	// ForbiddenHostMounts lists host paths which must not be mounted
+	// into containers, neither directly nor via a parent directory.
+	ForbiddenHostMounts []string `toml:"forbidden_host_mounts,omitempty"`
+	// RequiredLabels lists labels each container must have, either as
+	// key or as key=value.
+	RequiredLabels []string `toml:"required_labels,omitempty"`
+	// ReadOnlyRootfs requires containers to have a read-only rootfs.
+	ReadOnlyRootfs bool `toml:"read_only_rootfs,omitempty"`
+}
+
+// Container describes a container to be admitted.
+type Container struct {
+	// Name is the name of the container.  It is empty if the name is
+	// generated by Podman.
+	Name string
+	// Image is the name of the image the container is created from, if
+	// any.
+	Image string
+	// ImageSources are the registry repositories the image of the
+	// container was pulled from.  The names of the image are not used
+	// since any name can be given to an image with podman tag.
+	ImageSources []string
+	// Privileged is set for privileged containers.
+	Privileged bool
+	// HostMounts are the host paths bind mounted into the container,
+	// including the sources of overlay volumes.
+	HostMounts []string
+	// HostDevices are the host devices passed to the container.
+	HostDevices []string
+	// Labels are the labels of the container.
+	Labels map[string]string
+	// RuntimeSpec is the OCI runtime spec generated for the container.
+	RuntimeSpec *spec.Spec
+	// Infra is set for infra containers of pods, which are only checked
+	// for privileges and host mounts.
+	Infra bool
+}
+
+// policyDirs are the directories admission policy files are read from, in
+// the order they are read.  Only directories of the administrator are used,
+// so that users cannot weaken the policy with files of their own.
+var policyDirs = []string{
+	config.DefaultContainersConfig + ".d",
+	config.OverrideContainersConfig + ".d",
+}
+
+// policyFiles returns the admission policy files in the order they are
+// read.
+func policyFiles() ([]string, error) {
+	if path := os.Getenv(PolicyEnv); path != "" {
+		if rootless.IsRootless() {
+			logrus.Warnf("Ignoring %s, it is only honored for root", PolicyEnv)
+		} else {
+			return []string{path}, nil
+		}
+	}
+
+	var files []string
+	for _, dir := range policyDirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		// ReadDir returns the entries sorted by name.
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), PolicySuffix) {
+				files = append(files, filepath.Join(dir, entry.Name()))
+			}
+		}
+	}
+	return files, nil
+}
+
+// Policies are the admission policies of all policy files.  Each policy is
+// evaluated on its own, so a policy file can add restrictions but cannot lift
+// the ones of another file.
+type Policies []*Policy
+
+// Load reads the admission policies.  The returned policies are empty if no
+// policy file exists.
+func Load() (Policies, error) {
+	files, err := policyFiles()
+	if err != nil {
+		return nil, fmt.Errorf("looking up admission policy files: %w", err)
+	}
+
+	policies := make(Policies, 0, len(files))
+	for _, file := range files {
+		logrus.Debugf("Reading admission policy file %q", file)
+		policy := &Policy{}
+		meta, err := toml.DecodeFile(file, policy)
+		if err != nil {
+			return nil, fmt.Errorf("decoding admission policy file %q: %w", file, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("admission policy file %q: unknown keys %v", file, undecoded)
+		}
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("admission policy file %q: %w", file, err)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// Admit evaluates all policies against ctr.  If any policy in enforce mode
+// is violated, an error wrapping define.ErrAdmissionDenied and listing the
+// violations of all policies in enforce mode is returned.  Violations of
+// policies in audit mode are only logged.
+func (ps Policies) Admit(ctr *Container) error {
+	name := ctr.Name
+	if name == "" {
+		name = ctr.Image
+	}
+
+	var violations []string
+	for _, p := range ps {
+		if p == nil {
+			continue
+		}
+		v := p.Violations(ctr)
+		if p.Mode == ModeAudit {
+			for _, violation := range v {
+				logrus.Warnf("Admission policy violation by container %q (audit mode): %s", name, violation)
+			}
+			continue
+		}
+		violations = append(violations, v...)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: container %q: %s", define.ErrAdmissionDenied, name, strings.Join(violations, "; "))
+}
+
+// Validate checks the policy for invalid settings.
+func (p *Policy) Validate() error {
+	switch p.Mode {
+	case "", ModeEnforce, ModeAudit:
+	default:
+		return fmt.Errorf("invalid admission policy mode %q, must be %q or %q", p.Mode, ModeEnforce, ModeAudit)
+	}
+	for _, resource := range p.RequiredResourceLimits {
+		switch resource {
+		case ResourceMemory, ResourceCPU, ResourcePids:
+		default:
+			return fmt.Errorf("invalid required resource limit %q, must be one of %q, %q or %q", resource, ResourceMemory, ResourceCPU, ResourcePids)
+		}
+	}
+	for _, path := range p.ForbiddenHostMounts {
+		if !filepath.IsAbs(path) {
+			return fmt.Errorf("forbidden host mount %q must be an absolute path", path)
+		}
+	}
+	return nil
+}
+
+// Admit evaluates the policy against ctr like Policies.Admit.
+func (p *Policy) Admit(ctr *Container) error {
+	return Policies{p}.Admit(ctr)
+}
+
+// Violations returns all violations of the policy by ctr.
+func (p *Policy) Violations(ctr *Container) []string {
+	var violations []string
+
+	if p.DenyPrivileged && ctr.Privileged {
+		violations = append(violations, "privileged containers are not allowed")
+	}
+	violations = append(violations, p.hostMountViolations(ctr)...)
+	if ctr.Infra {
+		return violations
+	}
+
+	if len(p.AllowedRegistries) > 0 && !p.registryAllowed(ctr.ImageSources) {
+		if ctr.Image == "" {
+			violations = append(violations, "containers must be created from an image of an allowed registry")
+		} else {
+			violations = append(violations, fmt.Sprintf("image %q was not pulled from an allowed registry", ctr.Image))
+		}
+	}
+
+	var resources *spec.LinuxResources
+	if ctr.RuntimeSpec != nil && ctr.RuntimeSpec.Linux != nil {
+		resources = ctr.RuntimeSpec.Linux.Resources
+	}
+	for _, resource := range p.RequiredResourceLimits {
+		if !hasResourceLimit(resources, resource) {
+			violations = append(violations, fmt.Sprintf("a %s limit is required", resource))
+		}
+	}
+
+	for _, label := range p.RequiredLabels {
+		key, value, hasValue := strings.Cut(label, "=")
+		actual, ok := ctr.Labels[key]
+		switch {
+		case !ok:
+			violations = append(violations, fmt.Sprintf("label %q is required", key))
+		case hasValue && actual != value:
+			violations = append(violations, fmt.Sprintf("label %q must be set to %q", key, value))
+		}
+	}
+
+	if p.ReadOnlyRootfs && !hasReadOnlyRootfs(ctr) {
+		violations = append(violations, "a read-only rootfs is required")
+	}
+	return violations
+}
+
+// hostMountViolations returns a violation for each host path bind mounted or
+// device passed into the container which is, is below or contains a forbidden
+// host mount.  Mounts added by Podman itself, like /etc/hosts, are not
+// checked.
+func (p *Policy) hostMountViolations(ctr *Container) []string {
+	if len(p.ForbiddenHostMounts) == 0 {
+		return nil
+	}
+
+	var violations []string
+	seen := make(map[string]bool)
+	check := func(paths []string, format string) {
+		for _, path := range paths {
+			path = filepath.Clean(path)
+			if seen[path] {
+				continue
+			}
+			seen[path] = true
+			if p.forbidden(path) {
+				violations = append(violations, fmt.Sprintf(format, path))
+			}
+		}
+	}
+	check(ctr.HostMounts, "mounting host path %q is not allowed")
+	check(ctr.HostDevices, "passing host device %q is not allowed")
+	return violations
+}
+
+// forbidden returns true if path is, is below or contains a forbidden host
+// mount.
+func (p *Policy) forbidden(path string) bool {
+	for _, forbidden := range p.ForbiddenHostMounts {
+		forbidden = filepath.Clean(forbidden)
+		if pathContains(forbidden, path) || pathContains(path, forbidden) {
+			return true
+		}
+	}
+	return false
+}
+
+// registryAllowed returns true if any of the repositories is in an allowed
+// registry or repository namespace.
+func (p *Policy) registryAllowed(repos []string) bool {
+	for _, name := range repos {
+		named, err := reference.ParseNormalizedNamed(name)
+		if err != nil {
+			continue
+		}
+		repo := named.Name()
+		for _, allowed := range p.AllowedRegistries {
+			allowed = strings.TrimSuffix(allowed, "/")
+			if repo == allowed || strings.HasPrefix(repo, allowed+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasResourceLimit(resources *spec.LinuxResources, resource string) bool {
+	if resources == nil {
+		return false
+	}
+	switch resource {
+	case ResourceMemory:
+		return resources.Memory != nil && resources.Memory.Limit != nil && *resources.Memory.Limit > 0
+	case ResourceCPU:
+		return resources.CPU != nil && resources.CPU.Quota != nil && *resources.CPU.Quota > 0
+	case ResourcePids:
+		return resources.Pids != nil && resources.Pids.Limit > 0
+	}
+	return false
+}
+
+func hasReadOnlyRootfs(ctr *Container) bool {
+	return ctr.RuntimeSpec != nil && ctr.RuntimeSpec.Root != nil && ctr.RuntimeSpec.Root.Readonly
+}
+
+// pathContains returns true if path is dir or below dir.
+func pathContains(dir, path string) bool {
+	if dir == path || dir == "/" {
+		return true
+	}
+	return strings.HasPrefix(path, dir+string(filepath.Separator))
+}
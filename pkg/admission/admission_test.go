@@ -0,0 +1,228 @@
+package admission
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setPolicyDirs makes Load read the policy files from dirs.
+func setPolicyDirs(t *testing.T, dirs ...string) {
+	orig := policyDirs
+	policyDirs = dirs
+	t.Cleanup(func() { policyDirs = orig })
+}
+
+func writePolicy(t *testing.T, content string) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test"+PolicySuffix), []byte(content), 0o644))
+	setPolicyDirs(t, dir)
+}
+
+func TestLoad(t *testing.T) {
+	writePolicy(t, `
+mode = "audit"
+deny_privileged = true
+allowed_registries = ["quay.io"]
+required_resource_limits = ["memory", "pids"]
+forbidden_host_mounts = ["/etc"]
+required_labels = ["owner"]
+read_only_rootfs = true
+`)
+	policies, err := Load()
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, &Policy{
+		Mode:                   ModeAudit,
+		DenyPrivileged:         true,
+		AllowedRegistries:      []string{"quay.io"},
+		RequiredResourceLimits: []string{ResourceMemory, ResourcePids},
+		ForbiddenHostMounts:    []string{"/etc"},
+		RequiredLabels:         []string{"owner"},
+		ReadOnlyRootfs:         true,
+	}, policies[0])
+}
+
+func TestLoadMultiple(t *testing.T) {
+	system, admin := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(system, "50-base"+PolicySuffix), []byte("deny_privileged = true\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(admin, "10-labels"+PolicySuffix), []byte("required_labels = [\"owner\"]\n"), 0o644))
+	// A later file in audit mode does not weaken the earlier ones.
+	require.NoError(t, os.WriteFile(filepath.Join(admin, "99-audit"+PolicySuffix), []byte("mode = \"audit\"\nread_only_rootfs = true\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(admin, "ignored.toml"), []byte("deny_privileged = false\n"), 0o644))
+	setPolicyDirs(t, system, filepath.Join(t.TempDir(), "missing"), admin)
+
+	policies, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, Policies{
+		{DenyPrivileged: true},
+		{RequiredLabels: []string{"owner"}},
+		{Mode: ModeAudit, ReadOnlyRootfs: true},
+	}, policies)
+
+	ctr := testContainer()
+	ctr.Privileged = true
+	ctr.Labels = nil
+	ctr.RuntimeSpec.Root = nil
+	err = policies.Admit(ctr)
+	assert.ErrorContains(t, err, `container "test": privileged containers are not allowed; label "owner" is required`)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"unknown key", `deny_privilege = true`, "unknown keys"},
+		{"mode", `mode = "warn"`, "invalid admission policy mode"},
+		{"resource", `required_resource_limits = ["io"]`, "invalid required resource limit"},
+		{"relative mount", `forbidden_host_mounts = ["etc"]`, "must be an absolute path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writePolicy(t, tt.content)
+			_, err := Load()
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func testContainer() *Container {
+	memory := int64(1 << 20)
+	return &Container{
+		Name:   "test",
+		Image:  "quay.io/libpod/alpine:latest",
+		Labels: map[string]string{"owner": "team"},
+		RuntimeSpec: &spec.Spec{
+			Root: &spec.Root{Readonly: true},
+			Linux: &spec.Linux{Resources: &spec.LinuxResources{
+				Memory: &spec.LinuxMemory{Limit: &memory},
+				Pids:   &spec.LinuxPids{Limit: 100},
+			}},
+		},
+		ImageSources: []string{"quay.io/libpod/alpine"},
+	}
+}
+
+func testPolicy() *Policy {
+	return &Policy{
+		DenyPrivileged:         true,
+		AllowedRegistries:      []string{"quay.io/libpod", "registry.example.com"},
+		RequiredResourceLimits: []string{ResourceMemory, ResourcePids},
+		ForbiddenHostMounts:    []string{"/etc", "/var/run/docker.sock", "/dev/sda"},
+		RequiredLabels:         []string{"owner=team"},
+		ReadOnlyRootfs:         true,
+	}
+}
+
+func TestViolations(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(*Container)
+		violations []string
+	}{
+		{"compliant", func(*Container) {}, nil},
+		{
+			"privileged",
+			func(c *Container) { c.Privileged = true },
+			[]string{"privileged containers are not allowed"},
+		},
+		{
+			"registry",
+			func(c *Container) { c.ImageSources = []string{"docker.io/library/alpine"} },
+			[]string{`image "quay.io/libpod/alpine:latest" was not pulled from an allowed registry`},
+		},
+		{
+			"registry namespace prefix",
+			func(c *Container) { c.ImageSources = []string{"quay.io/libpodder/alpine"} },
+			[]string{`image "quay.io/libpod/alpine:latest" was not pulled from an allowed registry`},
+		},
+		{
+			"registry any source",
+			func(c *Container) {
+				c.ImageSources = []string{"docker.io/library/alpine", "registry.example.com/alpine"}
+			},
+			nil,
+		},
+		{
+			"no pull source",
+			func(c *Container) { c.ImageSources = nil },
+			[]string{`image "quay.io/libpod/alpine:latest" was not pulled from an allowed registry`},
+		},
+		{
+			"rootfs",
+			func(c *Container) {
+				c.Image = ""
+				c.ImageSources = nil
+			},
+			[]string{"containers must be created from an image of an allowed registry"},
+		},
+		{
+			"resource limits",
+			func(c *Container) { c.RuntimeSpec.Linux.Resources = nil },
+			[]string{"a memory limit is required", "a pids limit is required"},
+		},
+		{
+			"host mounts",
+			func(c *Container) { c.HostMounts = []string{"/etc/ssh", "/var/run/", "/srv/data", "/etc/ssh"} },
+			[]string{`mounting host path "/etc/ssh" is not allowed`, `mounting host path "/var/run" is not allowed`},
+		},
+		{
+			"host devices",
+			func(c *Container) { c.HostDevices = []string{"/dev/sda", "/dev/fuse"} },
+			[]string{`passing host device "/dev/sda" is not allowed`},
+		},
+		{
+			"labels",
+			func(c *Container) { c.Labels = map[string]string{"owner": "other"} },
+			[]string{`label "owner" must be set to "team"`},
+		},
+		{
+			"read-only rootfs",
+			func(c *Container) { c.RuntimeSpec.Root.Readonly = false },
+			[]string{"a read-only rootfs is required"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctr := testContainer()
+			tt.modify(ctr)
+			assert.Equal(t, tt.violations, testPolicy().Violations(ctr))
+		})
+	}
+}
+
+func TestViolationsInfra(t *testing.T) {
+	ctr := testContainer()
+	ctr.Infra = true
+	ctr.ImageSources = nil
+	ctr.Labels = nil
+	ctr.HostMounts = []string{"/etc"}
+	assert.Equal(t, []string{`mounting host path "/etc" is not allowed`}, testPolicy().Violations(ctr))
+}
+
+func TestAdmit(t *testing.T) {
+	ctr := testContainer()
+	ctr.Labels = nil
+
+	policy := testPolicy()
+	err := policy.Admit(ctr)
+	assert.True(t, errors.Is(err, define.ErrAdmissionDenied))
+	assert.ErrorContains(t, err, `container "test": label "owner" is required`)
+
+	ctr.Name = ""
+	assert.ErrorContains(t, policy.Admit(ctr), `container "quay.io/libpod/alpine:latest": label "owner" is required`)
+
+	policy.Mode = ModeAudit
+	assert.NoError(t, policy.Admit(ctr))
+
+	var policies Policies
+	assert.NoError(t, policies.Admit(ctr))
+}
@@ -13,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers"
@@ -200,7 +201,7 @@ func GetContainer(w http.ResponseWriter, r *http.Request) {
 		utils.ContainerNotFound(w, name, err)
 		return
 	}
-	api, err := LibpodToContainerJSON(ctnr, query.Size)
+	api, err := LibpodToContainerJSON(ctnr, query.Size, utils.RequestVersion(r))
 	if err != nil {
 		utils.InternalServerError(w, err)
 		return
@@ -417,7 +418,7 @@ func convertSecondaryIPPrefixLen(input *define.InspectNetworkSettings, output *t
 	}
 }
 
-func LibpodToContainerJSON(l *libpod.Container, sz bool) (*types.ContainerJSON, error) {
+func LibpodToContainerJSON(l *libpod.Container, sz bool, apiVersion semver.Version) (*types.ContainerJSON, error) {
 	imageID, imageName := l.Image()
 	inspect, err := l.Inspect(sz)
 	if err != nil {
@@ -544,6 +545,9 @@ func LibpodToContainerJSON(l *libpod.Container, sz bool) (*types.ContainerJSON,
 			StartPeriod: inspect.Config.Healthcheck.StartPeriod,
 			Retries:     inspect.Config.Healthcheck.Retries,
 		}
+		if startup := inspect.Config.StartupHealthCheck; startup != nil && apiVersion.GTE(apiVersion144) {
+			healthcheck.StartInterval = startup.Interval
+		}
 	}
 
 	// Apparently the compiler can't convert a map[string]struct{} into a nat.PortSet
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/blang/semver/v4"
 	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/libnetwork/types"
@@ -95,7 +96,7 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Take body structure and convert to cliopts
-	cliOpts, args, err := cliOpts(body, rtc)
+	cliOpts, args, err := cliOpts(body, rtc, utils.RequestVersion(r))
 	if err != nil {
 		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("make cli opts(): %w", err))
 		return
@@ -117,6 +118,10 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("fill out specgen: %w", err))
 		return
 	}
+	// The startup healthcheck of StartInterval ends with the start period
+	if sg.StartupHealthConfig != nil {
+		sg.StartupHealthConfig.MaxDuration = body.Config.Healthcheck.StartPeriod
+	}
 	// moby always create the working directory
 	localTrue := true
 	sg.CreateWorkingDir = &localTrue
@@ -145,7 +150,7 @@ func stringMaptoArray(m map[string]string) []string {
 }
 
 // cliOpts converts a compat input struct to cliopts
-func cliOpts(cc handlers.CreateContainerConfig, rtc *config.Config) (*entities.ContainerCreateOptions, []string, error) {
+func cliOpts(cc handlers.CreateContainerConfig, rtc *config.Config, apiVersion semver.Version) (*entities.ContainerCreateOptions, []string, error) {
 	var (
 		capAdd     []string
 		cappDrop   []string
@@ -615,6 +620,16 @@ func cliOpts(cc handlers.CreateContainerConfig, rtc *config.Config) (*entities.C
 		if cc.Config.Healthcheck.Timeout > 0 {
 			cliOpts.HealthTimeout = cc.Config.Healthcheck.Timeout.String()
 		}
+		// Docker probes at StartInterval during the start period until the
+		// first success, then at Interval.  A startup healthcheck running
+		// the same test, which ends with the start period at the latest,
+		// models this; the regular healthcheck then counts the Retries.
+		if cc.Config.Healthcheck.StartInterval > 0 && cc.Config.Healthcheck.StartPeriod > 0 && apiVersion.GTE(apiVersion144) &&
+			len(cc.Config.Healthcheck.Test) > 0 && cc.Config.Healthcheck.Test[0] != define.HealthConfigTestNone {
+			cliOpts.StartupHCCmd = finCmd
+			cliOpts.StartupHCInterval = cc.Config.Healthcheck.StartInterval.String()
+			cliOpts.StartupHCTimeout = cliOpts.HealthTimeout
+		}
 	}
 
 	// specgen assumes the image name is arg[0]
@@ -18,6 +18,7 @@ func StartContainer(w http.ResponseWriter, r *http.Request) {
 	decoder := utils.GetDecoder(r)
 	query := struct {
 		DetachKeys string `schema:"detachKeys"`
+		Timing     bool   `schema:"timing"`
 	}{
 		// Override golang default values for types
 	}
@@ -44,5 +45,15 @@ func StartContainer(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
+	if query.Timing && utils.IsLibpodRequest(r) {
+		// The container may be gone by the time the client could
+		// inspect it, e.g. with --rm, so return the timing right away.
+		timing, err := con.Timing()
+		if err != nil {
+			logrus.Debugf("Getting timing of container %s: %v", con.ID(), err)
+		}
+		utils.WriteResponse(w, http.StatusOK, timing)
+		return
+	}
 	utils.WriteResponse(w, http.StatusNoContent, nil)
 }
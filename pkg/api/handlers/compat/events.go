@@ -5,6 +5,7 @@ package compat
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/events"
@@ -28,9 +29,11 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 	// NOTE: the "filters" parameter is extracted separately for backwards
 	// compat via `filterFromRequest()`.
 	query := struct {
-		Since  string `schema:"since"`
-		Until  string `schema:"until"`
-		Stream bool   `schema:"stream"`
+		AfterID   uint64 `schema:"afterID"`
+		Heartbeat uint   `schema:"heartbeat"`
+		Since     string `schema:"since"`
+		Until     string `schema:"until"`
+		Stream    bool   `schema:"stream"`
 	}{
 		Stream: true,
 	}
@@ -39,7 +42,7 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(query.Since) > 0 || len(query.Until) > 0 {
+	if len(query.Since) > 0 || len(query.Until) > 0 || query.AfterID > 0 {
 		fromStart = true
 	}
 
@@ -51,6 +54,7 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 	eventChannel := make(chan events.ReadResult)
 
 	readOpts := events.ReadOptions{
+		AfterID:      query.AfterID,
 		FromStart:    fromStart,
 		Stream:       query.Stream,
 		Filters:      libpodFilters,
@@ -76,10 +80,41 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 	coder := json.NewEncoder(w)
 	coder.SetEscapeHTML(true)
 
+	// Heartbeats are only sent when the stream was idle for the requested
+	// number of seconds, a nil channel never fires.
+	var (
+		heartbeat         <-chan time.Time
+		heartbeatInterval = time.Duration(query.Heartbeat) * time.Second
+		resetHeartbeat    = func() {}
+	)
+	if query.Stream && heartbeatInterval > 0 {
+		timer := time.NewTimer(heartbeatInterval)
+		defer timer.Stop()
+		heartbeat = timer.C
+		resetHeartbeat = func() {
+			// Stop and drain the timer first, so that a heartbeat
+			// which fired meanwhile is not sent right after the
+			// reset.
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(heartbeatInterval)
+		}
+	}
+
 	for {
 		select {
 		case <-r.Context().Done():
 			return
+		case now := <-heartbeat:
+			if err := coder.Encode(heartbeatEvent(now)); err != nil {
+				logrus.Errorf("Unable to write json: %q", err)
+			}
+			flush()
+			resetHeartbeat()
 		case evt, ok := <-eventChannel:
 			if !ok {
 				return
@@ -109,6 +144,19 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 				logrus.Errorf("Unable to write json: %q", err)
 			}
 			flush()
+			resetHeartbeat()
 		}
 	}
 }
+
+// heartbeatEvent returns the message sent on idle event streams.
+func heartbeatEvent(now time.Time) *entities.Event {
+	e := new(entities.Event)
+	e.Type = entities.EventTypeHeartbeat
+	e.Action = entities.EventTypeHeartbeat
+	e.Status = entities.EventTypeHeartbeat
+	e.Scope = "local"
+	e.Time = now.Unix()
+	e.TimeNano = now.UnixNano()
+	return e
+}
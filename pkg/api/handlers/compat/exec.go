@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"strings"
 
 	"github.com/containers/common/pkg/resize"
@@ -61,6 +62,13 @@ func ExecCreateHandler(w http.ResponseWriter, r *http.Request) {
 	libpodConfig.WorkDir = input.WorkingDir
 	libpodConfig.Privileged = input.Privileged
 	libpodConfig.User = input.User
+	if utils.IsLibpodRequest(r) {
+		if input.RecordFile != "" && !filepath.IsAbs(input.RecordFile) {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("record file %q must be an absolute path", input.RecordFile))
+			return
+		}
+		libpodConfig.RecordFile = input.RecordFile
+	}
 
 	if input.Tty {
 		util.ExecAddTERM(ctr.Env(), libpodConfig.Environment)
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/containers/buildah"
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
@@ -30,6 +31,7 @@ import (
 	dockerImage "github.com/docker/docker/api/types/image"
 	"github.com/docker/go-connections/nat"
 	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
 )
 
@@ -74,7 +76,7 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 		Output: tmpfile.Name(),
 	}
 
-	if err := imageEngine.Save(r.Context(), possiblyNormalizedName, nil, saveOptions); err != nil {
+	if _, err := imageEngine.Save(r.Context(), possiblyNormalizedName, nil, saveOptions); err != nil {
 		if errors.Is(err, storage.ErrImageUnknown) {
 			utils.ImageNotFound(w, name, fmt.Errorf("failed to find image %s: %w", name, err))
 			return
@@ -339,7 +341,7 @@ func GetImage(w http.ResponseWriter, r *http.Request) {
 		utils.Error(w, http.StatusNotFound, fmt.Errorf("failed to find image %s: %s", name, errMsg))
 		return
 	}
-	inspect, err := imageDataToImageInspect(r.Context(), newImage)
+	inspect, err := imageDataToImageInspect(r.Context(), newImage, utils.RequestVersion(r))
 	if err != nil {
 		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("failed to convert ImageData to ImageInspect '%s': %w", name, err))
 		return
@@ -347,7 +349,7 @@ func GetImage(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, inspect)
 }
 
-func imageDataToImageInspect(ctx context.Context, l *libimage.Image) (*handlers.ImageInspect, error) {
+func imageDataToImageInspect(ctx context.Context, l *libimage.Image, apiVersion semver.Version) (*handlers.ImageInspect, error) {
 	options := &libimage.InspectOptions{WithParent: true, WithSize: true}
 	info, err := l.Inspect(ctx, options)
 	if err != nil {
@@ -384,33 +386,39 @@ func imageDataToImageInspect(ctx context.Context, l *libimage.Image) (*handlers.
 		Name: info.GraphDriver.Name,
 		Data: info.GraphDriver.Data,
 	}
-	// Add in basic ContainerConfig to satisfy docker-compose
-	cc := new(dockerContainer.Config)
-	cc.Hostname = info.ID[0:11] // short ID is the hostname
-	cc.Volumes = info.Config.Volumes
-
 	dockerImageInspect := docker.ImageInspect{
-		Architecture:    info.Architecture,
-		Author:          info.Author,
-		Comment:         info.Comment,
-		Config:          &config,
-		ContainerConfig: cc,
-		Created:         l.Created().Format(time.RFC3339Nano),
-		DockerVersion:   info.Version,
-		GraphDriver:     graphDriver,
-		ID:              "sha256:" + l.ID(),
-		Metadata:        dockerImage.Metadata{},
-		Os:              info.Os,
-		OsVersion:       info.Version,
-		Parent:          info.Parent,
-		RepoDigests:     info.RepoDigests,
-		RepoTags:        info.RepoTags,
-		RootFS:          rootfs,
-		Size:            info.Size,
-		Variant:         "",
-		VirtualSize:     info.VirtualSize,
-	}
-	return &handlers.ImageInspect{ImageInspect: dockerImageInspect}, nil
+		Architecture:  info.Architecture,
+		Author:        info.Author,
+		Comment:       info.Comment,
+		Config:        &config,
+		Created:       l.Created().Format(time.RFC3339Nano),
+		DockerVersion: info.Version,
+		GraphDriver:   graphDriver,
+		ID:            "sha256:" + l.ID(),
+		Metadata:      dockerImage.Metadata{},
+		Os:            info.Os,
+		OsVersion:     info.Version,
+		Parent:        info.Parent,
+		RepoDigests:   info.RepoDigests,
+		RepoTags:      info.RepoTags,
+		RootFS:        rootfs,
+		Size:          info.Size,
+		Variant:       "",
+	}
+	if apiVersion.LT(apiVersion144) {
+		dockerImageInspect.VirtualSize = info.VirtualSize
+	}
+	inspect := &handlers.ImageInspect{ImageInspect: dockerImageInspect}
+	if apiVersion.LT(apiVersion145) {
+		// Add in basic ContainerConfig to satisfy docker-compose
+		cc := new(dockerContainer.Config)
+		cc.Hostname = info.ID[0:11] // short ID is the hostname
+		cc.Volumes = info.Config.Volumes
+		inspect.ContainerConfig = cc
+		container := ""
+		inspect.Container = &container
+	}
+	return inspect, nil
 }
 
 // portsToPortSet converts libpod's exposed ports to docker's structs
@@ -444,9 +452,10 @@ func GetImages(w http.ResponseWriter, r *http.Request) {
 	decoder := utils.GetDecoder(r)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	query := struct {
-		All     bool
-		Digests bool
-		Filter  string // Docker 1.24 compatibility
+		All       bool
+		Digests   bool
+		Filter    string // Docker 1.24 compatibility
+		Manifests bool
 	}{
 		// This is where you can override the golang default value for one of fields
 	}
@@ -492,13 +501,78 @@ func GetImages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !utils.IsLibpodRequest(r) {
-		// docker adds sha256: in front of the ID
-		for _, s := range summaries {
-			s.ID = "sha256:" + s.ID
+	if utils.IsLibpodRequest(r) {
+		utils.WriteResponse(w, http.StatusOK, summaries)
+		return
+	}
+
+	apiVersion := utils.RequestVersion(r)
+	compatSummaries := make([]*handlers.ImageSummary, 0, len(summaries))
+	for _, s := range summaries {
+		summary := &handlers.ImageSummary{ImageSummary: s}
+		if apiVersion.LT(apiVersion144) {
+			summary.VirtualSize = &s.VirtualSize
+		}
+		if query.Manifests && apiVersion.GTE(apiVersion147) {
+			summary.Manifests, err = imageManifestSummaries(r.Context(), runtime, s.ID)
+			if err != nil {
+				utils.Error(w, http.StatusInternalServerError, err)
+				return
+			}
 		}
+		// docker adds sha256: in front of the ID
+		s.ID = "sha256:" + s.ID
+		compatSummaries = append(compatSummaries, summary)
 	}
-	utils.WriteResponse(w, http.StatusOK, summaries)
+	utils.WriteResponse(w, http.StatusOK, compatSummaries)
+}
+
+// imageManifestSummaries describes the manifest of the image with the given
+// ID in the shape of the Docker image list.  Local images always carry
+// exactly one manifest for a single platform.
+func imageManifestSummaries(ctx context.Context, runtime *libpod.Runtime, id string) ([]dockerImage.ManifestSummary, error) {
+	img, _, err := runtime.LibimageRuntime().LookupImage(id, nil)
+	if err != nil {
+		return nil, err
+	}
+	rawManifest, mimeType, err := img.Manifest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest of image %s: %w", id, err)
+	}
+	info, err := img.Inspect(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	size, err := img.Size()
+	if err != nil {
+		return nil, err
+	}
+	containers, err := img.Containers()
+	if err != nil {
+		return nil, err
+	}
+
+	summary := dockerImage.ManifestSummary{
+		ID: img.Digest().String(),
+		Descriptor: ocispec.Descriptor{
+			MediaType: mimeType,
+			Digest:    img.Digest(),
+			Size:      int64(len(rawManifest)),
+		},
+		Available: true,
+		Kind:      dockerImage.ManifestKindImage,
+		ImageData: &dockerImage.ImageProperties{
+			Platform: ocispec.Platform{
+				Architecture: info.Architecture,
+				OS:           info.Os,
+			},
+			Containers: containers,
+		},
+	}
+	summary.Size.Content = int64(len(rawManifest))
+	summary.Size.Total = size
+	summary.ImageData.Size.Unpacked = size
+	return []dockerImage.ManifestSummary{summary}, nil
 }
 
 func LoadImages(w http.ResponseWriter, r *http.Request) {
@@ -601,7 +675,7 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 	imageEngine := abi.ImageEngine{Libpod: runtime}
 
 	saveOptions := entities.ImageSaveOptions{Format: "docker-archive", Output: tmpfile.Name(), MultiImageArchive: true}
-	if err := imageEngine.Save(r.Context(), images[0], images[1:], saveOptions); err != nil {
+	if _, err := imageEngine.Save(r.Context(), images[0], images[1:], saveOptions); err != nil {
 		utils.InternalServerError(w, err)
 		return
 	}
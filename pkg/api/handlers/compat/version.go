@@ -8,6 +8,7 @@ import (
 	goRuntime "runtime"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/api/handlers/utils"
@@ -17,6 +18,20 @@ import (
 	"github.com/containers/podman/v5/version"
 )
 
+// Docker API levels at which the shape of compat requests or responses
+// changed.  Handlers compare them against utils.RequestVersion() so that
+// older clients keep seeing the fields they were written against.
+var (
+	// apiVersion144 dropped VirtualSize from image responses and added
+	// StartInterval to healthchecks.
+	apiVersion144 = semver.MustParse("1.44.0")
+	// apiVersion145 dropped Container and ContainerConfig from image
+	// inspect.
+	apiVersion145 = semver.MustParse("1.45.0")
+	// apiVersion147 added the manifests option to the image list.
+	apiVersion147 = semver.MustParse("1.47.0")
+)
+
 func VersionHandler(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 
@@ -0,0 +1,185 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/parallel"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+)
+
+// Batch returns the handler of a batch of operations. Each operation is
+// dispatched to the libpod API of router as if it was a request of its own.
+func Batch(router http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+		query := struct {
+			Parallel uint `schema:"parallel"`
+			FailFast bool `schema:"failFast"`
+		}{
+			// override any golang type defaults
+		}
+		if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+			return
+		}
+
+		var operations []entities.BatchOperation
+		if err := json.NewDecoder(r.Body).Decode(&operations); err != nil {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("decode(): %w", err))
+			return
+		}
+		requests := make([]*http.Request, len(operations))
+		for i, op := range operations {
+			req, err := newBatchRequest(r, op)
+			if err != nil {
+				utils.Error(w, http.StatusBadRequest, fmt.Errorf("operation %d: %w", i, err))
+				return
+			}
+			requests[i] = req
+		}
+
+		results := make([]entities.BatchResult, len(operations))
+		opts := parallel.Options{Parallel: query.Parallel, FailFast: query.FailFast}
+		// The operations are requests handled by the API, which queues
+		// their jobs.
+		errs := parallel.RunUnqueued(r.Context(), len(requests), opts, func(i int) error {
+			rw := newBatchResponseWriter()
+			router.ServeHTTP(rw, requests[i])
+			rw.WriteHeader(http.StatusOK)
+			results[i].StatusCode = rw.status
+			results[i].Body = rw.jsonBody()
+			if rw.status >= http.StatusBadRequest {
+				return fmt.Errorf("%s %s: %s", requests[i].Method, operations[i].Path, http.StatusText(rw.status))
+			}
+			return nil
+		})
+		for i, err := range errs {
+			if errors.Is(err, parallel.ErrSkipped) {
+				results[i].Skipped = true
+			}
+		}
+		utils.WriteResponse(w, http.StatusOK, results)
+	}
+}
+
+// newBatchRequest returns the request of the operation, which inherits the
+// context, API version and headers of the batch request.
+func newBatchRequest(r *http.Request, op entities.BatchOperation) (*http.Request, error) {
+	if op.Method == "" {
+		op.Method = http.MethodPost
+	}
+	if !strings.HasPrefix(op.Path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", op.Path)
+	}
+	if strings.HasPrefix(op.Path, "/batch") {
+		return nil, errors.New("batches cannot be nested")
+	}
+	if err := checkBatchStreaming(op.Path); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("/v%s/libpod%s", mux.Vars(r)["version"], op.Path)
+	req, err := http.NewRequestWithContext(r.Context(), op.Method, url, bytes.NewReader(op.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = r.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.ContentLength = int64(len(op.Body))
+	req.RemoteAddr = r.RemoteAddr
+	req.Host = r.Host
+	return req, nil
+}
+
+var (
+	// batchStreamingPaths match the endpoints which stream their response,
+	// attach to containers or transfer archives. The responses to the
+	// operations of a batch are buffered, so they cannot be part of one.
+	batchStreamingPaths = regexp.MustCompile(`^/(events|build|images/(pull|export|load|import)|images/[^/]+/(get|push)|containers/[^/]+/(attach|export|archive)|exec/[^/]+/start|manifests/[^/]+/registry/.+)$`)
+	// batchStatsPaths match the endpoints which stream unless the stream
+	// parameter is false.
+	batchStatsPaths = regexp.MustCompile(`^/containers/([^/]+/)?stats$`)
+)
+
+// checkBatchStreaming returns an error if the operation at path, including
+// its query, would stream its response.
+func checkBatchStreaming(path string) error {
+	path, rawQuery, _ := strings.Cut(path, "?")
+	if batchStreamingPaths.MatchString(path) {
+		return fmt.Errorf("%s streams its response and cannot be part of a batch", path)
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return fmt.Errorf("parsing query of %s: %w", path, err)
+	}
+	for _, param := range []string{"stream", "follow"} {
+		streaming := param == "stream" && batchStatsPaths.MatchString(path)
+		if value := query.Get(param); value != "" {
+			streaming, err = strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s parameter of %s: %w", param, path, err)
+			}
+		}
+		if streaming {
+			return fmt.Errorf("%s streams its response unless %s=false and cannot be part of a batch", path, param)
+		}
+	}
+	return nil
+}
+
+// batchResponseWriter records the response to an operation of a batch.
+// It cannot be hijacked, so that operations which attach to containers
+// fail.
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBatchResponseWriter() *batchResponseWriter {
+	return &batchResponseWriter{header: make(http.Header)}
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *batchResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+func (w *batchResponseWriter) Flush() {}
+
+// jsonBody returns the body of the response, as a JSON string if it is not
+// JSON already.
+func (w *batchResponseWriter) jsonBody() json.RawMessage {
+	body := bytes.TrimSpace(w.body.Bytes())
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+	s, _ := json.Marshal(string(body))
+	return s
+}
@@ -0,0 +1,39 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBatchStreaming(t *testing.T) {
+	for _, path := range []string{
+		"/containers/ctr/stop?timeout=0",
+		"/containers/ctr/json",
+		"/containers/ctr/logs",
+		"/containers/ctr/logs?follow=false",
+		"/containers/stats?stream=false",
+		"/containers/ctr/stats?stream=0",
+		"/pods/stats",
+	} {
+		assert.NoError(t, checkBatchStreaming(path), path)
+	}
+
+	for _, path := range []string{
+		"/events",
+		"/images/pull?reference=alpine",
+		"/images/alpine/push",
+		"/containers/ctr/attach?stream=false",
+		"/containers/ctr/archive?path=/etc",
+		"/exec/123/start",
+		"/containers/ctr/logs?follow=true",
+		"/containers/stats",
+		"/containers/ctr/stats?stream=true",
+		"/pods/pod/top?stream=1",
+		"/containers/ctr/logs?follow=maybe",
+	} {
+		assert.Error(t, checkBatchStreaming(path), path)
+	}
+}
@@ -219,16 +219,17 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
-		Keep           bool   `schema:"keep"`
-		LeaveRunning   bool   `schema:"leaveRunning"`
-		TCPEstablished bool   `schema:"tcpEstablished"`
-		Export         bool   `schema:"export"`
-		IgnoreRootFS   bool   `schema:"ignoreRootFS"`
-		PrintStats     bool   `schema:"printStats"`
-		PreCheckpoint  bool   `schema:"preCheckpoint"`
-		WithPrevious   bool   `schema:"withPrevious"`
-		FileLocks      bool   `schema:"fileLocks"`
-		CreateImage    string `schema:"createImage"`
+		Keep             bool   `schema:"keep"`
+		LeaveRunning     bool   `schema:"leaveRunning"`
+		TCPEstablished   bool   `schema:"tcpEstablished"`
+		Export           bool   `schema:"export"`
+		IgnoreRootFS     bool   `schema:"ignoreRootFS"`
+		PrintStats       bool   `schema:"printStats"`
+		PreCheckpoint    bool   `schema:"preCheckpoint"`
+		WithPrevious     bool   `schema:"withPrevious"`
+		FileLocks        bool   `schema:"fileLocks"`
+		CreateImage      string `schema:"createImage"`
+		EncryptionSecret string `schema:"encryptionSecret"`
 	}{
 		// override any golang type defaults
 	}
@@ -246,15 +247,16 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 	names := []string{name}
 
 	options := entities.CheckpointOptions{
-		Keep:           query.Keep,
-		LeaveRunning:   query.LeaveRunning,
-		TCPEstablished: query.TCPEstablished,
-		IgnoreRootFS:   query.IgnoreRootFS,
-		PrintStats:     query.PrintStats,
-		PreCheckPoint:  query.PreCheckpoint,
-		WithPrevious:   query.WithPrevious,
-		FileLocks:      query.FileLocks,
-		CreateImage:    query.CreateImage,
+		Keep:             query.Keep,
+		LeaveRunning:     query.LeaveRunning,
+		TCPEstablished:   query.TCPEstablished,
+		IgnoreRootFS:     query.IgnoreRootFS,
+		PrintStats:       query.PrintStats,
+		PreCheckPoint:    query.PreCheckpoint,
+		WithPrevious:     query.WithPrevious,
+		FileLocks:        query.FileLocks,
+		CreateImage:      query.CreateImage,
+		EncryptionSecret: query.EncryptionSecret,
 	}
 
 	if query.Export {
@@ -305,18 +307,19 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
-		Keep            bool   `schema:"keep"`
-		TCPEstablished  bool   `schema:"tcpEstablished"`
-		Import          bool   `schema:"import"`
-		Name            string `schema:"name"`
-		IgnoreRootFS    bool   `schema:"ignoreRootFS"`
-		IgnoreVolumes   bool   `schema:"ignoreVolumes"`
-		IgnoreStaticIP  bool   `schema:"ignoreStaticIP"`
-		IgnoreStaticMAC bool   `schema:"ignoreStaticMAC"`
-		PrintStats      bool   `schema:"printStats"`
-		FileLocks       bool   `schema:"fileLocks"`
-		PublishPorts    string `schema:"publishPorts"`
-		Pod             string `schema:"pod"`
+		Keep             bool   `schema:"keep"`
+		TCPEstablished   bool   `schema:"tcpEstablished"`
+		Import           bool   `schema:"import"`
+		Name             string `schema:"name"`
+		IgnoreRootFS     bool   `schema:"ignoreRootFS"`
+		IgnoreVolumes    bool   `schema:"ignoreVolumes"`
+		IgnoreStaticIP   bool   `schema:"ignoreStaticIP"`
+		IgnoreStaticMAC  bool   `schema:"ignoreStaticMAC"`
+		PrintStats       bool   `schema:"printStats"`
+		FileLocks        bool   `schema:"fileLocks"`
+		PublishPorts     string `schema:"publishPorts"`
+		Pod              string `schema:"pod"`
+		DecryptionSecret string `schema:"decryptionSecret"`
 	}{
 		// override any golang type defaults
 	}
@@ -326,17 +329,18 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	options := entities.RestoreOptions{
-		Name:            query.Name,
-		Keep:            query.Keep,
-		TCPEstablished:  query.TCPEstablished,
-		IgnoreRootFS:    query.IgnoreRootFS,
-		IgnoreVolumes:   query.IgnoreVolumes,
-		IgnoreStaticIP:  query.IgnoreStaticIP,
-		IgnoreStaticMAC: query.IgnoreStaticMAC,
-		PrintStats:      query.PrintStats,
-		FileLocks:       query.FileLocks,
-		PublishPorts:    strings.Fields(query.PublishPorts),
-		Pod:             query.Pod,
+		Name:             query.Name,
+		Keep:             query.Keep,
+		TCPEstablished:   query.TCPEstablished,
+		IgnoreRootFS:     query.IgnoreRootFS,
+		IgnoreVolumes:    query.IgnoreVolumes,
+		IgnoreStaticIP:   query.IgnoreStaticIP,
+		IgnoreStaticMAC:  query.IgnoreStaticMAC,
+		PrintStats:       query.PrintStats,
+		FileLocks:        query.FileLocks,
+		PublishPorts:     strings.Fields(query.PublishPorts),
+		Pod:              query.Pod,
+		DecryptionSecret: query.DecryptionSecret,
 	}
 
 	var names []string
@@ -31,6 +31,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/infra/abi"
 	domainUtils "github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/util"
 	utils2 "github.com/containers/podman/v5/utils"
 	"github.com/containers/storage"
@@ -176,8 +177,9 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
-		Compress bool   `schema:"compress"`
-		Format   string `schema:"format"`
+		AllPlatforms bool   `schema:"allPlatforms"`
+		Compress     bool   `schema:"compress"`
+		Format       string `schema:"format"`
 	}{
 		Format: define.OCIArchive,
 	}
@@ -189,7 +191,8 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 	}
 	name := utils.GetName(r)
 
-	if _, _, err := runtime.LibimageRuntime().LookupImage(name, nil); err != nil {
+	lookupOptions := &libimage.LookupImageOptions{ManifestList: query.AllPlatforms}
+	if _, _, err := runtime.LibimageRuntime().LookupImage(name, lookupOptions); err != nil {
 		utils.ImageNotFound(w, name, err)
 		return
 	}
@@ -221,11 +224,12 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 	imageEngine := abi.ImageEngine{Libpod: runtime}
 
 	saveOptions := entities.ImageSaveOptions{
-		Compress: query.Compress,
-		Format:   query.Format,
-		Output:   output,
+		AllPlatforms: query.AllPlatforms,
+		Compress:     query.Compress,
+		Format:       query.Format,
+		Output:       output,
 	}
-	if err := imageEngine.Save(r.Context(), name, nil, saveOptions); err != nil {
+	if _, err := imageEngine.Save(r.Context(), name, nil, saveOptions); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
 		return
 	}
@@ -255,6 +259,7 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
+		AllPlatforms                bool     `schema:"allPlatforms"`
 		Compress                    bool     `schema:"compress"`
 		Format                      string   `schema:"format"`
 		OciAcceptUncompressedLayers bool     `schema:"ociAcceptUncompressedLayers"`
@@ -308,6 +313,7 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 
 	// Use the ABI image engine to share as much code as possible.
 	opts := entities.ImageSaveOptions{
+		AllPlatforms:                query.AllPlatforms,
 		Compress:                    query.Compress,
 		Format:                      query.Format,
 		MultiImageArchive:           len(query.References) > 1,
@@ -316,7 +322,7 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 	}
 
 	imageEngine := abi.ImageEngine{Libpod: runtime}
-	if err := imageEngine.Save(r.Context(), query.References[0], query.References[1:], opts); err != nil {
+	if _, err := imageEngine.Save(r.Context(), query.References[0], query.References[1:], opts); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
 		return
 	}
@@ -347,6 +353,19 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 
 func ImagesLoad(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		ProgressFormat string `schema:"progressFormat"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.ProgressFormat != "" && query.ProgressFormat != progress.FormatJSON {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid progress format %q: only %q is supported", query.ProgressFormat, progress.FormatJSON))
+		return
+	}
 
 	tmpfile, err := os.CreateTemp("", "libpod-images-load.tar")
 	if err != nil {
@@ -366,6 +385,18 @@ func ImagesLoad(w http.ResponseWriter, r *http.Request) {
 	imageEngine := abi.ImageEngine{Libpod: runtime}
 
 	loadOptions := entities.ImageLoadOptions{Input: tmpfile.Name()}
+	if query.ProgressFormat == progress.FormatJSON {
+		// Stream the progress events, the last of which reports the
+		// result of the load.
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		loadOptions.ProgressFormat = progress.FormatJSON
+		loadOptions.Writer = &flushWriter{w: w}
+		if _, err := imageEngine.Load(r.Context(), loadOptions); err != nil {
+			logrus.Debugf("Loading image: %v", err)
+		}
+		return
+	}
 	loadReport, err := imageEngine.Load(r.Context(), loadOptions)
 	if err != nil {
 		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("unable to load image: %w", err))
@@ -374,6 +405,19 @@ func ImagesLoad(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, loadReport)
 }
 
+// flushWriter flushes every write to the client.
+type flushWriter struct {
+	w http.ResponseWriter
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	if flusher, ok := f.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
 func ImagesImport(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
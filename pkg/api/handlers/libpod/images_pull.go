@@ -19,6 +19,7 @@ import (
 	"github.com/containers/podman/v5/pkg/auth"
 	"github.com/containers/podman/v5/pkg/channel"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/gorilla/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -34,11 +35,14 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 		AllTags    bool   `schema:"allTags"`
 		CompatMode bool   `schema:"compatMode"`
 		PullPolicy string `schema:"policy"`
-		Quiet      bool   `schema:"quiet"`
-		Reference  string `schema:"reference"`
-		Retry      uint   `schema:"retry"`
-		RetryDelay string `schema:"retrydelay"`
-		TLSVerify  bool   `schema:"tlsVerify"`
+		// ProgressFormat "json" reports progress as structured events
+		// instead of the human-readable stream.
+		ProgressFormat string `schema:"progressFormat"`
+		Quiet          bool   `schema:"quiet"`
+		Reference      string `schema:"reference"`
+		Retry          uint   `schema:"retry"`
+		RetryDelay     string `schema:"retrydelay"`
+		TLSVerify      bool   `schema:"tlsVerify"`
 		// Platform fields below:
 		Arch    string `schema:"Arch"`
 		OS      string `schema:"OS"`
@@ -58,6 +62,13 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if query.ProgressFormat != "" {
+		if err := progress.ValidateFormat(query.ProgressFormat); err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	if len(query.Reference) == 0 {
 		utils.InternalServerError(w, errors.New("reference parameter cannot be empty"))
 		return
@@ -115,7 +126,7 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 
 	// Let's keep thing simple when running in quiet mode and pull directly.
 	if query.Quiet {
-		images, err := runtime.LibimageRuntime().Pull(r.Context(), query.Reference, pullPolicy, pullOptions)
+		images, err := runtime.PullImage(r.Context(), query.Reference, pullPolicy, pullOptions)
 		var report entities.ImagePullReport
 		if err != nil {
 			report.Error = err.Error()
@@ -139,11 +150,42 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 	pullOptions.Writer = writer
 
 	var pulledImages []*libimage.Image
+	pull := func(ctx context.Context) error {
+		var err error
+		pulledImages, err = runtime.PullImage(ctx, query.Reference, pullPolicy, pullOptions)
+		return err
+	}
+
+	events := make(chan *entities.ProgressEvent)
+	stopProgress := func() {}
+	if query.ProgressFormat == progress.FormatJSON {
+		tracker := progress.NewTracker(func(event *entities.ProgressEvent) {
+			select {
+			case events <- event:
+			case <-r.Context().Done():
+			}
+		})
+		pullOptions.Writer = nil
+		pullOptions.Progress, stopProgress = progress.Forward(tracker)
+
+		// Retry here rather than in libimage to report the retries.
+		retryOptions := progress.RetryOptions(pullOptions.MaxRetries, pullOptions.RetryDelay)
+		noRetries := uint(0)
+		pullOptions.MaxRetries = &noRetries
+		pullOnce := pull
+		pull = func(ctx context.Context) error {
+			return tracker.Run(ctx, retryOptions, func() error {
+				return pullOnce(ctx)
+			})
+		}
+	}
+
 	var pullError error
 	runCtx, cancel := context.WithCancel(r.Context())
 	go func() {
 		defer cancel()
-		pulledImages, pullError = runtime.LibimageRuntime().Pull(runCtx, query.Reference, pullPolicy, pullOptions)
+		pullError = pull(runCtx)
+		stopProgress()
 	}()
 
 	flush := func() {
@@ -167,6 +209,12 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 				logrus.Warnf("Failed to encode json: %v", err)
 			}
 			flush()
+		case event := <-events:
+			report.Progress = event
+			if err := enc.Encode(report); err != nil {
+				logrus.Warnf("Failed to encode json: %v", err)
+			}
+			flush()
 		case <-runCtx.Done():
 			for _, image := range pulledImages {
 				report.Images = append(report.Images, image.ID())
@@ -176,6 +224,10 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 			if pullError != nil {
 				report.Error = pullError.Error()
 			}
+			if query.ProgressFormat == progress.FormatJSON {
+				report.Progress = progress.Result(pullError)
+				report.Progress.Images = report.Images
+			}
 			if err := enc.Encode(report); err != nil {
 				logrus.Warnf("Failed to encode json: %v", err)
 			}
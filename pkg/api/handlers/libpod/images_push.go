@@ -17,6 +17,7 @@ import (
 	"github.com/containers/podman/v5/pkg/channel"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/gorilla/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -33,6 +34,7 @@ func PushImage(w http.ResponseWriter, r *http.Request) {
 		ForceCompressionFormat bool   `schema:"forceCompressionFormat"`
 		Destination            string `schema:"destination"`
 		Format                 string `schema:"format"`
+		ProgressFormat         string `schema:"progressFormat"`
 		RemoveSignatures       bool   `schema:"removeSignatures"`
 		Retry                  uint   `schema:"retry"`
 		RetryDelay             string `schema:"retryDelay"`
@@ -49,6 +51,13 @@ func PushImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if query.ProgressFormat != "" {
+		if err := progress.ValidateFormat(query.ProgressFormat); err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	source := strings.TrimSuffix(utils.GetName(r), "/push") // GetName returns the entire path
 	if _, err := utils.ParseStorageReference(source); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
@@ -85,6 +94,7 @@ func PushImage(w http.ResponseWriter, r *http.Request) {
 		ForceCompressionFormat: query.ForceCompressionFormat,
 		Format:                 query.Format,
 		Password:               password,
+		ProgressFormat:         query.ProgressFormat,
 		Quiet:                  query.Quiet,
 		RemoveSignatures:       query.RemoveSignatures,
 		RetryDelay:             query.RetryDelay,
@@ -148,7 +158,16 @@ func PushImage(w http.ResponseWriter, r *http.Request) {
 		var stream entities.ImagePushStream
 		select {
 		case s := <-writer.Chan():
-			stream.Stream = string(s)
+			if query.ProgressFormat == progress.FormatJSON {
+				// Each write is a single JSON-encoded event.
+				stream.Progress = new(entities.ProgressEvent)
+				if err := json.Unmarshal(s, stream.Progress); err != nil {
+					logrus.Warnf("Failed to decode progress event: %v", err)
+					continue
+				}
+			} else {
+				stream.Stream = string(s)
+			}
 			if err := enc.Encode(stream); err != nil {
 				logrus.Warnf("Failed to encode json: %v", err)
 			}
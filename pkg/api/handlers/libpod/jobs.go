@@ -0,0 +1,101 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	"github.com/containers/podman/v5/pkg/api/server/jobs"
+	api "github.com/containers/podman/v5/pkg/api/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/gorilla/schema"
+)
+
+func ListJobs(w http.ResponseWriter, r *http.Request) {
+	manager := r.Context().Value(api.JobManagerKey).(*jobs.Manager)
+
+	list := manager.List()
+	reports := make([]*entities.JobReport, 0, len(list))
+	for _, job := range list {
+		reports = append(reports, job.Report())
+	}
+	utils.WriteResponse(w, http.StatusOK, reports)
+}
+
+func InspectJob(w http.ResponseWriter, r *http.Request) {
+	manager := r.Context().Value(api.JobManagerKey).(*jobs.Manager)
+
+	job, err := manager.Get(utils.GetName(r))
+	if err != nil {
+		jobError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, job.Report())
+}
+
+func JobLogs(w http.ResponseWriter, r *http.Request) {
+	var (
+		manager = r.Context().Value(api.JobManagerKey).(*jobs.Manager)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+	query := struct {
+		Follow bool `schema:"follow"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	job, err := manager.Get(utils.GetName(r))
+	if err != nil {
+		jobError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	if err := job.CopyOutput(r.Context(), w, query.Follow); err != nil {
+		utils.Error(w, http.StatusInternalServerError, err)
+	}
+}
+
+func CancelJob(w http.ResponseWriter, r *http.Request) {
+	manager := r.Context().Value(api.JobManagerKey).(*jobs.Manager)
+
+	job, err := manager.Get(utils.GetName(r))
+	if err != nil {
+		jobError(w, err)
+		return
+	}
+	job.Cancel()
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
+func RemoveJob(w http.ResponseWriter, r *http.Request) {
+	manager := r.Context().Value(api.JobManagerKey).(*jobs.Manager)
+
+	if err := manager.Remove(utils.GetName(r)); err != nil {
+		jobError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
+func jobError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, jobs.ErrNoSuchJob):
+		utils.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, jobs.ErrJobRunning):
+		utils.Error(w, http.StatusConflict, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
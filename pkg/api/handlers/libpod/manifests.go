@@ -29,6 +29,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
 	"github.com/opencontainers/go-digest"
@@ -357,6 +358,7 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		CompressionLevel       *int     `schema:"compressionLevel"`
 		ForceCompressionFormat bool     `schema:"forceCompressionFormat"`
 		Format                 string   `schema:"format"`
+		ProgressFormat         string   `schema:"progressFormat"`
 		RemoveSignatures       bool     `schema:"removeSignatures"`
 		TLSVerify              bool     `schema:"tlsVerify"`
 		Quiet                  bool     `schema:"quiet"`
@@ -374,6 +376,13 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if query.ProgressFormat != "" {
+		if err := progress.ValidateFormat(query.ProgressFormat); err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	destination := utils.GetVar(r, "destination")
 	if err := utils.IsRegistryReference(destination); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
@@ -400,6 +409,7 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		ForceCompressionFormat: query.ForceCompressionFormat,
 		Format:                 query.Format,
 		Password:               password,
+		ProgressFormat:         query.ProgressFormat,
 		Quiet:                  true,
 		RemoveSignatures:       query.RemoveSignatures,
 		Username:               username,
@@ -460,7 +470,16 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		var report entities.ManifestPushReport
 		select {
 		case s := <-writer.Chan():
-			report.Stream = string(s)
+			if query.ProgressFormat == progress.FormatJSON {
+				// Each write is a single JSON-encoded event.
+				report.Progress = new(entities.ProgressEvent)
+				if err := json.Unmarshal(s, report.Progress); err != nil {
+					logrus.Warnf("Failed to decode progress event: %v", err)
+					continue
+				}
+			} else {
+				report.Stream = string(s)
+			}
 			if err := enc.Encode(report); err != nil {
 				logrus.Warnf("Failed to encode json: %v", err)
 			}
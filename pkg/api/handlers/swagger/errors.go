@@ -65,6 +65,13 @@ type manifestNotFound struct {
 	Body errorhandling.ErrorModel
 }
 
+// No such job
+// swagger:response
+type jobNotFound struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // Internal server error
 // swagger:response
 type internalError struct {
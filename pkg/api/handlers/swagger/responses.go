@@ -198,6 +198,27 @@ type systemCheckResponse struct {
 	Body entities.SystemCheckReport
 }
 
+// Batch
+// swagger:response
+type batchResponse struct {
+	// in:body
+	Body []entities.BatchResult
+}
+
+// Job
+// swagger:response
+type jobReport struct {
+	// in:body
+	Body entities.JobReport
+}
+
+// Job list
+// swagger:response
+type jobList struct {
+	// in:body
+	Body []entities.JobReport
+}
+
 // Disk usage
 // swagger:response
 type systemDiskUsage struct {
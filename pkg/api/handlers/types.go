@@ -5,6 +5,7 @@ import (
 	docker "github.com/docker/docker/api/types"
 	dockerBackend "github.com/docker/docker/api/types/backend"
 	dockerContainer "github.com/docker/docker/api/types/container"
+	dockerImage "github.com/docker/docker/api/types/image"
 	dockerNetwork "github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/api/types/system"
@@ -17,8 +18,20 @@ type AuthConfig struct {
 
 type ImageInspect struct {
 	docker.ImageInspect
-	// Container is for backwards compat but is basically unused
-	Container string
+	// Container is for backwards compat but is basically unused.  It is
+	// only reported to clients using API versions before 1.45.
+	Container *string `json:",omitempty"`
+}
+
+// ImageSummary is the compat representation of an image in the image list.
+type ImageSummary struct {
+	*entities.ImageSummary
+	// VirtualSize is only reported to clients using API versions before
+	// 1.44 and shadows the field of the embedded summary.
+	VirtualSize *int64 `json:",omitempty"`
+	// Manifests is only reported to clients using API version 1.47 or
+	// later which asked for it.
+	Manifests []dockerImage.ManifestSummary `json:",omitempty"`
 }
 
 type ContainerConfig struct {
@@ -164,6 +177,9 @@ type HistoryResponse struct {
 
 type ExecCreateConfig struct {
 	docker.ExecConfig
+	// RecordFile is the path of a file on the server to record the
+	// terminal session to, in asciicast v2 format. Libpod API only.
+	RecordFile string `json:"RecordFile,omitempty"`
 }
 
 type ExecStartConfig struct {
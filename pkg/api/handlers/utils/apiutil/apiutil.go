@@ -69,3 +69,19 @@ func SupportedVersionWithDefaults(r *http.Request) (semver.Version, error) {
 		fmt.Sprintf(">=%s <=%s", version.APIVersion[tree][version.MinimalAPI].String(),
 			version.APIVersion[tree][version.CurrentAPI].String()))
 }
+
+// RequestVersion returns the API version given by the client in the URL path.
+// Requests without a (valid) version in their path are assumed to target the
+// current API level of their endpoint tree, matching how Docker treats
+// unversioned paths.
+func RequestVersion(r *http.Request) semver.Version {
+	tree := version.Compat
+	if IsLibpodRequest(r) {
+		tree = version.Libpod
+	}
+	v, err := SupportedVersion(r, ">=0.0.0")
+	if err != nil {
+		return version.APIVersion[tree][version.CurrentAPI]
+	}
+	return v
+}
@@ -140,3 +140,32 @@ func TestEqualVersion(t *testing.T) {
 			rr.Body.String(), expected)
 	}
 }
+
+func TestRequestVersion(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/v1.40/images/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = mux.SetURLVars(req, map[string]string{"version": "1.40"})
+	if v := RequestVersion(req); v.String() != "1.40.0" {
+		t.Errorf("RequestVersion() = %q, want %q", v.String(), "1.40.0")
+	}
+
+	req, err = http.NewRequest(http.MethodGet, "/images/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := version.APIVersion[version.Compat][version.CurrentAPI]
+	if v := RequestVersion(req); !v.Equals(want) {
+		t.Errorf("RequestVersion() = %q, want %q", v.String(), want.String())
+	}
+
+	req, err = http.NewRequest(http.MethodGet, "/v5.0.0/libpod/images/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = version.APIVersion[version.Libpod][version.CurrentAPI]
+	if v := RequestVersion(req); !v.Equals(want) {
+		t.Errorf("RequestVersion() = %q, want %q", v.String(), want.String())
+	}
+}
@@ -33,6 +33,12 @@ func SupportedVersion(r *http.Request, condition string) (semver.Version, error)
 	return apiutil.SupportedVersion(r, condition)
 }
 
+// RequestVersion returns the API version given by the client in the URL path,
+// or the current API level of the endpoint tree if none was given.
+func RequestVersion(r *http.Request) semver.Version {
+	return apiutil.RequestVersion(r)
+}
+
 // WriteResponse encodes the given value as JSON or string and renders it for http client
 func WriteResponse(w http.ResponseWriter, code int, value interface{}) {
 	// RFC2616 explicitly states that the following status codes "MUST NOT
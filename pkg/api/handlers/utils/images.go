@@ -130,7 +130,7 @@ func CompatPull(ctx context.Context, w http.ResponseWriter, runtime *libpod.Runt
 
 	pullResChan := make(chan pullResult)
 	go func() {
-		pulledImages, err := runtime.LibimageRuntime().Pull(ctx, reference, pullPolicy, pullOptions)
+		pulledImages, err := runtime.PullImage(ctx, reference, pullPolicy, pullOptions)
 		pullResChan <- pullResult{images: pulledImages, err: err}
 	}()
 
@@ -0,0 +1,124 @@
+//go:build !remote
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/utils"
+	"github.com/containers/podman/v5/pkg/api/server/jobs"
+	"github.com/sirupsen/logrus"
+)
+
+// JobAPIHandler is like APIHandler, but runs the handler as an asynchronous
+// job of the given operation if the client asks for it with the async query
+// parameter.  The client is then answered with the report of the job right
+// away, and the response of the handler becomes the output of the job, of
+// the given kind.
+func (s *APIServer) JobAPIHandler(operation string, output jobs.Output, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if async, _ := strconv.ParseBool(r.URL.Query().Get("async")); !async {
+			s.apiWrapper(h, w, r, false)
+			return
+		}
+		s.startJob(operation, output, h, w, r)
+	}
+}
+
+func (s *APIServer) startJob(operation string, output jobs.Output, h http.HandlerFunc, w http.ResponseWriter, r *http.Request) {
+	// The body must outlive this request, so keep a copy of it around
+	// until the job is done.
+	body, err := os.CreateTemp("", "api_job_body")
+	if err != nil {
+		utils.InternalServerError(w, fmt.Errorf("failed to create tempfile: %w", err))
+		return
+	}
+	cleanup := func() {
+		body.Close()
+		if err := os.Remove(body.Name()); err != nil {
+			logrus.Errorf("Failed to remove temporary file: %v", err)
+		}
+	}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		cleanup()
+		utils.InternalServerError(w, fmt.Errorf("failed to write temporary file: %w", err))
+		return
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	// The job must neither be canceled when the client disconnects nor
+	// when this request is done.
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+	job, err := s.jobs.Create(operation, output, cancel)
+	if err != nil {
+		cancel()
+		cleanup()
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	req := r.Clone(ctx)
+	query := req.URL.Query()
+	query.Del("async")
+	req.URL.RawQuery = query.Encode()
+	req.Form = nil
+	req.Body = body
+
+	go func() {
+		defer cleanup()
+		defer cancel()
+
+		logrus.Debugf("Job %s: running %s %s", job.ID(), req.Method, req.URL.Path)
+		rw := newJobResponseWriter(job)
+		s.apiWrapper(h, rw, req, false)
+		job.Finish(rw.status())
+		logrus.Debugf("Job %s: %s", job.ID(), job.Report().Status)
+	}()
+
+	utils.WriteResponse(w, http.StatusAccepted, job.Report())
+}
+
+// jobResponseWriter writes the response of a handler to the output of a
+// job.  It cannot be hijacked.
+type jobResponseWriter struct {
+	io.Writer
+	header     http.Header
+	statusCode int
+}
+
+func newJobResponseWriter(w io.Writer) *jobResponseWriter {
+	return &jobResponseWriter{Writer: w, header: make(http.Header)}
+}
+
+func (w *jobResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *jobResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+func (w *jobResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.Writer.Write(p)
+}
+
+func (w *jobResponseWriter) Flush() {}
+
+func (w *jobResponseWriter) status() int {
+	if w.statusCode == 0 {
+		return http.StatusOK
+	}
+	return w.statusCode
+}
@@ -0,0 +1,44 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/tracing"
+	"github.com/gorilla/mux"
+)
+
+// tracingHandler records a span for each request, as child of the span of
+// the client in the traceparent header. The span is named after the method
+// and the route of the request, e.g. "POST /libpod/containers/{name}/start".
+func tracingHandler() mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !tracing.Enabled() {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			path := r.URL.Path
+			if route := mux.CurrentRoute(r); route != nil {
+				if tmpl, err := route.GetPathTemplate(); err == nil {
+					path = tmpl
+				}
+			}
+			// Strip the API version of versioned paths
+			if strings.HasPrefix(path, "/v{version") {
+				if i := strings.Index(path, "}"); i >= 0 {
+					path = path[i+1:]
+				}
+			}
+
+			ctx, span := tracing.StartServer(r.Context(), r.Header, r.Method+" "+path)
+			defer span.End()
+			span.SetAttribute("http.method", r.Method)
+			span.SetAttribute("http.target", r.URL.Path)
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,419 @@
+//go:build !remote
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v5/pkg/api/server/idle"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/storage/pkg/stringid"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNoSuchJob is returned when a job cannot be found.
+var ErrNoSuchJob = errors.New("no such job")
+
+// ErrJobRunning is returned when removing a job which has not finished.
+var ErrJobRunning = errors.New("job is still running")
+
+// DefaultRetention is how long finished jobs are kept by default.
+const DefaultRetention = time.Hour
+
+// MaxLogSize is the size of the log output kept of a job.  Once the log
+// grows to twice that size, its oldest lines are dropped.
+const MaxLogSize = 1024 * 1024
+
+// Output is the kind of output of the operation of a job.
+type Output int
+
+const (
+	// OutputLog is a log of the progress of the operation, e.g. a JSON
+	// stream.  Its last MaxLogSize bytes at least are kept, in memory.
+	OutputLog Output = iota
+	// OutputPayload is the result of the operation, e.g. an archive.  It
+	// is kept whole in a temporary file until the job is removed.
+	OutputPayload
+)
+
+// Manager keeps track of the asynchronous jobs of the API service.
+// Finished jobs are dropped once they are older than the retention.
+//
+// The jobs only live in the memory of the service, so every job counts as
+// an active connection of the idle tracker until it is dropped: the
+// service does not exit on idle while a job runs or its output can still
+// be fetched.
+type Manager struct {
+	retention time.Duration
+	tracker   *idle.Tracker
+	lock      sync.Mutex
+	jobs      map[string]*Job
+}
+
+// NewManager returns a manager which keeps finished jobs for retention.
+// A retention of zero keeps them until they are removed.  tracker may be
+// nil.
+func NewManager(retention time.Duration, tracker *idle.Tracker) *Manager {
+	return &Manager{
+		retention: retention,
+		tracker:   tracker,
+		jobs:      make(map[string]*Job),
+	}
+}
+
+// Create registers a new running job for the operation, whose output is of
+// the given kind.  cancel is called when the job is canceled.
+func (m *Manager) Create(operation string, output Output, cancel context.CancelFunc) (*Job, error) {
+	job := &Job{
+		id:        stringid.GenerateRandomID(),
+		operation: operation,
+		created:   time.Now(),
+		cancel:    cancel,
+		manager:   m,
+		changed:   make(chan struct{}),
+	}
+	if output == OutputPayload {
+		f, err := os.CreateTemp("", "api_job_output")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tempfile: %w", err)
+		}
+		job.payload = f
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked()
+	m.jobs[job.id] = job
+	if m.tracker != nil {
+		// Like a hijacked connection, see removeLocked
+		m.tracker.ConnState(nil, http.StateHijacked)
+	}
+	return job, nil
+}
+
+// Get returns the job with the given ID or a unique prefix of it.
+func (m *Manager) Get(id string) (*Job, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked()
+
+	if job, ok := m.jobs[id]; ok {
+		return job, nil
+	}
+	var found *Job
+	for jobID, job := range m.jobs {
+		if id != "" && strings.HasPrefix(jobID, id) {
+			if found != nil {
+				return nil, fmt.Errorf("more than one job matches %q", id)
+			}
+			found = job
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%s: %w", id, ErrNoSuchJob)
+	}
+	return found, nil
+}
+
+// List returns all jobs, oldest first.
+func (m *Manager) List() []*Job {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked()
+
+	jobs := make([]*Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].created.Before(jobs[j].created)
+	})
+	return jobs
+}
+
+// Remove drops a finished job.
+func (m *Manager) Remove(id string) error {
+	job, err := m.Get(id)
+	if err != nil {
+		return err
+	}
+	if job.Report().Status == entities.JobStatusRunning {
+		return fmt.Errorf("%s: %w", job.id, ErrJobRunning)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.removeLocked(job)
+	return nil
+}
+
+// Close drops all jobs, e.g. when the service shuts down.
+func (m *Manager) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, job := range m.jobs {
+		m.removeLocked(job)
+	}
+}
+
+// removeLocked drops a job and its output.  The caller must hold m.lock.
+func (m *Manager) removeLocked(job *Job) {
+	if _, ok := m.jobs[job.id]; !ok {
+		return
+	}
+	delete(m.jobs, job.id)
+	job.removeOutput()
+	if m.tracker != nil {
+		m.tracker.Close()
+	}
+}
+
+// finished schedules dropping the job once it is older than the retention,
+// so that it does not keep the service from exiting on idle any longer.
+func (m *Manager) finished(job *Job) {
+	if m.retention == 0 {
+		return
+	}
+	time.AfterFunc(m.retention, func() {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.pruneLocked()
+	})
+}
+
+// pruneLocked drops finished jobs older than the retention.  The caller
+// must hold m.lock.
+func (m *Manager) pruneLocked() {
+	if m.retention == 0 {
+		return
+	}
+	for _, job := range m.jobs {
+		report := job.Report()
+		if report.Status != entities.JobStatusRunning && time.Since(report.Finished) >= m.retention {
+			m.removeLocked(job)
+		}
+	}
+}
+
+// Job is a single operation run in the background.  Everything the
+// operation writes is kept as the output of the job: a log in memory, or
+// a payload in a temporary file.
+type Job struct {
+	id        string
+	operation string
+	created   time.Time
+	cancel    context.CancelFunc
+	manager   *Manager
+
+	lock       sync.Mutex
+	log        []byte        // the end of the log output, see MaxLogSize
+	payload    *os.File      // the payload output, nil for log output
+	size       int64         // size of all output written
+	changed    chan struct{} // closed and replaced whenever output or state change
+	finished   time.Time
+	canceled   bool
+	statusCode int
+	err        string
+}
+
+// ID returns the ID of the job.
+func (j *Job) ID() string {
+	return j.id
+}
+
+// Write appends p to the output of the job.  An error reported by the
+// operation in its JSON output is recorded as the error of the job.
+func (j *Job) Write(p []byte) (int, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	// A payload is not JSON, unless the operation failed before writing
+	// it and responded with an error.
+	if j.payload == nil || j.size == 0 {
+		if msg := errorMessage(p); msg != "" {
+			j.err = msg
+		}
+	}
+	if j.payload != nil {
+		n, err := j.payload.WriteAt(p, j.size)
+		j.size += int64(n)
+		j.notifyLocked()
+		return n, err
+	}
+
+	j.log = append(j.log, p...)
+	j.size += int64(len(p))
+	if len(j.log) > 2*MaxLogSize {
+		// Drop whole lines, so that JSON streams stay valid
+		drop := len(j.log) - MaxLogSize
+		if i := bytes.IndexByte(j.log[drop:], '\n'); i >= 0 {
+			drop += i + 1
+		}
+		j.log = append([]byte(nil), j.log[drop:]...)
+	}
+	j.notifyLocked()
+	return len(p), nil
+}
+
+// Finish marks the job as finished with the HTTP status code the operation
+// responded with.
+func (j *Job) Finish(statusCode int) {
+	j.lock.Lock()
+	j.statusCode = statusCode
+	j.finished = time.Now()
+	j.notifyLocked()
+	j.lock.Unlock()
+
+	j.manager.finished(j)
+}
+
+// Cancel cancels a running job.  Canceling a finished job is a no-op.
+func (j *Job) Cancel() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if !j.finished.IsZero() {
+		return
+	}
+	j.canceled = true
+	j.cancel()
+}
+
+// Report returns the current state of the job.
+func (j *Job) Report() *entities.JobReport {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	report := &entities.JobReport{
+		ID:         j.id,
+		Operation:  j.operation,
+		Created:    j.created,
+		Finished:   j.finished,
+		StatusCode: j.statusCode,
+		Error:      j.err,
+		OutputSize: j.size,
+		Truncated:  j.payload == nil && j.size > int64(len(j.log)),
+	}
+	switch {
+	case j.finished.IsZero():
+		report.Status = entities.JobStatusRunning
+	case j.canceled:
+		report.Status = entities.JobStatusCanceled
+	case j.err != "" || j.statusCode >= http.StatusBadRequest:
+		report.Status = entities.JobStatusFailed
+	default:
+		report.Status = entities.JobStatusSucceeded
+	}
+	return report
+}
+
+// CopyOutput writes the output of the job to w.  If follow is set, it
+// keeps writing new output until the job finishes or ctx is done.  Log
+// output which was dropped is skipped.
+func (j *Job) CopyOutput(ctx context.Context, w io.Writer, follow bool) error {
+	var offset int64
+	for {
+		j.lock.Lock()
+		chunk, start, err := j.readLocked(offset)
+		size := j.size
+		done := !j.finished.IsZero()
+		changed := j.changed
+		j.lock.Unlock()
+		if err != nil {
+			return err
+		}
+
+		if len(chunk) > 0 {
+			if _, err := w.Write(chunk); err != nil {
+				return err
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+		offset = start + int64(len(chunk))
+		if len(chunk) > 0 && offset < size {
+			// More payload to read
+			continue
+		}
+		if !follow || done {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// readLocked returns the output from offset on, at most 1MiB of it for a
+// payload, and the offset it starts at, which is later than offset if the
+// log output there was dropped.  The caller must hold j.lock.
+func (j *Job) readLocked(offset int64) ([]byte, int64, error) {
+	if j.payload == nil {
+		if start := j.size - int64(len(j.log)); offset < start {
+			offset = start
+		}
+		return j.log[len(j.log)-int(j.size-offset):], offset, nil
+	}
+	buf := make([]byte, min(j.size-offset, 1024*1024))
+	n, err := j.payload.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, offset, err
+	}
+	return buf[:n], offset, nil
+}
+
+// removeOutput removes the payload file of the job, if any.
+func (j *Job) removeOutput() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.payload == nil {
+		return
+	}
+	j.payload.Close()
+	if err := os.Remove(j.payload.Name()); err != nil {
+		logrus.Errorf("Failed to remove temporary file: %v", err)
+	}
+}
+
+// notifyLocked wakes up everyone following the job.  The caller must hold
+// j.lock.
+func (j *Job) notifyLocked() {
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// errorMessage returns the error reported in a JSON message written by an
+// operation, either in a progress stream or as an error response.
+func errorMessage(p []byte) string {
+	var msg struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+		Cause   string `json:"cause"`
+	}
+	if err := json.Unmarshal(p, &msg); err != nil {
+		return ""
+	}
+	switch {
+	case msg.Error != "":
+		return msg.Error
+	case msg.Message != "" && msg.Cause != "":
+		// The shape of utils.Error()
+		return msg.Message
+	}
+	return ""
+}
@@ -0,0 +1,188 @@
+//go:build !remote
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v5/pkg/api/server/idle"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustCreate(t *testing.T, m *Manager, operation string, output Output) *Job {
+	job, err := m.Create(operation, output, func() {})
+	require.NoError(t, err)
+	return job
+}
+
+func TestJobStatus(t *testing.T) {
+	m := NewManager(0, nil)
+
+	job := mustCreate(t, m, "image pull", OutputLog)
+	assert.Equal(t, entities.JobStatusRunning, job.Report().Status)
+	job.Finish(http.StatusOK)
+	assert.Equal(t, entities.JobStatusSucceeded, job.Report().Status)
+
+	job = mustCreate(t, m, "image pull", OutputLog)
+	_, _ = job.Write([]byte(`{"stream":"Trying to pull image\n"}`))
+	_, _ = job.Write([]byte(`{"error":"manifest unknown"}`))
+	job.Finish(http.StatusOK)
+	report := job.Report()
+	assert.Equal(t, entities.JobStatusFailed, report.Status)
+	assert.Equal(t, "manifest unknown", report.Error)
+
+	job = mustCreate(t, m, "image push", OutputLog)
+	_, _ = job.Write([]byte(`{"cause":"image not known","message":"no such image","response":404}`))
+	job.Finish(http.StatusNotFound)
+	report = job.Report()
+	assert.Equal(t, entities.JobStatusFailed, report.Status)
+	assert.Equal(t, "no such image", report.Error)
+	assert.Equal(t, http.StatusNotFound, report.StatusCode)
+
+	canceled := false
+	job, err := m.Create("build", OutputLog, func() { canceled = true })
+	require.NoError(t, err)
+	job.Cancel()
+	job.Finish(http.StatusOK)
+	assert.True(t, canceled)
+	assert.Equal(t, entities.JobStatusCanceled, job.Report().Status)
+}
+
+func TestManagerLookup(t *testing.T) {
+	m := NewManager(0, nil)
+	job := mustCreate(t, m, "image pull", OutputLog)
+
+	found, err := m.Get(job.ID()[:12])
+	require.NoError(t, err)
+	assert.Equal(t, job, found)
+
+	_, err = m.Get("nonesuch")
+	assert.ErrorIs(t, err, ErrNoSuchJob)
+
+	err = m.Remove(job.ID())
+	assert.ErrorIs(t, err, ErrJobRunning)
+	job.Finish(http.StatusOK)
+	require.NoError(t, m.Remove(job.ID()))
+	assert.Empty(t, m.List())
+}
+
+func TestManagerRetention(t *testing.T) {
+	tracker := idle.NewTracker(time.Hour)
+	m := NewManager(time.Millisecond, tracker)
+	running := mustCreate(t, m, "image pull", OutputLog)
+	finished := mustCreate(t, m, "image push", OutputLog)
+	assert.Equal(t, 2, tracker.ActiveConnections(), "jobs keep the service alive")
+	finished.Finish(http.StatusOK)
+
+	// The finished job is dropped without any further request
+	require.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(m.jobs) == 1
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, 1, tracker.ActiveConnections())
+	jobs := m.List()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, running, jobs[0])
+
+	running.Finish(http.StatusOK)
+	require.Eventually(t, func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return len(m.jobs) == 0
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, 0, tracker.ActiveConnections())
+}
+
+func TestManagerKeepsJobs(t *testing.T) {
+	tracker := idle.NewTracker(time.Hour)
+	m := NewManager(0, tracker)
+	job := mustCreate(t, m, "image save", OutputPayload)
+	job.Finish(http.StatusOK)
+	assert.Equal(t, 1, tracker.ActiveConnections(), "a finished job keeps the service alive until it is removed")
+
+	require.NoError(t, m.Remove(job.ID()))
+	assert.Equal(t, 0, tracker.ActiveConnections())
+}
+
+func TestCopyOutputFollow(t *testing.T) {
+	m := NewManager(0, nil)
+	job := mustCreate(t, m, "image pull", OutputLog)
+	_, _ = job.Write([]byte("one\n"))
+
+	var buf bytes.Buffer
+	done := make(chan error)
+	go func() {
+		done <- job.CopyOutput(context.Background(), &buf, true)
+	}()
+	_, _ = job.Write([]byte("two\n"))
+	job.Finish(http.StatusOK)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal(errors.New("following the output did not stop when the job finished"))
+	}
+	assert.Equal(t, "one\ntwo\n", buf.String())
+}
+
+func TestLogTruncated(t *testing.T) {
+	m := NewManager(0, nil)
+	job := mustCreate(t, m, "image pull", OutputLog)
+	line := []byte(strings.Repeat("x", 1023) + "\n")
+	for i := 0; i < 2*MaxLogSize/len(line); i++ {
+		_, _ = job.Write(line)
+	}
+	_, _ = job.Write([]byte(`{"error":"manifest unknown"}` + "\n"))
+	job.Finish(http.StatusOK)
+
+	report := job.Report()
+	assert.True(t, report.Truncated)
+	assert.Equal(t, int64(2*MaxLogSize+29), report.OutputSize)
+	assert.Equal(t, "manifest unknown", report.Error)
+
+	var buf bytes.Buffer
+	require.NoError(t, job.CopyOutput(context.Background(), &buf, false))
+	assert.LessOrEqual(t, buf.Len(), MaxLogSize)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), line), "whole lines are dropped")
+	assert.True(t, bytes.HasSuffix(buf.Bytes(), []byte(`{"error":"manifest unknown"}`+"\n")))
+}
+
+func TestPayload(t *testing.T) {
+	m := NewManager(0, nil)
+	job := mustCreate(t, m, "image save", OutputPayload)
+	path := job.payload.Name()
+	payload := bytes.Repeat([]byte("{tar}"), 512*1024)
+	_, _ = job.Write(payload[:1000])
+	_, _ = job.Write(payload[1000:])
+	job.Finish(http.StatusOK)
+
+	report := job.Report()
+	assert.Equal(t, entities.JobStatusSucceeded, report.Status, "a payload is not parsed for errors")
+	assert.False(t, report.Truncated)
+	assert.Equal(t, int64(len(payload)), report.OutputSize)
+
+	var buf bytes.Buffer
+	require.NoError(t, job.CopyOutput(context.Background(), &buf, false))
+	assert.Equal(t, payload, buf.Bytes())
+
+	require.NoError(t, m.Remove(job.ID()))
+	assert.NoFileExists(t, path)
+
+	// An error response instead of the payload is recorded
+	job = mustCreate(t, m, "image save", OutputPayload)
+	_, _ = job.Write([]byte(`{"cause":"image not known","message":"no such image","response":404}`))
+	job.Finish(http.StatusNotFound)
+	assert.Equal(t, "no such image", job.Report().Error)
+	m.Close()
+	assert.Empty(t, m.List())
+}
@@ -0,0 +1,53 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerBatchHandlers(r *mux.Router) error {
+	// swagger:operation POST /libpod/batch libpod SystemBatchLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Run a batch of operations
+	// description: |
+	//   Run a list of requests to the libpod API and return the response to each of them.
+	//   Each operation is a request with a method, a path below /libpod including its query, and an optional JSON body.
+	//   Endpoints which stream their response, attach to containers or transfer archives are rejected,
+	//   as are operations with the stream or follow parameter set to true. The stats endpoints must set stream=false.
+	//   The status of this request is 200 even if operations fail, see the statusCode of each result.
+	// parameters:
+	//   - in: query
+	//     name: parallel
+	//     type: integer
+	//     description: Maximum number of operations run at the same time, 0 for the number of CPUs
+	//     default: 0
+	//   - in: query
+	//     name: failFast
+	//     type: boolean
+	//     description: Skip the remaining operations after an operation failed
+	//     default: false
+	//   - in: body
+	//     name: request
+	//     description: List of operations
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: "#/definitions/BatchOperation"
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/batchResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/batch"), s.APIHandler(libpod.Batch(r))).Methods(http.MethodPost)
+	return nil
+}
@@ -1114,9 +1114,20 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: string
 	//    description: "Override the key sequence for detaching a container. Format is a single character [a-Z] or ctrl-<value> where <value> is one of: a-z, @, ^, [, , or _."
 	//    default: ctrl-p,ctrl-q
+	//  - in: query
+	//    name: timing
+	//    type: boolean
+	//    default: false
+	//    description: Return the durations of the steps of starting the container, which are only recorded for containers created with timing enabled.
 	// produces:
 	// - application/json
 	// responses:
+	//   200:
+	//     description: the durations of the steps of starting the container, if timing is set
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: "#/definitions/TimingSpan"
 	//   204:
 	//     description: no error
 	//   304:
@@ -1603,6 +1614,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: printStats
 	//    type: boolean
 	//    description: add checkpoint statistics to the returned CheckpointReport
+	//  - in: query
+	//    name: encryptionSecret
+	//    type: string
+	//    description: encrypt the exported checkpoint or checkpoint image with the passphrase stored in this secret
 	// produces:
 	// - application/json
 	// responses:
@@ -1669,6 +1684,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: pod
 	//    type: string
 	//    description: pod to restore into
+	//  - in: query
+	//    name: decryptionSecret
+	//    type: string
+	//    description: decrypt an encrypted checkpoint with the passphrase stored in this secret
 	// produces:
 	// - application/json
 	// responses:
@@ -31,6 +31,19 @@ func (s *APIServer) registerEventsHandlers(r *mux.Router) error {
 	//   type: string
 	//   in: query
 	//   description: JSON encoded map[string][]string of constraints
+	// - name: afterID
+	//   type: integer
+	//   in: query
+	//   description: |
+	//     only return events with a sequence ID greater than this, e.g. the SequenceID of the last
+	//     event received before a reconnect, to resume the stream without losing or repeating events
+	// - name: heartbeat
+	//   type: integer
+	//   in: query
+	//   default: 0
+	//   description: |
+	//     send a message of type heartbeat when no event was sent for this many seconds, so that
+	//     clients can detect dead connections. 0 disables heartbeats.
 	// responses:
 	//   200:
 	//     description: returns a string of json data describing an event
@@ -60,6 +73,19 @@ func (s *APIServer) registerEventsHandlers(r *mux.Router) error {
 	//   type: string
 	//   in: query
 	//   description: JSON encoded map[string][]string of constraints
+	// - name: afterID
+	//   type: integer
+	//   in: query
+	//   description: |
+	//     only return events with a sequence ID greater than this, e.g. the SequenceID of the last
+	//     event received before a reconnect, to resume the stream without losing or repeating events
+	// - name: heartbeat
+	//   type: integer
+	//   in: query
+	//   default: 0
+	//   description: |
+	//     send a message of type heartbeat when no event was sent for this many seconds, so that
+	//     clients can detect dead connections. 0 disables heartbeats.
 	// - name: stream
 	//   type: boolean
 	//   in: query
@@ -239,11 +239,16 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//        WorkingDir:
 	//          type: string
 	//          description: The working directory for the exec process inside the container.
+	//        RecordFile:
+	//          type: string
+	//          description: Absolute path of a file on the server to record the terminal session to, in asciicast v2 format. Requires Tty.
 	// produces:
 	// - application/json
 	// responses:
 	//   201:
 	//     description: no error
+	//   400:
+	//     $ref: "#/responses/badParamError"
 	//   404:
 	//     $ref: "#/responses/containerNotFound"
 	//   409:
@@ -7,6 +7,7 @@ import (
 
 	"github.com/containers/podman/v5/pkg/api/handlers/compat"
 	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/containers/podman/v5/pkg/api/server/jobs"
 	"github.com/gorilla/mux"
 )
 
@@ -104,6 +105,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     description: Not supported
 	//     type: boolean
 	//     default: false
+	//   - name: manifests
+	//     in: query
+	//     description: Include the manifest of each image in the response. Requires API version 1.47 or later.
+	//     type: boolean
+	//     default: false
 	// produces:
 	// - application/json
 	// responses:
@@ -157,6 +163,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//           (or `0`), all unused images are pruned.
 	//        - `until=<string>` Prune images created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
 	//        - `label` (`label=<key>`, `label=<key>=<value>`, `label!=<key>`, or `label!=<key>=<value>`) Prune images with (or without, in case `label!=...` is used) the specified labels.
+	//        - `unused-for=<duration>` Prune images not used by a container for this Go duration (e.g. `720h`).
 	// produces:
 	// - application/json
 	// responses:
@@ -780,6 +787,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    type: boolean
 	//    default: true
 	//  - in: query
+	//    name: progressFormat
+	//    description: Format of the progress stream data on push, "text" or "json".  With "json", each stream object carries a structured progress event.
+	//    type: string
+	//    default: text
+	//  - in: query
 	//    name: format
 	//    type: string
 	//    description: Manifest type (oci, v2s1, or v2s2) to use when pushing an image. Default is manifest type of source, with fallbacks.
@@ -803,6 +815,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    name: X-Registry-Auth
 	//    type: string
 	//    description: A base64-encoded auth configuration.
+	//  - in: query
+	//    name: async
+	//    type: boolean
+	//    default: false
+	//    description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/json
 	// responses:
@@ -815,7 +832,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: '#/responses/imageNotFound'
 	//   500:
 	//     $ref: '#/responses/internalError'
-	r.Handle(VersionedPath("/libpod/images/{name:.*}/push"), s.APIHandler(libpod.PushImage)).Methods(http.MethodPost)
+	r.Handle(VersionedPath("/libpod/images/{name:.*}/push"), s.JobAPIHandler("image push", jobs.OutputLog, libpod.PushImage)).Methods(http.MethodPost)
 	// swagger:operation GET /libpod/images/{name}/exists libpod ImageExistsLibpod
 	// ---
 	// tags:
@@ -924,6 +941,10 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	// summary: Load image
 	// description: Load an image (oci-archive or docker-archive) stream.
 	// parameters:
+	//   - in: query
+	//     name: progressFormat
+	//     type: string
+	//     description: If "json", stream one progress event per line instead of the report.  The last event is the final "success" or "error" event listing the loaded images.
 	//   - in: body
 	//     name: upload
 	//     required: true
@@ -1078,6 +1099,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     type: boolean
 	//     default: false
 	//   - in: query
+	//     name: progressFormat
+	//     description: "format of the progress stream data on pull, \"text\" or \"json\".  With \"json\", each stream object carries a structured progress event."
+	//     type: string
+	//     default: text
+	//   - in: query
 	//     name: compatMode
 	//     description: "Return the same JSON payload as the Docker-compat endpoint."
 	//     type: boolean
@@ -1111,6 +1137,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     name: X-Registry-Auth
 	//     description: "base-64 encoded auth config. Must include the following four values: username, password, email and server address OR simply just an identity token."
 	//     type: string
+	//   - in: query
+	//     name: async
+	//     type: boolean
+	//     default: false
+	//     description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/json
 	// responses:
@@ -1120,7 +1151,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/badParamError"
 	//   500:
 	//     $ref: '#/responses/internalError'
-	r.Handle(VersionedPath("/libpod/images/pull"), s.APIHandler(libpod.ImagesPull)).Methods(http.MethodPost)
+	r.Handle(VersionedPath("/libpod/images/pull"), s.JobAPIHandler("image pull", jobs.OutputLog, libpod.ImagesPull)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/images/prune libpod ImagePruneLibpod
 	// ---
 	// tags:
@@ -1156,6 +1187,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//           (or `0`), all unused images are pruned.
 	//        - `until=<string>` Prune images created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
 	//        - `label` (`label=<key>`, `label=<key>=<value>`, `label!=<key>`, or `label!=<key>=<value>`) Prune images with (or without, in case `label!=...` is used) the specified labels.
+	//        - `unused-for=<duration>` Prune images not used by a container for this Go duration (e.g. `720h`).
 	// produces:
 	// - application/json
 	// responses:
@@ -1226,6 +1258,15 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    name: compress
 	//    type: boolean
 	//    description: use compression on image
+	//  - in: query
+	//    name: allPlatforms
+	//    type: boolean
+	//    description: export all instances of a manifest list as an OCI image index (only for oci-archive and oci-dir)
+	//  - in: query
+	//    name: async
+	//    type: boolean
+	//    default: false
+	//    description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/x-tar
 	// responses:
@@ -1238,7 +1279,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: '#/responses/imageNotFound'
 	//   500:
 	//     $ref: '#/responses/internalError'
-	r.Handle(VersionedPath("/libpod/images/{name:.*}/get"), s.APIHandler(libpod.ExportImage)).Methods(http.MethodGet)
+	r.Handle(VersionedPath("/libpod/images/{name:.*}/get"), s.JobAPIHandler("image save", jobs.OutputPayload, libpod.ExportImage)).Methods(http.MethodGet)
 	// swagger:operation GET /libpod/images/export libpod ImageExportLibpod
 	// ---
 	// tags:
@@ -1264,6 +1305,15 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    name: ociAcceptUncompressedLayers
 	//    type: boolean
 	//    description: accept uncompressed layers when copying OCI images
+	//  - in: query
+	//    name: allPlatforms
+	//    type: boolean
+	//    description: export all instances of a manifest list as an OCI image index (only for oci-archive and oci-dir)
+	//  - in: query
+	//    name: async
+	//    type: boolean
+	//    default: false
+	//    description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/json
 	// responses:
@@ -1276,7 +1326,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: '#/responses/imageNotFound'
 	//   500:
 	//     $ref: '#/responses/internalError'
-	r.Handle(VersionedPath("/libpod/images/export"), s.APIHandler(libpod.ExportImages)).Methods(http.MethodGet)
+	r.Handle(VersionedPath("/libpod/images/export"), s.JobAPIHandler("image save", jobs.OutputPayload, libpod.ExportImages)).Methods(http.MethodGet)
 	// swagger:operation GET /libpod/images/{name}/json libpod ImageInspectLibpod
 	// ---
 	// tags:
@@ -1708,6 +1758,11 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    type: array
 	//    items:
 	//      type: string
+	//  - in: query
+	//    name: async
+	//    type: boolean
+	//    default: false
+	//    description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/json
 	// responses:
@@ -1727,7 +1782,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/badParamError"
 	//   500:
 	//     $ref: "#/responses/internalError"
-	r.Handle(VersionedPath("/libpod/build"), s.APIHandler(compat.BuildImage)).Methods(http.MethodPost)
+	r.Handle(VersionedPath("/libpod/build"), s.JobAPIHandler("build", jobs.OutputLog, compat.BuildImage)).Methods(http.MethodPost)
 
 	// swagger:operation POST /libpod/images/scp/{name} libpod ImageScpLibpod
 	// ---
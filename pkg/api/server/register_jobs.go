@@ -0,0 +1,127 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerJobsHandlers(r *mux.Router) error {
+	// swagger:operation GET /libpod/jobs/json libpod JobListLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: List jobs
+	// description: |
+	//   List the asynchronous jobs started with the async parameter of pull, push, save, build and kube play.
+	//   Finished jobs are removed once they are older than the job retention of the service.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/jobList"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/jobs/json"), s.APIHandler(libpod.ListJobs)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/jobs/{name}/json libpod JobInspectLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Inspect a job
+	// description: Return the status of an asynchronous job
+	// parameters:
+	//   - in: path
+	//     name: name
+	//     type: string
+	//     required: true
+	//     description: the ID or a unique prefix of the ID of the job
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/jobReport"
+	//   404:
+	//     $ref: "#/responses/jobNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/jobs/{name}/json"), s.APIHandler(libpod.InspectJob)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/jobs/{name}/logs libpod JobLogsLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Get the output of a job
+	// description: |
+	//   Return the output of an asynchronous job, which is what the operation would have responded with if it was not run asynchronously.
+	//   The payload of an image save is kept whole, in a temporary file of the service. Of the progress output of the other operations, only the last MiB is kept; the job is marked as truncated when older output was dropped.
+	// parameters:
+	//   - in: path
+	//     name: name
+	//     type: string
+	//     required: true
+	//     description: the ID or a unique prefix of the ID of the job
+	//   - in: query
+	//     name: follow
+	//     type: boolean
+	//     description: Keep streaming the output until the job finishes
+	//     default: false
+	// produces:
+	// - application/octet-stream
+	// responses:
+	//   200:
+	//     description: the output of the job
+	//   404:
+	//     $ref: "#/responses/jobNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/jobs/{name}/logs"), s.APIHandler(libpod.JobLogs)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/jobs/{name}/cancel libpod JobCancelLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Cancel a job
+	// description: Cancel a running asynchronous job.  Canceling a finished job has no effect.
+	// parameters:
+	//   - in: path
+	//     name: name
+	//     type: string
+	//     required: true
+	//     description: the ID or a unique prefix of the ID of the job
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/jobNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/jobs/{name}/cancel"), s.APIHandler(libpod.CancelJob)).Methods(http.MethodPost)
+	// swagger:operation DELETE /libpod/jobs/{name} libpod JobDeleteLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Remove a job
+	// description: Remove a finished asynchronous job and its output
+	// parameters:
+	//   - in: path
+	//     name: name
+	//     type: string
+	//     required: true
+	//     description: the ID or a unique prefix of the ID of the job
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/jobNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/jobs/{name}"), s.APIHandler(libpod.RemoveJob)).Methods(http.MethodDelete)
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v5/pkg/api/handlers/libpod"
+	"github.com/containers/podman/v5/pkg/api/server/jobs"
 	"github.com/gorilla/mux"
 )
 
@@ -148,6 +149,11 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//    description: Kubernetes YAML file.
 	//    schema:
 	//      type: string
+	//  - in: query
+	//    name: async
+	//    type: boolean
+	//    default: false
+	//    description: Run the operation as an asynchronous job and respond with its report right away. Its output is available from /libpod/jobs/{id}/logs.
 	// produces:
 	// - application/json
 	// responses:
@@ -155,8 +161,8 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/playKubeResponseLibpod"
 	//   500:
 	//     $ref: "#/responses/internalError"
-	r.HandleFunc(VersionedPath("/libpod/play/kube"), s.APIHandler(libpod.PlayKube)).Methods(http.MethodPost)
-	r.HandleFunc(VersionedPath("/libpod/kube/play"), s.APIHandler(libpod.KubePlay)).Methods(http.MethodPost)
+	r.HandleFunc(VersionedPath("/libpod/play/kube"), s.JobAPIHandler("kube play", jobs.OutputLog, libpod.PlayKube)).Methods(http.MethodPost)
+	r.HandleFunc(VersionedPath("/libpod/kube/play"), s.JobAPIHandler("kube play", jobs.OutputLog, libpod.KubePlay)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/play/kube libpod PlayKubeDownLibpod
 	// ---
 	// tags:
@@ -94,6 +94,11 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//    description: "silences extra stream data on push"
 	//    type: boolean
 	//    default: true
+	//  - in: query
+	//    name: progressFormat
+	//    description: "format of the progress stream data on push, \"text\" or \"json\".  With \"json\", each stream object carries a structured progress event."
+	//    type: string
+	//    default: text
 	// responses:
 	//   200:
 	//     schema:
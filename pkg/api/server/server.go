@@ -20,6 +20,7 @@ import (
 	"github.com/containers/podman/v5/libpod/shutdown"
 	"github.com/containers/podman/v5/pkg/api/handlers"
 	"github.com/containers/podman/v5/pkg/api/server/idle"
+	"github.com/containers/podman/v5/pkg/api/server/jobs"
 	"github.com/containers/podman/v5/pkg/api/types"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/coreos/go-systemd/v22/daemon"
@@ -38,6 +39,7 @@ type APIServer struct {
 	CorsHeaders        string        // Inject Cross-Origin Resource Sharing (CORS) headers
 	PProfAddr          string        // Binding network address for pprof profiles
 	idleTracker        *idle.Tracker // Track connections to support idle shutdown
+	jobs               *jobs.Manager // Asynchronous jobs started by clients
 }
 
 // Number of seconds to wait for next request, if exceeded shutdown server
@@ -53,8 +55,9 @@ var shutdownOnce sync.Once
 // NewServer will create and configure a new API server with all defaults
 func NewServer(runtime *libpod.Runtime) (*APIServer, error) {
 	return newServer(runtime, nil, entities.ServiceOptions{
-		CorsHeaders: DefaultCorsHeaders,
-		Timeout:     DefaultServiceDuration,
+		CorsHeaders:  DefaultCorsHeaders,
+		Timeout:      DefaultServiceDuration,
+		JobRetention: jobs.DefaultRetention,
 	})
 }
 
@@ -73,6 +76,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 
 	router := mux.NewRouter().UseEncodedPath()
 	tracker := idle.NewTracker(opts.Timeout)
+	jobManager := jobs.NewManager(opts.JobRetention, tracker)
 
 	server := APIServer{
 		Server: http.Server{
@@ -88,6 +92,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		Listener:    listener,
 		PProfAddr:   opts.PProfAddr,
 		idleTracker: tracker,
+		jobs:        jobManager,
 	}
 
 	server.BaseContext = func(l net.Listener) context.Context {
@@ -95,12 +100,14 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		ctx = context.WithValue(ctx, types.CompatDecoderKey, handlers.NewCompatAPIDecoder())
 		ctx = context.WithValue(ctx, types.RuntimeKey, runtime)
 		ctx = context.WithValue(ctx, types.IdleTrackerKey, tracker)
+		ctx = context.WithValue(ctx, types.JobManagerKey, jobManager)
 		return ctx
 	}
 
 	// Capture panics and print stack traces for diagnostics,
 	// additionally process X-Reference-Id Header to support event correlation
-	router.Use(panicHandler(), referenceIDHandler())
+	// and record a span of each request if tracing is enabled
+	router.Use(panicHandler(), referenceIDHandler(), tracingHandler())
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -120,6 +127,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	for _, fn := range []func(*mux.Router) error{
 		server.registerAuthHandlers,
 		server.registerArchiveHandlers,
+		server.registerBatchHandlers,
 		server.registerContainersHandlers,
 		server.registerDistributionHandlers,
 		server.registerEventsHandlers,
@@ -128,6 +136,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		server.registerHealthCheckHandlers,
 		server.registerImagesHandlers,
 		server.registerInfoHandlers,
+		server.registerJobsHandlers,
 		server.registerManifestHandlers,
 		server.registerMonitorHandlers,
 		server.registerNetworkHandlers,
@@ -294,6 +303,8 @@ func (s *APIServer) Shutdown(halt bool) error {
 			}
 		}()
 		<-ctx.Done()
+		// Remove the output of the jobs, which cannot be fetched anymore
+		s.jobs.Close()
 	})
 	return nil
 }
@@ -10,4 +10,5 @@ const (
 	IdleTrackerKey
 	ConnKey
 	CompatDecoderKey
+	JobManagerKey
 )
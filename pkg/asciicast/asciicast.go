@@ -0,0 +1,216 @@
+// Package asciicast reads and writes terminal session recordings in the
+// asciicast v2 format of asciinema.
+//
+// A recording is a header line followed by one event per line, all encoded
+// as JSON. See https://docs.asciinema.org/manual/asciicast/v2/.
+package asciicast
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Version is the version of the asciicast format.
+const Version = 2
+
+// Event types.
+const (
+	// EventOutput is data written to the terminal.
+	EventOutput = "o"
+	// EventInput is data read from the terminal.
+	EventInput = "i"
+	// EventResize is a change of the terminal size to "COLUMNSxROWS".
+	EventResize = "r"
+	// EventMarker is a marker with a label.
+	EventMarker = "m"
+)
+
+// Header is the first line of a recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	// Podman is the metadata of a session recorded by Podman.
+	Podman *Metadata `json:"podman,omitempty"`
+}
+
+// Metadata describes a container session recorded by Podman.
+type Metadata struct {
+	// Type of the session, "exec" or "attach".
+	Type string `json:"type"`
+	// ContainerID and ContainerName identify the container.
+	ContainerID   string `json:"containerId"`
+	ContainerName string `json:"containerName"`
+	// SessionID is the ID of the exec session.
+	SessionID string `json:"sessionId,omitempty"`
+	// User is the user who started the session.
+	User string `json:"user"`
+	// ContainerUser is the user the command runs as in the container.
+	ContainerUser string `json:"containerUser,omitempty"`
+}
+
+// Event is a line of a recording after the header.
+type Event struct {
+	// Time since the start of the recording.
+	Time time.Duration
+	// Type is one of the Event* constants.
+	Type string
+	// Data of the event.
+	Data string
+}
+
+// MarshalJSON encodes the event as [time, type, data].
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{json.Number(strconv.FormatFloat(e.Time.Seconds(), 'f', 6, 64)), e.Type, e.Data})
+}
+
+// UnmarshalJSON decodes an event encoded as [time, type, data].
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("invalid event %s: expected 3 elements, got %d", data, len(raw))
+	}
+	var seconds float64
+	if err := json.Unmarshal(raw[0], &seconds); err != nil {
+		return fmt.Errorf("invalid event time: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &e.Type); err != nil {
+		return fmt.Errorf("invalid event type: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &e.Data); err != nil {
+		return fmt.Errorf("invalid event data: %w", err)
+	}
+	e.Time = time.Duration(seconds * float64(time.Second))
+	return nil
+}
+
+// ResizeData returns the data of a resize event.
+func ResizeData(width, height uint16) string {
+	return fmt.Sprintf("%dx%d", width, height)
+}
+
+// Writer writes a recording. It is safe for concurrent use.
+type Writer struct {
+	lock    sync.Mutex
+	w       io.Writer
+	enc     *json.Encoder
+	start   time.Time
+	pending []byte
+}
+
+// NewWriter writes the header of a recording starting at start to w.
+func NewWriter(w io.Writer, header Header, start time.Time) (*Writer, error) {
+	header.Version = Version
+	header.Timestamp = start.Unix()
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(header); err != nil {
+		return nil, fmt.Errorf("writing asciicast header: %w", err)
+	}
+	return &Writer{w: w, enc: enc, start: start}, nil
+}
+
+// WriteEvent writes an event which happened at t.
+func (w *Writer) WriteEvent(t time.Time, eventType, data string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.writeEvent(t, eventType, data)
+}
+
+func (w *Writer) writeEvent(t time.Time, eventType, data string) error {
+	return w.enc.Encode(Event{Time: t.Sub(w.start), Type: eventType, Data: data})
+}
+
+// WriteOutput writes an output event for p, which was written to the
+// terminal at t. A UTF-8 sequence incomplete at the end of p is held back
+// until the next call, so that it is not replaced by the JSON encoder.
+func (w *Writer) WriteOutput(t time.Time, p []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	data := append(w.pending, p...)
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	w.pending = append([]byte(nil), data[cut:]...)
+	if cut == 0 {
+		return nil
+	}
+	return w.writeEvent(t, EventOutput, string(data[:cut]))
+}
+
+// Reader reads a recording.
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewReader reads the header of the recording in r.
+func NewReader(r io.Reader) (*Reader, *Header, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	reader := &Reader{scanner: scanner}
+	line, err := reader.nextLine()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("empty recording")
+		}
+		return nil, nil, err
+	}
+	header := new(Header)
+	if err := json.Unmarshal(line, header); err != nil {
+		return nil, nil, fmt.Errorf("invalid asciicast header: %w", err)
+	}
+	if header.Version != Version {
+		return nil, nil, fmt.Errorf("unsupported asciicast version %d", header.Version)
+	}
+	return reader, header, nil
+}
+
+func (r *Reader) nextLine() ([]byte, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := r.scanner.Bytes()
+		if strings.TrimSpace(string(line)) != "" {
+			return line, nil
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Next returns the next event of the recording, or io.EOF after the last
+// event.
+func (r *Reader) Next() (*Event, error) {
+	line, err := r.nextLine()
+	if err != nil {
+		return nil, err
+	}
+	event := new(Event)
+	if err := json.Unmarshal(line, event); err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.line, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,74 @@
+package asciicast
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{
+		Width:  80,
+		Height: 24,
+		Podman: &Metadata{Type: "exec", ContainerID: "abc", User: "root"},
+	}, start)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteOutput(start.Add(time.Second), []byte("hello\r\n")))
+	require.NoError(t, w.WriteEvent(start.Add(1500*time.Millisecond), EventResize, ResizeData(100, 30)))
+	// "é" split across two writes
+	require.NoError(t, w.WriteOutput(start.Add(2*time.Second), []byte{'a', 0xc3}))
+	require.NoError(t, w.WriteOutput(start.Add(3*time.Second), []byte{0xa9, 'b'}))
+	require.NoError(t, w.WriteEvent(start.Add(4*time.Second), EventMarker, "end"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, `[1.000000,"o","hello\r\n"]`, lines[1])
+	assert.Equal(t, `[1.500000,"r","100x30"]`, lines[2])
+
+	r, header, err := NewReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, Version, header.Version)
+	assert.Equal(t, uint16(80), header.Width)
+	assert.Equal(t, start.Unix(), header.Timestamp)
+	require.NotNil(t, header.Podman)
+	assert.Equal(t, "abc", header.Podman.ContainerID)
+
+	var events []Event
+	for {
+		event, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, *event)
+	}
+	assert.Equal(t, []Event{
+		{Time: time.Second, Type: EventOutput, Data: "hello\r\n"},
+		{Time: 1500 * time.Millisecond, Type: EventResize, Data: "100x30"},
+		{Time: 2 * time.Second, Type: EventOutput, Data: "a"},
+		{Time: 3 * time.Second, Type: EventOutput, Data: "éb"},
+		{Time: 4 * time.Second, Type: EventMarker, Data: "end"},
+	}, events)
+}
+
+func TestReadInvalid(t *testing.T) {
+	_, _, err := NewReader(strings.NewReader(""))
+	assert.ErrorContains(t, err, "empty recording")
+
+	_, _, err = NewReader(strings.NewReader(`{"version": 1, "width": 80, "height": 24}`))
+	assert.ErrorContains(t, err, "unsupported asciicast version 1")
+
+	r, _, err := NewReader(strings.NewReader("{\"version\": 2, \"width\": 80, \"height\": 24}\n[1.0, \"o\"]\n"))
+	require.NoError(t, err)
+	_, err = r.Next()
+	assert.ErrorContains(t, err, "line 2: invalid event")
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/blang/semver/v4"
 	"github.com/containers/common/pkg/ssh"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/version"
 	"github.com/kevinburke/ssh_config"
 	"github.com/sirupsen/logrus"
@@ -389,6 +390,9 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 	uri := fmt.Sprintf(baseURL+"/v%s/libpod"+endpoint, params...)
 	logrus.Debugf("DoRequest Method: %s URI: %v", httpMethod, uri)
 
+	ctx, span := tracing.StartClient(ctx, httpMethod+" /libpod"+strings.ReplaceAll(endpoint, "%s", "{name}"))
+	defer span.End()
+
 	req, err := http.NewRequestWithContext(ctx, httpMethod, uri, httpBody)
 	if err != nil {
 		return nil, err
@@ -406,6 +410,7 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 			req.Header.Add(key, v)
 		}
 	}
+	tracing.Inject(ctx, req.Header)
 
 	// Give the Do three chances in the case of a comm/service hiccup
 	for i := 1; i <= 3; i++ {
@@ -415,6 +420,11 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 		}
 		time.Sleep(time.Duration(i*100) * time.Millisecond)
 	}
+	if err != nil {
+		span.SetError(err)
+	} else {
+		span.SetAttribute("http.status_code", strconv.Itoa(response.StatusCode))
+	}
 	return &APIResponse{response, req}, err
 }
 
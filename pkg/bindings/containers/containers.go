@@ -218,6 +218,35 @@ func Start(ctx context.Context, nameOrID string, options *StartOptions) error {
 	return response.Process(nil)
 }
 
+// StartWithTiming starts a container like Start and returns the durations of
+// the steps of starting it.  They are only recorded for containers created
+// with timing enabled.
+func StartWithTiming(ctx context.Context, nameOrID string, options *StartOptions) ([]define.TimingSpan, error) {
+	if options == nil {
+		options = new(StartOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.WithTiming(true).ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/start", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	// Only 200 has a body, the container may have been running already.
+	if response.StatusCode != http.StatusOK {
+		return nil, response.Process(nil)
+	}
+	var timing []define.TimingSpan
+	return timing, response.Process(&timing)
+}
+
 func Stats(ctx context.Context, containers []string, options *StatsOptions) (chan types.ContainerStatsReport, error) {
 	if options == nil {
 		options = new(StatsOptions)
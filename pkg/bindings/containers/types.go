@@ -62,6 +62,9 @@ type CheckpointOptions struct {
 	PreCheckpoint  *bool
 	WithPrevious   *bool
 	FileLocks      *bool
+	// EncryptionSecret is the name of a secret holding the passphrase
+	// used to encrypt the exported checkpoint or checkpoint image.
+	EncryptionSecret *string
 }
 
 // RestoreOptions are optional options for restoring containers
@@ -87,6 +90,9 @@ type RestoreOptions struct {
 	PrintStats     *bool
 	PublishPorts   []string
 	FileLocks      *bool
+	// DecryptionSecret is the name of a secret holding the passphrase
+	// used to decrypt an encrypted checkpoint.
+	DecryptionSecret *string
 }
 
 // CreateOptions are optional options for creating containers
@@ -204,6 +210,7 @@ type RestartOptions struct {
 type StartOptions struct {
 	DetachKeys *string
 	Recursive  *bool
+	Timing     *bool
 }
 
 // StatsOptions are optional options for getting stats on containers
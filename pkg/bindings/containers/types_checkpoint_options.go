@@ -166,3 +166,18 @@ func (o *CheckpointOptions) GetFileLocks() bool {
 	}
 	return *o.FileLocks
 }
+
+// WithEncryptionSecret set field EncryptionSecret to given value
+func (o *CheckpointOptions) WithEncryptionSecret(value string) *CheckpointOptions {
+	o.EncryptionSecret = &value
+	return o
+}
+
+// GetEncryptionSecret returns value of field EncryptionSecret
+func (o *CheckpointOptions) GetEncryptionSecret() string {
+	if o.EncryptionSecret == nil {
+		var z string
+		return z
+	}
+	return *o.EncryptionSecret
+}
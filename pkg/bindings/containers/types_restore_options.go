@@ -211,3 +211,18 @@ func (o *RestoreOptions) GetFileLocks() bool {
 	}
 	return *o.FileLocks
 }
+
+// WithDecryptionSecret set field DecryptionSecret to given value
+func (o *RestoreOptions) WithDecryptionSecret(value string) *RestoreOptions {
+	o.DecryptionSecret = &value
+	return o
+}
+
+// GetDecryptionSecret returns value of field DecryptionSecret
+func (o *RestoreOptions) GetDecryptionSecret() string {
+	if o.DecryptionSecret == nil {
+		var z string
+		return z
+	}
+	return *o.DecryptionSecret
+}
@@ -46,3 +46,18 @@ func (o *StartOptions) GetRecursive() bool {
 	}
 	return *o.Recursive
 }
+
+// WithTiming set field Timing to given value
+func (o *StartOptions) WithTiming(value bool) *StartOptions {
+	o.Timing = &value
+	return o
+}
+
+// GetTiming returns value of field Timing
+func (o *StartOptions) GetTiming() bool {
+	if o.Timing == nil {
+		var z bool
+		return z
+	}
+	return *o.Timing
+}
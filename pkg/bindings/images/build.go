@@ -623,6 +623,10 @@ func Build(ctx context.Context, containerFiles []string, options types.BuildOpti
 		params.Add("secrets", c)
 	}
 
+	if options.Async {
+		params.Set("async", "true")
+	}
+
 	tarfile, err := nTar(append(excludes, dontexcludes...), tarContent...)
 	if err != nil {
 		logrus.Errorf("Cannot tar container entries %v error: %v", tarContent, err)
@@ -648,6 +652,16 @@ func Build(ctx context.Context, containerFiles []string, options types.BuildOpti
 		return nil, response.Process(err)
 	}
 
+	if options.Async {
+		// The build runs as a job of the service, the response is
+		// the report of the job.
+		var job types.JobReport
+		if err := response.Process(&job); err != nil {
+			return nil, err
+		}
+		return &types.BuildReport{JobID: job.ID, SaveFormat: saveFormat}, nil
+	}
+
 	body := response.Body.(io.Reader)
 	if logrus.IsLevelEnabled(logrus.DebugLevel) {
 		if v, found := os.LookupEnv("PODMAN_RETAIN_BUILD_ARTIFACT"); found {
@@ -2,6 +2,7 @@ package images
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -125,18 +126,62 @@ func History(ctx context.Context, nameOrID string, options *HistoryOptions) ([]*
 }
 
 func Load(ctx context.Context, r io.Reader) (*types.ImageLoadReport, error) {
+	return LoadWithOptions(ctx, r, nil)
+}
+
+// LoadWithOptions loads an image from the reader like Load.  If a progress
+// format is set in the options, the progress of the load is written to the
+// progress writer.
+func LoadWithOptions(ctx context.Context, r io.Reader, options *LoadOptions) (*types.ImageLoadReport, error) {
+	if options == nil {
+		options = new(LoadOptions)
+	}
 	var report types.ImageLoadReport
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	response, err := conn.DoRequest(ctx, r, http.MethodPost, "/images/load", nil, nil)
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, r, http.MethodPost, "/images/load", params, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
 
-	return &report, response.Process(&report)
+	if options.GetProgressFormat() == "" || !response.IsSuccess() {
+		return &report, response.Process(&report)
+	}
+
+	// The progress is streamed as one event per line, the last one
+	// being the result of the load.
+	writer := options.GetProgressWriter()
+	if writer == nil {
+		writer = io.Discard
+	}
+	dec := json.NewDecoder(response.Body)
+	enc := json.NewEncoder(writer)
+	for {
+		var event types.ProgressEvent
+		if err := dec.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("image load ended without a result")
+			}
+			return nil, err
+		}
+		if err := enc.Encode(&event); err != nil {
+			return nil, err
+		}
+		switch event.Event {
+		case types.ProgressEventSuccess:
+			report.Names = event.Images
+			return &report, nil
+		case types.ProgressEventError:
+			return nil, errors.New(event.Error)
+		}
+	}
 }
 
 // Export saves images from local storage as a tarball or image archive.  The optional format
@@ -145,28 +190,52 @@ func Export(ctx context.Context, nameOrIDs []string, w io.Writer, options *Expor
 	if options == nil {
 		options = new(ExportOptions)
 	}
-	conn, err := bindings.GetClient(ctx)
+	response, err := export(ctx, nameOrIDs, options, false)
 	if err != nil {
 		return err
 	}
-	params, err := options.ToParams()
-	if err != nil {
+	defer response.Body.Close()
+
+	if response.IsSuccess() || response.IsRedirection() {
+		_, err = io.Copy(w, response.Body)
 		return err
 	}
-	for _, ref := range nameOrIDs {
-		params.Add("references", ref)
+	return response.Process(nil)
+}
+
+// ExportAsync starts exporting the images as an asynchronous job of the
+// service and returns the report of the job.  The archive is the output of
+// the job, see jobs.Logs().
+func ExportAsync(ctx context.Context, nameOrIDs []string, options *ExportOptions) (*types.JobReport, error) {
+	if options == nil {
+		options = new(ExportOptions)
 	}
-	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/images/export", params, nil)
+	response, err := export(ctx, nameOrIDs, options, true)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer response.Body.Close()
 
-	if response.IsSuccess() || response.IsRedirection() {
-		_, err = io.Copy(w, response.Body)
-		return err
+	var report types.JobReport
+	return &report, response.Process(&report)
+}
+
+func export(ctx context.Context, nameOrIDs []string, options *ExportOptions, async bool) (*bindings.APIResponse, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return response.Process(nil)
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range nameOrIDs {
+		params.Add("references", ref)
+	}
+	if async {
+		params.Set("async", "true")
+	}
+	return conn.DoRequest(ctx, nil, http.MethodGet, "/images/export", params, nil)
 }
 
 // Prune removes unused images from local storage.  The optional filters can be used to further
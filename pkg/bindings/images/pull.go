@@ -25,28 +25,7 @@ func Pull(ctx context.Context, rawImage string, options *PullOptions) ([]string,
 	if options == nil {
 		options = new(PullOptions)
 	}
-	conn, err := bindings.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	params, err := options.ToParams()
-	if err != nil {
-		return nil, err
-	}
-	params.Set("reference", rawImage)
-
-	// SkipTLSVerify is special.  It's not being serialized by ToParams()
-	// because we need to flip the boolean.
-	if options.SkipTLSVerify != nil {
-		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
-	}
-
-	header, err := auth.MakeXRegistryAuthHeader(&imgTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/images/pull", params, header)
+	response, err := pull(ctx, rawImage, options, false)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +46,7 @@ func Pull(ctx context.Context, rawImage string, options *PullOptions) ([]string,
 	}
 
 	dec := json.NewDecoder(response.Body)
+	progressEnc := json.NewEncoder(writer)
 	var images []string
 	var pullErrors []error
 LOOP:
@@ -86,6 +66,12 @@ LOOP:
 			// non-blocking select
 		}
 
+		if report.Progress != nil {
+			if err := progressEnc.Encode(report.Progress); err != nil {
+				return images, err
+			}
+		}
+
 		switch {
 		case report.Stream != "":
 			fmt.Fprint(writer, report.Stream)
@@ -94,9 +80,56 @@ LOOP:
 		case len(report.Images) > 0:
 			images = report.Images
 		case report.ID != "":
+		case report.Progress != nil:
+			// Already written above.
 		default:
 			return images, fmt.Errorf("failed to parse pull results stream, unexpected input: %v", report)
 		}
 	}
 	return images, errorhandling.JoinErrors(pullErrors)
 }
+
+// PullAsync starts pulling rawImage as an asynchronous job of the service and
+// returns the report of the job.  The progress of the pull is the output of
+// the job, see jobs.Logs().
+func PullAsync(ctx context.Context, rawImage string, options *PullOptions) (*types.JobReport, error) {
+	if options == nil {
+		options = new(PullOptions)
+	}
+	response, err := pull(ctx, rawImage, options, true)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var report types.JobReport
+	return &report, response.Process(&report)
+}
+
+func pull(ctx context.Context, rawImage string, options *PullOptions, async bool) (*bindings.APIResponse, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("reference", rawImage)
+	if async {
+		params.Set("async", "true")
+	}
+
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&imgTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.DoRequest(ctx, nil, http.MethodPost, "/images/pull", params, header)
+}
@@ -25,28 +25,7 @@ func Push(ctx context.Context, source string, destination string, options *PushO
 	if options == nil {
 		options = new(PushOptions)
 	}
-	conn, err := bindings.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	header, err := auth.MakeXRegistryAuthHeader(&imageTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
-	if err != nil {
-		return err
-	}
-
-	params, err := options.ToParams()
-	if err != nil {
-		return err
-	}
-	// SkipTLSVerify is special.  It's not being serialized by ToParams()
-	// because we need to flip the boolean.
-	if options.SkipTLSVerify != nil {
-		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
-	}
-	params.Set("destination", destination)
-
-	path := fmt.Sprintf("/images/%s/push", source)
-	response, err := conn.DoRequest(ctx, nil, http.MethodPost, path, params, header)
+	response, err := push(ctx, source, destination, options, false)
 	if err != nil {
 		return err
 	}
@@ -67,6 +46,7 @@ func Push(ctx context.Context, source string, destination string, options *PushO
 	}
 
 	dec := json.NewDecoder(response.Body)
+	progressEnc := json.NewEncoder(writer)
 LOOP:
 	for {
 		var report types.ImagePushStream
@@ -84,6 +64,12 @@ LOOP:
 			// non-blocking select
 		}
 
+		if report.Progress != nil {
+			if err := progressEnc.Encode(report.Progress); err != nil {
+				return err
+			}
+		}
+
 		switch {
 		case report.Stream != "":
 			fmt.Fprint(writer, report.Stream)
@@ -92,6 +78,8 @@ LOOP:
 		case report.Error != "":
 			// There can only be one error.
 			return errors.New(report.Error)
+		case report.Progress != nil:
+			// Already written above.
 		default:
 			return fmt.Errorf("failed to parse push results stream, unexpected input: %v", report)
 		}
@@ -99,3 +87,48 @@ LOOP:
 
 	return nil
 }
+
+// PushAsync starts pushing source to destination as an asynchronous job of
+// the service and returns the report of the job.  The progress of the push
+// is the output of the job, see jobs.Logs().
+func PushAsync(ctx context.Context, source string, destination string, options *PushOptions) (*types.JobReport, error) {
+	if options == nil {
+		options = new(PushOptions)
+	}
+	response, err := push(ctx, source, destination, options, true)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var report types.JobReport
+	return &report, response.Process(&report)
+}
+
+func push(ctx context.Context, source string, destination string, options *PushOptions, async bool) (*bindings.APIResponse, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	header, err := auth.MakeXRegistryAuthHeader(&imageTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, options.GetUsername(), options.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+	params.Set("destination", destination)
+	if async {
+		params.Set("async", "true")
+	}
+
+	path := fmt.Sprintf("/images/%s/push", source)
+	return conn.DoRequest(ctx, nil, http.MethodPost, path, params, header)
+}
@@ -69,6 +69,11 @@ type HistoryOptions struct {
 //
 //go:generate go run ../generator/generator.go LoadOptions
 type LoadOptions struct {
+	// ProgressFormat is the format of the progress sent to ProgressWriter,
+	// only "json" is supported.  If unset, no progress is reported.
+	ProgressFormat *string
+	// ProgressWriter is a writer where load progress are sent.
+	ProgressWriter *io.Writer `schema:"-"`
 	// Reference is the name of the loaded image
 	Reference *string
 }
@@ -77,6 +82,8 @@ type LoadOptions struct {
 //
 //go:generate go run ../generator/generator.go ExportOptions
 type ExportOptions struct {
+	// AllPlatforms exports all instances of a manifest list
+	AllPlatforms *bool
 	// Compress the image
 	Compress *bool
 	// Format of the output
@@ -156,6 +163,9 @@ type PushOptions struct {
 	Format *string
 	// Password for authenticating against the registry.
 	Password *string `schema:"-"`
+	// ProgressFormat is the format of the progress sent to ProgressWriter,
+	// "text" (default) or "json".
+	ProgressFormat *string
 	// ProgressWriter is a writer where push progress are sent.
 	// Since API handler for image push is quiet by default, WithQuiet(false) is necessary for
 	// the writer to receive progress messages.
@@ -218,6 +228,9 @@ type PullOptions struct {
 	Policy *string
 	// Password for authenticating against the registry.
 	Password *string `schema:"-"`
+	// ProgressFormat is the format of the progress sent to ProgressWriter,
+	// "text" (default) or "json".
+	ProgressFormat *string
 	// ProgressWriter is a writer where pull progress are sent.
 	ProgressWriter *io.Writer `schema:"-"`
 	// Quiet can be specified to suppress pull progress when pulling.  Ignored
@@ -17,6 +17,21 @@ func (o *ExportOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
 
+// WithAllPlatforms set field AllPlatforms to given value
+func (o *ExportOptions) WithAllPlatforms(value bool) *ExportOptions {
+	o.AllPlatforms = &value
+	return o
+}
+
+// GetAllPlatforms returns value of field AllPlatforms
+func (o *ExportOptions) GetAllPlatforms() bool {
+	if o.AllPlatforms == nil {
+		var z bool
+		return z
+	}
+	return *o.AllPlatforms
+}
+
 // WithCompress set field Compress to given value
 func (o *ExportOptions) WithCompress(value bool) *ExportOptions {
 	o.Compress = &value
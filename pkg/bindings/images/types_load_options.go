@@ -2,6 +2,7 @@
 package images
 
 import (
+	"io"
 	"net/url"
 
 	"github.com/containers/podman/v5/pkg/bindings/internal/util"
@@ -17,6 +18,36 @@ func (o *LoadOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
 
+// WithProgressFormat set field ProgressFormat to given value
+func (o *LoadOptions) WithProgressFormat(value string) *LoadOptions {
+	o.ProgressFormat = &value
+	return o
+}
+
+// GetProgressFormat returns value of field ProgressFormat
+func (o *LoadOptions) GetProgressFormat() string {
+	if o.ProgressFormat == nil {
+		var z string
+		return z
+	}
+	return *o.ProgressFormat
+}
+
+// WithProgressWriter set field ProgressWriter to given value
+func (o *LoadOptions) WithProgressWriter(value io.Writer) *LoadOptions {
+	o.ProgressWriter = &value
+	return o
+}
+
+// GetProgressWriter returns value of field ProgressWriter
+func (o *LoadOptions) GetProgressWriter() io.Writer {
+	if o.ProgressWriter == nil {
+		var z io.Writer
+		return z
+	}
+	return *o.ProgressWriter
+}
+
 // WithReference set field Reference to given value
 func (o *LoadOptions) WithReference(value string) *LoadOptions {
 	o.Reference = &value
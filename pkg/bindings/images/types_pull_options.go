@@ -108,6 +108,21 @@ func (o *PullOptions) GetPassword() string {
 	return *o.Password
 }
 
+// WithProgressFormat set field ProgressFormat to given value
+func (o *PullOptions) WithProgressFormat(value string) *PullOptions {
+	o.ProgressFormat = &value
+	return o
+}
+
+// GetProgressFormat returns value of field ProgressFormat
+func (o *PullOptions) GetProgressFormat() string {
+	if o.ProgressFormat == nil {
+		var z string
+		return z
+	}
+	return *o.ProgressFormat
+}
+
 // WithProgressWriter set field ProgressWriter to given value
 func (o *PullOptions) WithProgressWriter(value io.Writer) *PullOptions {
 	o.ProgressWriter = &value
@@ -153,6 +153,21 @@ func (o *PushOptions) GetPassword() string {
 	return *o.Password
 }
 
+// WithProgressFormat set field ProgressFormat to given value
+func (o *PushOptions) WithProgressFormat(value string) *PushOptions {
+	o.ProgressFormat = &value
+	return o
+}
+
+// GetProgressFormat returns value of field ProgressFormat
+func (o *PushOptions) GetProgressFormat() string {
+	if o.ProgressFormat == nil {
+		var z string
+		return z
+	}
+	return *o.ProgressFormat
+}
+
 // WithProgressWriter set field ProgressWriter to given value
 func (o *PushOptions) WithProgressWriter(value io.Writer) *PushOptions {
 	o.ProgressWriter = &value
@@ -0,0 +1,98 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/containers/podman/v5/pkg/bindings"
+	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+// List returns the asynchronous jobs of the service.
+func List(ctx context.Context) ([]*entitiesTypes.JobReport, error) {
+	var reports []*entitiesTypes.JobReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/jobs/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return reports, response.Process(&reports)
+}
+
+// Inspect returns the status of a job.
+func Inspect(ctx context.Context, id string) (*entitiesTypes.JobReport, error) {
+	var report *entitiesTypes.JobReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/jobs/%s/json", nil, nil, id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return report, response.Process(&report)
+}
+
+// Logs writes the output of a job to w.
+func Logs(ctx context.Context, id string, w io.Writer, options *LogsOptions) error {
+	if options == nil {
+		options = new(LogsOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/jobs/%s/logs", params, nil, id)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if !response.IsSuccess() {
+		return response.Process(nil)
+	}
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// Cancel cancels a running job.
+func Cancel(ctx context.Context, id string) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/jobs/%s/cancel", nil, nil, id)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
+
+// Remove removes a finished job.
+func Remove(ctx context.Context, id string) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodDelete, "/jobs/%s", nil, nil, id)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
@@ -0,0 +1,9 @@
+package jobs
+
+// LogsOptions are optional options for getting the output of a job
+//
+//go:generate go run ../generator/generator.go LogsOptions
+type LogsOptions struct {
+	// Follow streams the output until the job finishes
+	Follow *bool
+}
@@ -0,0 +1,33 @@
+// Code generated by go generate; DO NOT EDIT.
+package jobs
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *LogsOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *LogsOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithFollow set field Follow to given value
+func (o *LogsOptions) WithFollow(value bool) *LogsOptions {
+	o.Follow = &value
+	return o
+}
+
+// GetFollow returns value of field Follow
+func (o *LogsOptions) GetFollow() bool {
+	if o.Follow == nil {
+		var z bool
+		return z
+	}
+	return *o.Follow
+}
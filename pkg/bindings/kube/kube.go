@@ -32,6 +32,42 @@ func PlayWithBody(ctx context.Context, body io.Reader, options *PlayOptions) (*e
 		options = new(PlayOptions)
 	}
 
+	response, err := play(ctx, body, options, false)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// PlayWithBodyAsync starts playing the kube YAML as an asynchronous job of the
+// service and returns the report of the job.  The report of the play is the
+// output of the job, see jobs.Logs().
+func PlayWithBodyAsync(ctx context.Context, body io.Reader, options *PlayOptions) (*entitiesTypes.JobReport, error) {
+	var report entitiesTypes.JobReport
+	if options == nil {
+		options = new(PlayOptions)
+	}
+
+	response, err := play(ctx, body, options, true)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func play(ctx context.Context, body io.Reader, options *PlayOptions, async bool) (*bindings.APIResponse, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
@@ -49,6 +85,9 @@ func PlayWithBody(ctx context.Context, body io.Reader, options *PlayOptions) (*e
 	if options.Start != nil {
 		params.Set("start", strconv.FormatBool(options.GetStart()))
 	}
+	if async {
+		params.Set("async", "true")
+	}
 
 	// For the remote case, read any configMaps passed and append it to the main yaml content
 	if options.ConfigMaps != nil {
@@ -75,17 +114,7 @@ func PlayWithBody(ctx context.Context, body io.Reader, options *PlayOptions) (*e
 		return nil, err
 	}
 
-	response, err := conn.DoRequest(ctx, body, http.MethodPost, "/play/kube", params, header)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	if err := response.Process(&report); err != nil {
-		return nil, err
-	}
-
-	return &report, nil
+	return conn.DoRequest(ctx, body, http.MethodPost, "/play/kube", params, header)
 }
 
 func Down(ctx context.Context, path string, options DownOptions) (*entitiesTypes.KubePlayReport, error) {
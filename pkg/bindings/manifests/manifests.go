@@ -286,6 +286,7 @@ func Push(ctx context.Context, name, destination string, options *images.PushOpt
 	}
 
 	dec := json.NewDecoder(response.Body)
+	progressEnc := json.NewEncoder(writer)
 	for {
 		var report entitiesTypes.ManifestPushReport
 		if err := dec.Decode(&report); err != nil {
@@ -299,6 +300,12 @@ func Push(ctx context.Context, name, destination string, options *images.PushOpt
 			// non-blocking select
 		}
 
+		if report.Progress != nil {
+			if err := progressEnc.Encode(report.Progress); err != nil {
+				return "", err
+			}
+		}
+
 		switch {
 		case report.ID != "":
 			return report.ID, nil
@@ -307,6 +314,8 @@ func Push(ctx context.Context, name, destination string, options *images.PushOpt
 		case report.Error != "":
 			// There can only be one error.
 			return "", errors.New(report.Error)
+		case report.Progress != nil:
+			// Already written above.
 		default:
 			return "", fmt.Errorf("failed to parse push results stream, unexpected input: %v", report)
 		}
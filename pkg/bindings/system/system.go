@@ -1,6 +1,7 @@
 package system
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -170,3 +171,33 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 
 	return &report, response.Process(&report)
 }
+
+// Batch runs a list of operations on the libpod API of the service and
+// returns the result of each of them, in the order of the operations.
+// Failed operations do not return an error, see the status codes of their
+// results instead.
+func Batch(ctx context.Context, operations []types.BatchOperation, options *BatchOptions) ([]types.BatchResult, error) {
+	var results []types.BatchResult
+	if options == nil {
+		options = new(BatchOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(operations)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, bytes.NewReader(body), http.MethodPost, "/batch", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return results, response.Process(&results)
+}
@@ -4,10 +4,15 @@ package system
 //
 //go:generate go run ../generator/generator.go EventsOptions
 type EventsOptions struct {
+	// AfterID resumes the stream after the event with this sequence ID
+	AfterID *uint64 `schema:"afterID"`
 	Filters map[string][]string
-	Since   *string
-	Stream  *bool
-	Until   *string
+	// Heartbeat asks for a heartbeat message every given number of
+	// seconds without events
+	Heartbeat *uint
+	Since     *string
+	Stream    *bool
+	Until     *string
 }
 
 // PruneOptions are optional options for pruning
@@ -48,3 +53,14 @@ type CheckOptions struct {
 	RepairLossy                 *bool   `schema:"repair_lossy"`
 	UnreferencedLayerMaximumAge *string `schema:"unreferenced_layer_max_age"`
 }
+
+// BatchOptions are optional options for running a batch of operations
+//
+//go:generate go run ../generator/generator.go BatchOptions
+type BatchOptions struct {
+	// Parallel is the maximum number of operations run at the same time,
+	// the number of CPUs of the service if 0
+	Parallel *uint
+	// FailFast skips the remaining operations after an operation failed
+	FailFast *bool `schema:"failFast"`
+}
@@ -0,0 +1,48 @@
+// Code generated by go generate; DO NOT EDIT.
+package system
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *BatchOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *BatchOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithParallel set field Parallel to given value
+func (o *BatchOptions) WithParallel(value uint) *BatchOptions {
+	o.Parallel = &value
+	return o
+}
+
+// GetParallel returns value of field Parallel
+func (o *BatchOptions) GetParallel() uint {
+	if o.Parallel == nil {
+		var z uint
+		return z
+	}
+	return *o.Parallel
+}
+
+// WithFailFast set field FailFast to given value
+func (o *BatchOptions) WithFailFast(value bool) *BatchOptions {
+	o.FailFast = &value
+	return o
+}
+
+// GetFailFast returns value of field FailFast
+func (o *BatchOptions) GetFailFast() bool {
+	if o.FailFast == nil {
+		var z bool
+		return z
+	}
+	return *o.FailFast
+}
@@ -17,6 +17,21 @@ func (o *EventsOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
 
+// WithAfterID set field AfterID to given value
+func (o *EventsOptions) WithAfterID(value uint64) *EventsOptions {
+	o.AfterID = &value
+	return o
+}
+
+// GetAfterID returns value of field AfterID
+func (o *EventsOptions) GetAfterID() uint64 {
+	if o.AfterID == nil {
+		var z uint64
+		return z
+	}
+	return *o.AfterID
+}
+
 // WithFilters set field Filters to given value
 func (o *EventsOptions) WithFilters(value map[string][]string) *EventsOptions {
 	o.Filters = value
@@ -32,6 +47,21 @@ func (o *EventsOptions) GetFilters() map[string][]string {
 	return o.Filters
 }
 
+// WithHeartbeat set field Heartbeat to given value
+func (o *EventsOptions) WithHeartbeat(value uint) *EventsOptions {
+	o.Heartbeat = &value
+	return o
+}
+
+// GetHeartbeat returns value of field Heartbeat
+func (o *EventsOptions) GetHeartbeat() uint {
+	if o.Heartbeat == nil {
+		var z uint
+		return z
+	}
+	return *o.Heartbeat
+}
+
 // WithSince set field Since to given value
 func (o *EventsOptions) WithSince(value string) *EventsOptions {
 	o.Since = &value
@@ -201,7 +201,7 @@ func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOpt
 
 	pullOptions := &libimage.PullOptions{}
 	pullOptions.Writer = os.Stderr
-	if _, err := runtime.LibimageRuntime().Pull(ctx, ctrConfig.RootfsImageName, config.PullPolicyMissing, pullOptions); err != nil {
+	if _, err := runtime.PullImage(ctx, ctrConfig.RootfsImageName, config.PullPolicyMissing, pullOptions); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,338 @@
+package crutils
+
+import (
+	"archive/tar"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/containers/ocicrypt"
+	encconfig "github.com/containers/ocicrypt/config"
+	"github.com/containers/storage/pkg/archive"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// EncryptedCheckpointFile is the name of the encrypted checkpoint
+	// archive inside an encrypted checkpoint archive or image.
+	EncryptedCheckpointFile = "checkpoint.tar.enc"
+	// EncryptionMetadataFile is the name of the file holding the wrapped
+	// keys needed to decrypt EncryptedCheckpointFile.
+	EncryptionMetadataFile = "checkpoint.encryption.json"
+
+	// passphraseScheme is the ocicrypt key wrapping scheme used to
+	// protect checkpoints with a passphrase.
+	passphraseScheme = "podman-passphrase"
+	// passphraseAnnotation is the annotation holding the keys wrapped
+	// with a passphrase.
+	passphraseAnnotation = "io.podman.checkpoint.enc.keys.passphrase"
+)
+
+// encryptionMetadata is the content of EncryptionMetadataFile.
+type encryptionMetadata struct {
+	// Annotations are the ocicrypt annotations of the encrypted archive.
+	Annotations map[string]string `json:"annotations"`
+}
+
+// CREncryptConfigWithPassphrase returns a copy of ec which additionally
+// encrypts using passphrase.  ec may be nil.
+func CREncryptConfigWithPassphrase(ec *encconfig.EncryptConfig, passphrase []byte) *encconfig.EncryptConfig {
+	result := &encconfig.EncryptConfig{
+		Parameters: map[string][][]byte{},
+		DecryptConfig: encconfig.DecryptConfig{
+			Parameters: map[string][][]byte{},
+		},
+	}
+	if ec != nil {
+		for k, v := range ec.Parameters {
+			result.Parameters[k] = v
+		}
+		for k, v := range ec.DecryptConfig.Parameters {
+			result.DecryptConfig.Parameters[k] = v
+		}
+	}
+	result.Parameters[passphraseScheme] = append(result.Parameters[passphraseScheme], passphrase)
+	return result
+}
+
+// CRDecryptConfigWithPassphrase returns a copy of dc which additionally
+// decrypts using passphrase.  dc may be nil.
+func CRDecryptConfigWithPassphrase(dc *encconfig.DecryptConfig, passphrase []byte) *encconfig.DecryptConfig {
+	result := &encconfig.DecryptConfig{
+		Parameters: map[string][][]byte{},
+	}
+	if dc != nil {
+		for k, v := range dc.Parameters {
+			result.Parameters[k] = v
+		}
+	}
+	result.Parameters[passphraseScheme] = append(result.Parameters[passphraseScheme], passphrase)
+	return result
+}
+
+// CREncryptCheckpoint encrypts the checkpoint archive read from input and
+// writes the encrypted archive (EncryptedCheckpointFile) and the data needed
+// for decryption (EncryptionMetadataFile) into the directory destination.
+func CREncryptCheckpoint(destination string, input io.Reader, ec *encconfig.EncryptConfig) error {
+	registerPassphraseKeyWrapper()
+
+	encReader, finalizer, err := ocicrypt.EncryptLayer(ec, input, imgspecv1.Descriptor{})
+	if err != nil {
+		return fmt.Errorf("encrypting checkpoint: %w", err)
+	}
+
+	encPath := filepath.Join(destination, EncryptedCheckpointFile)
+	encFile, err := os.OpenFile(encPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("creating encrypted checkpoint %q: %w", encPath, err)
+	}
+	defer encFile.Close()
+	if _, err := io.Copy(encFile, encReader); err != nil {
+		return fmt.Errorf("encrypting checkpoint: %w", err)
+	}
+
+	annotations, err := finalizer()
+	if err != nil {
+		return fmt.Errorf("encrypting checkpoint: %w", err)
+	}
+	metadataBlob, err := json.Marshal(encryptionMetadata{Annotations: annotations})
+	if err != nil {
+		return err
+	}
+	metadataPath := filepath.Join(destination, EncryptionMetadataFile)
+	if err := os.WriteFile(metadataPath, metadataBlob, 0o600); err != nil {
+		return fmt.Errorf("writing checkpoint encryption metadata %q: %w", metadataPath, err)
+	}
+	return nil
+}
+
+// CREncryptedCheckpointArchive returns an uncompressed archive of the
+// encrypted checkpoint written to directory by CREncryptCheckpoint.  The
+// metadata is the first entry of the archive, so that it can be decrypted
+// as a stream.
+func CREncryptedCheckpointArchive(directory string) (io.ReadCloser, error) {
+	return archive.TarWithOptions(directory, &archive.TarOptions{
+		Compression:  archive.Uncompressed,
+		IncludeFiles: []string{EncryptionMetadataFile, EncryptedCheckpointFile},
+	})
+}
+
+// CRIsEncryptedCheckpoint returns true if path is an encrypted checkpoint.
+// path is either a checkpoint archive or a directory, for example a mounted
+// checkpoint image.
+func CRIsEncryptedCheckpoint(path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if info.IsDir() {
+		_, err := os.Stat(filepath.Join(path, EncryptionMetadataFile))
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	// Encrypted archives are never compressed and start with the
+	// metadata, so a failure to read a tar header just means that this
+	// is a regular (possibly compressed) checkpoint archive.
+	header, err := tar.NewReader(f).Next()
+	if err != nil {
+		return false, nil //nolint:nilerr
+	}
+	return filepath.Clean(header.Name) == EncryptionMetadataFile, nil
+}
+
+// CRDecryptCheckpoint decrypts the encrypted checkpoint at path, either an
+// archive or a directory, and writes the plain checkpoint archive to output.
+func CRDecryptCheckpoint(path string, output io.Writer, dc *encconfig.DecryptConfig) error {
+	if dc == nil || len(dc.Parameters) == 0 {
+		return fmt.Errorf("checkpoint %q is encrypted: a decryption key or secret is required", path)
+	}
+	registerPassphraseKeyWrapper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		metadataBlob, err := os.ReadFile(filepath.Join(path, EncryptionMetadataFile))
+		if err != nil {
+			return fmt.Errorf("reading checkpoint encryption metadata: %w", err)
+		}
+		encFile, err := os.Open(filepath.Join(path, EncryptedCheckpointFile))
+		if err != nil {
+			return fmt.Errorf("opening encrypted checkpoint: %w", err)
+		}
+		defer encFile.Close()
+		return decryptCheckpoint(metadataBlob, encFile, output, dc)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open checkpoint archive %s for import: %w", path, err)
+	}
+	defer f.Close()
+	reader := tar.NewReader(f)
+	var metadataBlob []byte
+	for {
+		header, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("encrypted checkpoint archive %s is incomplete", path)
+			}
+			return fmt.Errorf("reading encrypted checkpoint archive %s: %w", path, err)
+		}
+		switch filepath.Clean(header.Name) {
+		case EncryptionMetadataFile:
+			metadataBlob, err = io.ReadAll(reader)
+			if err != nil {
+				return fmt.Errorf("reading checkpoint encryption metadata: %w", err)
+			}
+		case EncryptedCheckpointFile:
+			if metadataBlob == nil {
+				return fmt.Errorf("encrypted checkpoint archive %s has no encryption metadata", path)
+			}
+			return decryptCheckpoint(metadataBlob, reader, output, dc)
+		}
+	}
+}
+
+func decryptCheckpoint(metadataBlob []byte, input io.Reader, output io.Writer, dc *encconfig.DecryptConfig) error {
+	var metadata encryptionMetadata
+	if err := json.Unmarshal(metadataBlob, &metadata); err != nil {
+		return fmt.Errorf("decoding checkpoint encryption metadata: %w", err)
+	}
+	plainReader, _, err := ocicrypt.DecryptLayer(dc, input, imgspecv1.Descriptor{Annotations: metadata.Annotations}, false)
+	if err != nil {
+		return fmt.Errorf("decrypting checkpoint: %w", err)
+	}
+	// The integrity of the data is verified once all of it was read.
+	if _, err := io.Copy(output, plainReader); err != nil {
+		return fmt.Errorf("decrypting checkpoint: %w", err)
+	}
+	return nil
+}
+
+var registerPassphraseOnce sync.Once
+
+// registerPassphraseKeyWrapper makes ocicrypt wrap keys with passphrases
+// stored in the podman-passphrase parameter.
+func registerPassphraseKeyWrapper() {
+	registerPassphraseOnce.Do(func() {
+		ocicrypt.RegisterKeyWrapper(passphraseScheme, passphraseKeyWrapper{})
+	})
+}
+
+// passphraseKeyWrapper wraps keys with a key derived from a passphrase using
+// scrypt and AES-256-GCM.
+type passphraseKeyWrapper struct{}
+
+// wrappedPassphraseKey is the annotation content of passphraseKeyWrapper.
+type wrappedPassphraseKey struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// scrypt parameters as recommended for interactive logins in 2017.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+func passphraseAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (passphraseKeyWrapper) WrapKeys(ec *encconfig.EncryptConfig, optsData []byte) ([]byte, error) {
+	passphrases := ec.Parameters[passphraseScheme]
+	if len(passphrases) == 0 {
+		return nil, nil
+	}
+	if len(passphrases) > 1 {
+		return nil, errors.New("only one passphrase can be used for encrypting a checkpoint")
+	}
+
+	wrapped := wrappedPassphraseKey{Salt: make([]byte, 16)}
+	if _, err := rand.Read(wrapped.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := passphraseAEAD(passphrases[0], wrapped.Salt)
+	if err != nil {
+		return nil, err
+	}
+	wrapped.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(wrapped.Nonce); err != nil {
+		return nil, err
+	}
+	wrapped.Ciphertext = aead.Seal(nil, wrapped.Nonce, optsData, nil)
+	return json.Marshal(wrapped)
+}
+
+func (passphraseKeyWrapper) UnwrapKey(dc *encconfig.DecryptConfig, annotation []byte) ([]byte, error) {
+	var wrapped wrappedPassphraseKey
+	if err := json.Unmarshal(annotation, &wrapped); err != nil {
+		return nil, fmt.Errorf("decoding passphrase-wrapped key: %w", err)
+	}
+	for _, passphrase := range dc.Parameters[passphraseScheme] {
+		aead, err := passphraseAEAD(passphrase, wrapped.Salt)
+		if err != nil {
+			return nil, err
+		}
+		if len(wrapped.Nonce) != aead.NonceSize() {
+			return nil, errors.New("invalid nonce of passphrase-wrapped key")
+		}
+		optsData, err := aead.Open(nil, wrapped.Nonce, wrapped.Ciphertext, nil)
+		if err == nil {
+			return optsData, nil
+		}
+	}
+	return nil, errors.New("wrong passphrase")
+}
+
+func (passphraseKeyWrapper) GetAnnotationID() string {
+	return passphraseAnnotation
+}
+
+func (passphraseKeyWrapper) NoPossibleKeys(dcparameters map[string][][]byte) bool {
+	return len(dcparameters[passphraseScheme]) == 0
+}
+
+func (passphraseKeyWrapper) GetPrivateKeys(dcparameters map[string][][]byte) [][]byte {
+	return dcparameters[passphraseScheme]
+}
+
+func (passphraseKeyWrapper) GetKeyIdsFromPacket(_ string) ([]uint64, error) {
+	return nil, nil
+}
+
+func (passphraseKeyWrapper) GetRecipients(_ string) ([]string, error) {
+	return []string{"[passphrase]"}, nil
+}
@@ -0,0 +1,91 @@
+package crutils
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/storage/pkg/archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCheckpointData = []byte("checkpoint data")
+
+func encryptTestCheckpoint(t *testing.T, passphrase string) string {
+	dir := t.TempDir()
+	ec := CREncryptConfigWithPassphrase(nil, []byte(passphrase))
+	require.NoError(t, CREncryptCheckpoint(dir, bytes.NewReader(testCheckpointData), ec))
+	return dir
+}
+
+func TestCheckpointEncryptionDirectory(t *testing.T) {
+	dir := encryptTestCheckpoint(t, "secret")
+
+	encrypted, err := CRIsEncryptedCheckpoint(dir)
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+
+	var plain bytes.Buffer
+	require.NoError(t, CRDecryptCheckpoint(dir, &plain, CRDecryptConfigWithPassphrase(nil, []byte("secret"))))
+	assert.Equal(t, testCheckpointData, plain.Bytes())
+}
+
+func TestCheckpointEncryptionArchive(t *testing.T) {
+	dir := encryptTestCheckpoint(t, "secret")
+
+	input, err := CREncryptedCheckpointArchive(dir)
+	require.NoError(t, err)
+	archivePath := filepath.Join(t.TempDir(), "checkpoint.tar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	_, err = io.Copy(f, input)
+	require.NoError(t, err)
+	require.NoError(t, input.Close())
+	require.NoError(t, f.Close())
+
+	encrypted, err := CRIsEncryptedCheckpoint(archivePath)
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+
+	var plain bytes.Buffer
+	require.NoError(t, CRDecryptCheckpoint(archivePath, &plain, CRDecryptConfigWithPassphrase(nil, []byte("secret"))))
+	assert.Equal(t, testCheckpointData, plain.Bytes())
+}
+
+func TestCheckpointDecryptionFailures(t *testing.T) {
+	dir := encryptTestCheckpoint(t, "secret")
+
+	err := CRDecryptCheckpoint(dir, io.Discard, nil)
+	assert.ErrorContains(t, err, "a decryption key or secret is required")
+
+	err = CRDecryptCheckpoint(dir, io.Discard, CRDecryptConfigWithPassphrase(nil, []byte("wrong")))
+	assert.Error(t, err)
+}
+
+func TestCRIsEncryptedCheckpointPlain(t *testing.T) {
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "config.dump"), []byte("{}"), 0o644))
+
+	for _, compression := range []archive.Compression{archive.Uncompressed, archive.Gzip, archive.Zstd} {
+		input, err := archive.TarWithOptions(srcDir, &archive.TarOptions{Compression: compression})
+		require.NoError(t, err)
+		archivePath := filepath.Join(t.TempDir(), "checkpoint.tar")
+		f, err := os.Create(archivePath)
+		require.NoError(t, err)
+		_, err = io.Copy(f, input)
+		require.NoError(t, err)
+		require.NoError(t, input.Close())
+		require.NoError(t, f.Close())
+
+		encrypted, err := CRIsEncryptedCheckpoint(archivePath)
+		require.NoError(t, err)
+		assert.False(t, encrypted, compression.Extension())
+	}
+
+	encrypted, err := CRIsEncryptedCheckpoint(srcDir)
+	require.NoError(t, err)
+	assert.False(t, encrypted)
+}
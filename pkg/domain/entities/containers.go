@@ -8,8 +8,10 @@ import (
 
 	nettypes "github.com/containers/common/libnetwork/types"
 	imageTypes "github.com/containers/image/v5/types"
+	encconfig "github.com/containers/ocicrypt/config"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/containers/podman/v5/pkg/parallel"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/storage/pkg/archive"
 )
@@ -93,7 +95,13 @@ type PauseUnpauseReport struct {
 	RawInput string
 }
 
+// BulkOptions control how an operation on many containers, pods or volumes
+// is run: how many items are processed in parallel and whether to stop at
+// the first failure.
+type BulkOptions = parallel.Options
+
 type StopOptions struct {
+	BulkOptions
 	Filters map[string][]string
 	All     bool
 	Ignore  bool
@@ -118,6 +126,7 @@ type TopOptions struct {
 }
 
 type KillOptions struct {
+	BulkOptions
 	All    bool
 	Latest bool
 	Signal string
@@ -130,6 +139,7 @@ type KillReport struct {
 }
 
 type RestartOptions struct {
+	BulkOptions
 	Filters map[string][]string
 	All     bool
 	Latest  bool
@@ -144,6 +154,7 @@ type RestartReport struct {
 }
 
 type RmOptions struct {
+	BulkOptions
 	Filters map[string][]string
 	All     bool
 	Depend  bool
@@ -209,6 +220,12 @@ type CheckpointOptions struct {
 	Compression    archive.Compression
 	PrintStats     bool
 	FileLocks      bool
+	// OciEncryptConfig when non-nil indicates that the exported
+	// checkpoint should be encrypted.
+	OciEncryptConfig *encconfig.EncryptConfig
+	// EncryptionSecret is the name of a secret whose content is used as
+	// passphrase to encrypt the exported checkpoint.
+	EncryptionSecret string
 }
 
 type CheckpointReport = types.CheckpointReport
@@ -230,6 +247,12 @@ type RestoreOptions struct {
 	Pod             string
 	PrintStats      bool
 	FileLocks       bool
+	// OciDecryptConfig contains the config that can be used to decrypt
+	// an encrypted checkpoint.
+	OciDecryptConfig *encconfig.DecryptConfig
+	// DecryptionSecret is the name of a secret whose content is used as
+	// passphrase to decrypt an encrypted checkpoint.
+	DecryptionSecret string
 }
 
 type RestoreReport = types.RestoreReport
@@ -287,9 +310,12 @@ type ExecOptions struct {
 	PreserveFDs uint
 	PreserveFD  []uint
 	Privileged  bool
-	Tty         bool
-	User        string
-	WorkDir     string
+	// RecordFile is the path of a file to record the terminal session
+	// to, in asciicast v2 format.
+	RecordFile string
+	Tty        bool
+	User       string
+	WorkDir    string
 }
 
 // ContainerExistsOptions describes the cli values to check if a container exists
@@ -300,6 +326,7 @@ type ContainerExistsOptions struct {
 // ContainerStartOptions describes the val from the
 // CLI needed to start a container
 type ContainerStartOptions struct {
+	BulkOptions
 	Filters     map[string][]string
 	All         bool
 	Attach      bool
@@ -361,6 +388,9 @@ type ContainerRunOptions struct {
 type ContainerRunReport struct {
 	ExitCode int
 	Id       string //nolint:revive,stylecheck
+	// Timing are the timing spans of starting the container, if timing
+	// is enabled in the spec.
+	Timing []define.TimingSpan
 }
 
 // ContainerCleanupOptions are the CLI values for the
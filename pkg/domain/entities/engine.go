@@ -46,6 +46,8 @@ type PodmanConfig struct {
 	RuntimeFlags             []string // global flags for the container runtime
 	Syslog                   bool     // write logging information to syslog as well as the console
 	Trace                    bool     // Hidden: Trace execution
+	TraceOTLPEndpoint        string   // OTLP/HTTP endpoint to export traces to
+	TraceOTLPFile            string   // File to export traces to
 	URI                      string   // URI to RESTful API Service
 	FarmNodeName             string   // Name of farm node
 	ConnectionError          error    // Error when looking up the connection in setupRemoteConnection()
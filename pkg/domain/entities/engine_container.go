@@ -62,6 +62,11 @@ type ContainerEngine interface { //nolint:interfacebloat
 	SystemPrune(ctx context.Context, options SystemPruneOptions) (*SystemPruneReport, error)
 	HealthCheckRun(ctx context.Context, nameOrID string, options HealthCheckOptions) (*define.HealthCheckResults, error)
 	Info(ctx context.Context) (*define.Info, error)
+	JobCancel(ctx context.Context, ids []string) ([]*JobCancelReport, error)
+	JobInspect(ctx context.Context, id string) (*JobReport, error)
+	JobList(ctx context.Context) ([]*JobReport, error)
+	JobLogs(ctx context.Context, id string, options JobLogsOptions) error
+	JobRm(ctx context.Context, ids []string) ([]*JobRmReport, error)
 	KubeApply(ctx context.Context, body io.Reader, opts ApplyOptions) error
 	Locks(ctx context.Context) (*LocksReport, error)
 	Migrate(ctx context.Context, options SystemMigrateOptions) error
@@ -104,6 +109,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	Shutdown(ctx context.Context)
 	SystemDf(ctx context.Context, options SystemDfOptions) (*SystemDfReport, error)
 	SystemCheck(ctx context.Context, options SystemCheckOptions) (*SystemCheckReport, error)
+	SystemStorageMigrate(ctx context.Context, options SystemStorageMigrateOptions) (*SystemStorageMigrateReport, error)
 	Unshare(ctx context.Context, args []string, options SystemUnshareOptions) error
 	Version(ctx context.Context) (*SystemVersionReport, error)
 	VolumeCreate(ctx context.Context, opts VolumeCreateOptions) (*IDOrNameResponse, error)
@@ -11,6 +11,7 @@ import (
 type ImageEngine interface { //nolint:interfacebloat
 	Build(ctx context.Context, containerFiles []string, opts BuildOptions) (*BuildReport, error)
 	Config(ctx context.Context) (*config.Config, error)
+	Convert(ctx context.Context, nameOrID string, opts ImageConvertOptions) (*ImageConvertReport, error)
 	Exists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	History(ctx context.Context, nameOrID string, opts ImageHistoryOptions) (*ImageHistoryReport, error)
 	Import(ctx context.Context, opts ImageImportOptions) (*ImageImportReport, error)
@@ -22,7 +23,7 @@ type ImageEngine interface { //nolint:interfacebloat
 	Pull(ctx context.Context, rawImage string, opts ImagePullOptions) (*ImagePullReport, error)
 	Push(ctx context.Context, source string, destination string, opts ImagePushOptions) (*ImagePushReport, error)
 	Remove(ctx context.Context, images []string, opts ImageRemoveOptions) (*ImageRemoveReport, []error)
-	Save(ctx context.Context, nameOrID string, tags []string, options ImageSaveOptions) error
+	Save(ctx context.Context, nameOrID string, tags []string, options ImageSaveOptions) (*ImageSaveReport, error)
 	Scp(ctx context.Context, src, dst string, opts ImageScpOptions) (*ImageScpReport, error)
 	Search(ctx context.Context, term string, opts ImageSearchOptions) ([]ImageSearchReport, error)
 	SetTrust(ctx context.Context, args []string, options SetTrustOptions) error
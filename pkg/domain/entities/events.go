@@ -11,6 +11,8 @@ import (
 
 type Event = types.Event
 
+const EventTypeHeartbeat = types.EventTypeHeartbeat
+
 // ConvertToLibpodEvent converts an entities event to a libpod one.
 func ConvertToLibpodEvent(e Event) *libpodEvents.Event {
 	var exitCode int
@@ -52,6 +54,7 @@ func ConvertToLibpodEvent(e Event) *libpodEvents.Event {
 		Type:              t,
 		HealthStatus:      e.HealthStatus,
 		Error:             errorString,
+		SequenceID:        e.SequenceID,
 		Details: libpodEvents.Details{
 			PodID:      podID,
 			Attributes: details,
@@ -95,5 +98,6 @@ func ConvertToEntitiesEvent(e libpodEvents.Event) *types.Event {
 	return &types.Event{
 		Message:      message,
 		HealthStatus: e.HealthStatus,
+		SequenceID:   e.SequenceID,
 	}
 }
@@ -111,16 +111,26 @@ type ImagePullOptions struct {
 	SkipTLSVerify types.OptionalBool
 	// PullPolicy whether to pull new image
 	PullPolicy config.PullPolicy
+	// ProgressFormat selects how progress is written to Writer: "text"
+	// (the default) or "json" for one ProgressEvent per line.
+	ProgressFormat string
 	// Writer is used to display copy information including progress bars.
 	Writer io.Writer
 	// OciDecryptConfig contains the config that can be used to decrypt an image if it is
 	// encrypted if non-nil. If nil, it does not attempt to decrypt an image.
 	OciDecryptConfig *encconfig.DecryptConfig
+	// Async starts the pull as a job of the service and returns right
+	// away.  Only supported for remote calls.
+	Async bool
 }
 
 // ImagePullReport is the response from pulling one or more images.
 type ImagePullReport = entitiesTypes.ImagePullReport
 
+// ProgressEvent is a machine-readable progress report of a pull, push or
+// load operation.
+type ProgressEvent = entitiesTypes.ProgressEvent
+
 // ImagePushOptions are the arguments for pushing images.
 type ImagePushOptions struct {
 	// All indicates that all images referenced in a manifest list should be pushed
@@ -177,6 +187,9 @@ type ImagePushOptions struct {
 	SkipTLSVerify types.OptionalBool
 	// Progress to get progress notifications
 	Progress chan types.ProgressProperties
+	// ProgressFormat selects how progress is written to Writer: "text"
+	// (the default) or "json" for one ProgressEvent per line.
+	ProgressFormat string
 	// CompressionFormat is the format to use for the compression of the blobs
 	CompressionFormat string
 	// CompressionLevel is the level to use for the compression of the blobs
@@ -199,12 +212,17 @@ type ImagePushOptions struct {
 	// CompressionFormat is used exclusively, and blobs of other compression
 	// algorithms are not reused.
 	ForceCompressionFormat bool
+	// Async starts the push as a job of the service and returns right
+	// away.  Only supported for remote calls.
+	Async bool
 }
 
 // ImagePushReport is the response from pushing an image.
 type ImagePushReport struct {
 	// The digest of the manifest of the pushed image.
 	ManifestDigest string
+	// JobID is the ID of the job of an asynchronous push.
+	JobID string
 }
 
 // ImagePushStream is the response from pushing an image. Only used in the
@@ -262,9 +280,14 @@ type ImageUntagOptions struct{}
 type ImageInspectReport = entitiesTypes.ImageInspectReport
 
 type ImageLoadOptions struct {
-	Input           string
+	Input string
+	// ProgressFormat selects how progress is reported on stderr: "text"
+	// (the default) or "json" for one ProgressEvent per line.
+	ProgressFormat  string
 	Quiet           bool
 	SignaturePolicy string
+	// Writer is used to display copy information instead of stderr.
+	Writer io.Writer
 }
 
 type ImageLoadReport = entitiesTypes.ImageLoadReport
@@ -286,6 +309,10 @@ type ImageImportReport = entitiesTypes.ImageImportReport
 
 // ImageSaveOptions provide options for saving images.
 type ImageSaveOptions struct {
+	// AllPlatforms saves a manifest list with all of its instances and
+	// attached artifacts as an OCI image index.  Only supported for the
+	// oci-archive and oci-dir formats.
+	AllPlatforms bool
 	// Compress layers when saving to a directory.
 	Compress bool
 	// Format of saving the image: oci-archive, oci-dir (directory with oci
@@ -303,6 +330,17 @@ type ImageSaveOptions struct {
 	// Quiet - suppress output when copying images
 	Quiet           bool
 	SignaturePolicy string
+	// Async starts the save as a job of the service and returns right
+	// away.  The archive is then the output of the job instead of being
+	// written to Output.  Only supported for remote calls and the archive
+	// formats.
+	Async bool
+}
+
+// ImageSaveReport is the response from saving images.
+type ImageSaveReport struct {
+	// JobID is the ID of the job of an asynchronous save.
+	JobID string
 }
 
 // ImageScpOptions provides options for ImageEngine.Scp()
@@ -331,6 +369,33 @@ type ImageTreeOptions struct {
 // ImageTreeReport provides results from ImageEngine.Tree()
 type ImageTreeReport = entitiesTypes.ImageTreeReport
 
+// ImageConvertOptions provides options for ImageEngine.Convert()
+type ImageConvertOptions struct {
+	// Tags are the names of the converted image.  It takes over the name
+	// of the source image if empty.
+	Tags []string
+	// Format is the manifest type (oci or v2s2) of the converted image.
+	Format string
+	// CompressionFormat is the compression of the layers of the converted
+	// image, e.g. gzip, zstd or zstd:chunked.
+	CompressionFormat string
+	// CompressionLevel is the compression level of the layers.
+	CompressionLevel *int
+	// InPlace allows converting an image whose converted image gets
+	// the same ID.  The image is then changed in place, including under
+	// its other names, and its signatures are removed.
+	InPlace bool
+	// SignaturePolicy to use when copying the image.
+	SignaturePolicy string
+	// Quiet suppresses the progress output.
+	Quiet bool
+	// Writer is used to display copy information including progress bars.
+	Writer io.Writer
+}
+
+// ImageConvertReport provides results from ImageEngine.Convert()
+type ImageConvertReport = entitiesTypes.ImageConvertReport
+
 // ShowTrustOptions are the cli options for showing trust
 type ShowTrustOptions struct {
 	JSON         bool
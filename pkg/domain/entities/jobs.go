@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"io"
+
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+type JobReport = types.JobReport
+
+const (
+	JobStatusRunning   = types.JobStatusRunning
+	JobStatusSucceeded = types.JobStatusSucceeded
+	JobStatusFailed    = types.JobStatusFailed
+	JobStatusCanceled  = types.JobStatusCanceled
+)
+
+// JobLogsOptions describe how the output of a job is retrieved.
+type JobLogsOptions struct {
+	// Follow streams the output until the job finishes.
+	Follow bool
+	// Writer receives the output of the job.
+	Writer io.Writer
+}
+
+// JobRmReport is the result of removing a job.
+type JobRmReport struct {
+	ID  string
+	Err error
+}
+
+// JobCancelReport is the result of canceling a job.
+type JobCancelReport struct {
+	ID  string
+	Err error
+}
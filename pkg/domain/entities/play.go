@@ -78,6 +78,9 @@ type PlayKubeOptions struct {
 	Wait bool
 	// SystemContext - used when building the image
 	SystemContext *types.SystemContext
+	// Async - start the play as a job of the service and return right
+	// away.  Only supported for remote calls.
+	Async bool
 }
 
 // PlayKubePod represents a single pod and associated containers created by play kube
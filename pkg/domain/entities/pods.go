@@ -38,6 +38,7 @@ type PodunpauseOptions struct {
 type PodUnpauseReport = types.PodUnpauseReport
 
 type PodStopOptions struct {
+	BulkOptions
 	All     bool
 	Ignore  bool
 	Latest  bool
@@ -53,6 +54,7 @@ type PodRestartOptions struct {
 
 type PodRestartReport = types.PodRestartReport
 type PodStartOptions struct {
+	BulkOptions
 	All    bool
 	Latest bool
 }
@@ -60,6 +62,7 @@ type PodStartOptions struct {
 type PodStartReport = types.PodStartReport
 
 type PodRmOptions struct {
+	BulkOptions
 	All     bool
 	Force   bool
 	Ignore  bool
@@ -75,33 +78,35 @@ type PodSpec = types.PodSpec
 // The JSON tags below are made to match the respective field in ContainerCreateOptions for the purpose of mapping.
 // swagger:model PodCreateOptions
 type PodCreateOptions struct {
-	CgroupParent       string            `json:"cgroup_parent,omitempty"`
-	CreateCommand      []string          `json:"create_command,omitempty"`
-	Devices            []string          `json:"devices,omitempty"`
-	DeviceReadBPs      []string          `json:"device_read_bps,omitempty"`
-	ExitPolicy         string            `json:"exit_policy,omitempty"`
-	Hostname           string            `json:"hostname,omitempty"`
-	Infra              bool              `json:"infra,omitempty"`
-	InfraImage         string            `json:"infra_image,omitempty"`
-	InfraName          string            `json:"container_name,omitempty"`
-	InfraCommand       *string           `json:"container_command,omitempty"`
-	InfraConmonPidFile string            `json:"container_conmon_pidfile,omitempty"`
-	Ipc                string            `json:"ipc,omitempty"`
-	Labels             map[string]string `json:"labels,omitempty"`
-	Name               string            `json:"name,omitempty"`
-	Net                *NetOptions       `json:"net,omitempty"`
-	Share              []string          `json:"share,omitempty"`
-	ShareParent        *bool             `json:"share_parent,omitempty"`
-	Restart            string            `json:"restart,omitempty"`
-	Pid                string            `json:"pid,omitempty"`
-	Cpus               float64           `json:"cpus,omitempty"`
-	CpusetCpus         string            `json:"cpuset_cpus,omitempty"`
-	Userns             specgen.Namespace `json:"-"`
-	Volume             []string          `json:"volume,omitempty"`
-	VolumesFrom        []string          `json:"volumes_from,omitempty"`
-	SecurityOpt        []string          `json:"security_opt,omitempty"`
-	Sysctl             []string          `json:"sysctl,omitempty"`
-	Uts                string            `json:"uts,omitempty"`
+	CgroupParent        string            `json:"cgroup_parent,omitempty"`
+	CreateCommand       []string          `json:"create_command,omitempty"`
+	Devices             []string          `json:"devices,omitempty"`
+	DeviceReadBPs       []string          `json:"device_read_bps,omitempty"`
+	ExitPolicy          string            `json:"exit_policy,omitempty"`
+	Hostname            string            `json:"hostname,omitempty"`
+	Infra               bool              `json:"infra,omitempty"`
+	InfraImage          string            `json:"infra_image,omitempty"`
+	InfraName           string            `json:"container_name,omitempty"`
+	InfraCommand        *string           `json:"container_command,omitempty"`
+	InfraConmonPidFile  string            `json:"container_conmon_pidfile,omitempty"`
+	InfraNoImage        bool              `json:"infra_no_image,omitempty"`
+	InfraHealthInterval string            `json:"infra_health_interval,omitempty"`
+	Ipc                 string            `json:"ipc,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	Name                string            `json:"name,omitempty"`
+	Net                 *NetOptions       `json:"net,omitempty"`
+	Share               []string          `json:"share,omitempty"`
+	ShareParent         *bool             `json:"share_parent,omitempty"`
+	Restart             string            `json:"restart,omitempty"`
+	Pid                 string            `json:"pid,omitempty"`
+	Cpus                float64           `json:"cpus,omitempty"`
+	CpusetCpus          string            `json:"cpuset_cpus,omitempty"`
+	Userns              specgen.Namespace `json:"-"`
+	Volume              []string          `json:"volume,omitempty"`
+	VolumesFrom         []string          `json:"volumes_from,omitempty"`
+	SecurityOpt         []string          `json:"security_opt,omitempty"`
+	Sysctl              []string          `json:"sysctl,omitempty"`
+	Uts                 string            `json:"uts,omitempty"`
 }
 
 // PodLogsOptions describes the options to extract pod logs.
@@ -340,6 +345,8 @@ func ToPodSpecGen(s specgen.PodSpecGenerator, p *PodCreateOptions) (*specgen.Pod
 		s.InfraConmonPidFile = p.InfraConmonPidFile
 	}
 	s.InfraImage = p.InfraImage
+	s.InfraNoImage = p.InfraNoImage
+	s.InfraHealthInterval = p.InfraHealthInterval
 	s.SharedNamespaces = p.Share
 	s.ShareParent = p.ShareParent
 	s.PodCreateCommand = p.CreateCommand
@@ -0,0 +1,41 @@
+package reports
+
+import (
+	"errors"
+
+	"github.com/containers/podman/v5/pkg/parallel"
+)
+
+const (
+	// BulkStatusOK is the status of items the operation succeeded on.
+	BulkStatusOK = "ok"
+	// BulkStatusFailed is the status of items the operation failed on.
+	BulkStatusFailed = "failed"
+	// BulkStatusSkipped is the status of items skipped after a failure
+	// of the operation on a previous item with --fail-fast.
+	BulkStatusSkipped = "skipped"
+)
+
+// BulkResult is the result of an operation on one of many containers, pods,
+// images or volumes, as printed by the commands with --format json.
+type BulkResult struct {
+	Id       string `json:"id,omitempty"` //nolint:revive,stylecheck
+	RawInput string `json:"rawInput,omitempty"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// NewBulkResult returns the result of an operation on the item identified
+// by id and rawInput, which failed with err unless it is nil.
+func NewBulkResult(id, rawInput string, err error) BulkResult {
+	result := BulkResult{Id: id, RawInput: rawInput, Status: BulkStatusOK}
+	switch {
+	case err == nil:
+	case errors.Is(err, parallel.ErrSkipped):
+		result.Status = BulkStatusSkipped
+	default:
+		result.Status = BulkStatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -9,6 +9,8 @@ type ServiceOptions = types.ServiceOptions
 type SystemPruneOptions = types.SystemPruneOptions
 type SystemPruneReport = types.SystemPruneReport
 type SystemMigrateOptions = types.SystemMigrateOptions
+type SystemStorageMigrateOptions = types.SystemStorageMigrateOptions
+type SystemStorageMigrateReport = types.SystemStorageMigrateReport
 type SystemCheckOptions = types.SystemCheckOptions
 type SystemCheckReport = types.SystemCheckReport
 type SystemDfOptions = types.SystemDfOptions
@@ -20,6 +22,8 @@ type SystemVersionReport = types.SystemVersionReport
 type SystemUnshareOptions = types.SystemUnshareOptions
 type ComponentVersion = types.SystemComponentVersion
 type ListRegistriesReport = types.ListRegistriesReport
+type BatchOperation = types.BatchOperation
+type BatchResult = types.BatchResult
 
 type AuthConfig = types.AuthConfig
 type AuthReport = types.AuthReport
@@ -81,14 +81,24 @@ type DiffOptions struct {
 	Format string          `json:",omitempty"` // CLI only
 	Latest bool            `json:",omitempty"` // API and CLI, only supported by containers
 	Type   define.DiffType // Type which should be compared
+	// Content compares the content of the files of a container with its
+	// image.  CLI only.
+	Content bool `json:",omitempty"`
+	// Export writes the changes of a container to this tar archive.  CLI only.
+	Export string `json:",omitempty"`
+	// Paths restricts the changes of a container to these paths.  CLI only.
+	Paths []string `json:",omitempty"`
 }
 
 // DiffReport provides changes for object
 type DiffReport struct {
 	Changes []archive.Change
+	// Files are the content changes if DiffOptions.Content is set.
+	Files []define.FileDiff
 }
 
 type EventsOptions struct {
+	AfterID   uint64
 	FromStart bool
 	EventChan chan events.ReadResult
 	Filter    []string
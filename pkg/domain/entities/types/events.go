@@ -11,4 +11,12 @@ type Event struct {
 	// point and fork such Docker types.
 	dockerEvents.Message
 	HealthStatus string `json:",omitempty"`
+	// SequenceID is the position of the event in the events log.  Pass
+	// it as afterID to resume streaming events after this one.
+	SequenceID uint64 `json:",omitempty"`
 }
+
+// EventTypeHeartbeat is the type of the messages sent on idle event streams
+// when the client asked for heartbeats.  They carry no event and only tell
+// the client that the connection is alive.
+const EventTypeHeartbeat = "heartbeat"
@@ -32,6 +32,9 @@ type ImageSummary struct {
 	IsManifestList *bool    `json:",omitempty"`
 	Names          []string `json:",omitempty"`
 	Os             string   `json:",omitempty"`
+	// LastUsed is the time a container was last created or started from
+	// the image in seconds since the epoch, zero if unknown.
+	LastUsed int64 `json:",omitempty"`
 }
 
 func (i *ImageSummary) Id() string { //nolint:revive,stylecheck
@@ -54,6 +57,22 @@ type ImageTreeReport struct {
 	Tree string // TODO: Refactor move presentation work out of server
 }
 
+// ImageConvertReport compares the source image of a conversion with the
+// converted image.
+type ImageConvertReport struct {
+	// ID of the converted image.
+	ID string
+	// SourceID is the ID of the source image.
+	SourceID string
+	// Format and SourceFormat are the manifest types of the images.
+	Format       string
+	SourceFormat string
+	// Size and SourceSize are the sizes of the manifests, configs and
+	// compressed layers of the images.
+	Size       int64
+	SourceSize int64
+}
+
 type ImageLoadReport struct {
 	Names []string
 }
@@ -136,15 +155,67 @@ type ImageHistoryReport struct {
 	Layers []ImageHistoryLayer
 }
 
+// Events reported by a ProgressEvent.
+const (
+	// ProgressEventStart is reported when copying a blob starts.
+	ProgressEventStart = "start"
+	// ProgressEventProgress is reported periodically while a blob is copied.
+	ProgressEventProgress = "progress"
+	// ProgressEventDone is reported when a blob was copied completely.
+	ProgressEventDone = "done"
+	// ProgressEventSkipped is reported when a blob is already present at
+	// the destination and does not need to be copied.
+	ProgressEventSkipped = "skipped"
+	// ProgressEventRetry is reported when copying a blob is restarted
+	// after a transient error.
+	ProgressEventRetry = "retry"
+	// ProgressEventError is reported when the operation failed.
+	ProgressEventError = "error"
+	// ProgressEventSuccess is reported when the operation succeeded.
+	ProgressEventSuccess = "success"
+)
+
+// ProgressEvent is a machine-readable progress report of a pull, push or
+// load operation.
+type ProgressEvent struct {
+	// Event is one of the ProgressEvent* constants.
+	Event string `json:"event"`
+	// Time the event was reported.
+	Time time.Time `json:"time"`
+	// Digest of the blob the event refers to.
+	Digest string `json:"digest,omitempty"`
+	// MediaType of the blob the event refers to.
+	MediaType string `json:"mediaType,omitempty"`
+	// Current is the number of bytes of the blob copied so far.
+	Current uint64 `json:"current,omitempty"`
+	// Total is the size of the blob in bytes, -1 if unknown.
+	Total int64 `json:"total,omitempty"`
+	// Attempt is the number of times copying the blob was retried.
+	Attempt int `json:"attempt,omitempty"`
+	// Error is the error message of an error event.
+	Error string `json:"error,omitempty"`
+	// Images are the IDs of the pulled or loaded images of a success
+	// event.
+	Images []string `json:"images,omitempty"`
+	// ManifestDigest is the digest of the pushed manifest of a success
+	// event.
+	ManifestDigest string `json:"manifestDigest,omitempty"`
+}
+
 type ImagePullReport struct {
 	// Stream used to provide output from c/image
 	Stream string `json:"stream,omitempty"`
+	// Progress is a machine-readable progress event, only used if
+	// requested instead of Stream.
+	Progress *ProgressEvent `json:"progress,omitempty"`
 	// Error contains text of errors from c/image
 	Error string `json:"error,omitempty"`
 	// Images contains the ID's of the images pulled
 	Images []string `json:"images,omitempty"`
 	// ID contains image id (retained for backwards compatibility)
 	ID string `json:"id,omitempty"`
+	// JobID is the ID of the job of an asynchronous pull
+	JobID string `json:"jobID,omitempty"`
 }
 
 type ImagePushStream struct {
@@ -152,6 +223,9 @@ type ImagePushStream struct {
 	ManifestDigest string `json:"manifestdigest,omitempty"`
 	// Stream used to provide push progress
 	Stream string `json:"stream,omitempty"`
+	// Progress is a machine-readable progress event, only used if
+	// requested instead of Stream.
+	Progress *ProgressEvent `json:"progress,omitempty"`
 	// Error contains text of errors from pushing
 	Error string `json:"error,omitempty"`
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+)
+
+const (
+	// JobStatusRunning is the status of a job which has not finished yet.
+	JobStatusRunning = "running"
+	// JobStatusSucceeded is the status of a job which finished without error.
+	JobStatusSucceeded = "succeeded"
+	// JobStatusFailed is the status of a job which finished with an error.
+	JobStatusFailed = "failed"
+	// JobStatusCanceled is the status of a job which was canceled before
+	// it finished.
+	JobStatusCanceled = "canceled"
+)
+
+// JobReport describes an asynchronous job of the API service.
+// swagger:model JobReport
+type JobReport struct {
+	// ID of the job
+	ID string `json:"Id"`
+	// Operation run by the job, e.g. "image pull"
+	Operation string
+	// Status is one of running, succeeded, failed or canceled
+	Status string
+	// Created is the time the job was submitted
+	Created time.Time
+	// Finished is the time the job finished, zero while it runs
+	Finished time.Time
+	// StatusCode is the HTTP status code the operation responded with
+	StatusCode int `json:",omitempty"`
+	// Error is the error the operation failed with
+	Error string `json:",omitempty"`
+	// OutputSize is the size of all output the operation wrote
+	OutputSize int64
+	// Truncated is set if the oldest output of the operation was dropped
+	Truncated bool `json:",omitempty"`
+}
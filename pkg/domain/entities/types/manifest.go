@@ -8,6 +8,8 @@ type ManifestPushReport struct {
 	Stream string `json:"stream,omitempty"`
 	// Error contains text of errors from pushing
 	Error string `json:"error,omitempty"`
+	// Progress is a structured progress event, only set if requested
+	Progress *ProgressEvent `json:"progress,omitempty"`
 }
 
 // swagger:model
@@ -31,6 +31,8 @@ type PlayKubeReport struct {
 	ServiceContainerID string
 	// If set, exit with the specified exit code.
 	ExitCode *int32
+	// JobID - ID of the job of an asynchronous play.
+	JobID string `json:",omitempty"`
 }
 
 type KubePlayReport = PlayKubeReport
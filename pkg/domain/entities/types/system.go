@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/containers/podman/v5/libpod/define"
@@ -9,10 +10,11 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type ServiceOptions struct {
-	CorsHeaders string        // Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr   string        // Network address to bind pprof profiles service
-	Timeout     time.Duration // Duration of inactivity the service should wait before shutting down
-	URI         string        // Path to unix domain socket service should listen on
+	CorsHeaders  string        // Cross-Origin Resource Sharing (CORS) headers
+	JobRetention time.Duration // Duration finished asynchronous jobs are kept, zero keeps them until removed
+	PProfAddr    string        // Network address to bind pprof profiles service
+	Timeout      time.Duration // Duration of inactivity the service should wait before shutting down
+	URI          string        // Path to unix domain socket service should listen on
 }
 
 // SystemCheckOptions provides options for checking storage consistency.
@@ -61,6 +63,25 @@ type SystemMigrateOptions struct {
 	NewRuntime string
 }
 
+// SystemStorageMigrateOptions describes the options to migrate the
+// storage to another graph driver
+type SystemStorageMigrateOptions struct {
+	Driver string // graph driver to migrate to
+	DryRun bool   // only estimate what would be migrated
+}
+
+// SystemStorageMigrateReport describes what was migrated to the new
+// graph driver, or what would be migrated for a dry run
+type SystemStorageMigrateReport struct {
+	From       string // graph driver migrated from
+	To         string // graph driver migrated to
+	Images     int    // number of images
+	Layers     int    // number of image layers
+	Containers int    // number of containers
+	Size       int64  // size of the image layers and container writable layers
+	Resumed    bool   // an interrupted migration was resumed
+}
+
 // SystemDfOptions describes the options for getting df information
 type SystemDfOptions struct {
 	Format  string
@@ -139,3 +160,28 @@ type LocksReport struct {
 	LockConflicts map[uint32][]string
 	LocksHeld     []uint32
 }
+
+// BatchOperation is a request to the libpod API which is run as part of a
+// batch.
+// swagger:model
+type BatchOperation struct {
+	// Method of the request, POST if empty
+	Method string `json:"method,omitempty"`
+	// Path of the request below /libpod including the query, e.g.
+	// /containers/ctr/stop?timeout=5
+	Path string `json:"path"`
+	// Body of the request, if any
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchResult is the response to a BatchOperation.
+type BatchResult struct {
+	// StatusCode of the response, 0 if the operation was skipped
+	StatusCode int `json:"statusCode"`
+	// Body of the response. Responses which are not JSON are returned as
+	// a string.
+	Body json.RawMessage `json:"body,omitempty"`
+	// Skipped is true if the operation was not run because an earlier
+	// operation failed
+	Skipped bool `json:"skipped,omitempty"`
+}
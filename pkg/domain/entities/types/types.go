@@ -68,6 +68,9 @@ type BuildOptions struct {
 	// so need to pass this to the main build functions
 	LogFileToClose *os.File
 	TmpDirToClose  string
+	// Async starts the build as a job of the service and returns right
+	// away.  Only supported for remote calls.
+	Async bool
 }
 
 // BuildReport is the image-build report.
@@ -76,4 +79,6 @@ type BuildReport struct {
 	ID string
 	// Format to save the image in
 	SaveFormat string
+	// JobID is the ID of the job of an asynchronous build.
+	JobID string
 }
@@ -12,6 +12,7 @@ type VolumeCreateOptions = types.VolumeCreateOptions
 type VolumeConfigResponse = types.VolumeConfigResponse
 
 type VolumeRmOptions struct {
+	BulkOptions
 	All     bool
 	Force   bool
 	Ignore  bool
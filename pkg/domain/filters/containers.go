@@ -5,11 +5,13 @@ package filters
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/filters"
 	"github.com/containers/common/pkg/util"
 	"github.com/containers/podman/v5/libpod"
@@ -286,6 +288,75 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 	return nil, fmt.Errorf("%s is an invalid filter", filter)
 }
 
+// GenerateContainerQuery returns a query for the parts of the filters that the
+// database can evaluate while loading containers. Filters which cannot be
+// expressed as a query, like regular expressions, are left out, so the filter
+// functions of GenerateContainerFilterFuncs must still be applied to the
+// returned containers. The status and exit code filters are never part of
+// the query: the state stored in the database may be outdated until it is
+// synced with the OCI runtime when the filter functions run.
+func GenerateContainerQuery(filterMap map[string][]string, r *libpod.Runtime) (*libpod.ContainerQuery, error) {
+	query := new(libpod.ContainerQuery)
+	for filter, filterValues := range filterMap {
+		if len(filterValues) == 0 {
+			continue
+		}
+		switch filter {
+		case "id":
+			// IDs which are not hex are regular expressions.
+			if !slices.ContainsFunc(filterValues, types.NotHexRegex.MatchString) {
+				for _, filterValue := range filterValues {
+					query.IDPrefixes = append(query.IDPrefixes, strings.ToLower(filterValue))
+				}
+			}
+		case "label":
+			for _, filterValue := range filterValues {
+				key, value, _ := strings.Cut(filterValue, "=")
+				// Keys containing a "*" are patterns.
+				if !strings.Contains(key, "*") {
+					query.Labels = append(query.Labels, libpod.ContainerQueryLabel{Key: key, Value: value})
+				}
+			}
+		case "name":
+			names := make([]string, 0, len(filterValues))
+			for _, filterValue := range filterValues {
+				names = append(names, strings.ReplaceAll(filterValue, "/", ""))
+			}
+			if isLiteral(names) {
+				query.NameSubstrings = names
+			}
+		case "ancestor":
+			if isLiteral(filterValues) {
+				query.Images = filterValues
+			}
+		case "pod":
+			query.PodIDs = []string{}
+			for _, podNameOrID := range filterValues {
+				p, err := r.LookupPod(podNameOrID)
+				if err != nil {
+					if errors.Is(err, define.ErrNoSuchPod) {
+						continue
+					}
+					return nil, err
+				}
+				query.PodIDs = append(query.PodIDs, p.ID())
+			}
+		}
+	}
+	return query, nil
+}
+
+// isLiteral returns true if none of the regular expressions contain
+// metacharacters, so that they match their literal value as a substring.
+func isLiteral(expressions []string) bool {
+	for _, expr := range expressions {
+		if regexp.QuoteMeta(expr) != expr {
+			return false
+		}
+	}
+	return true
+}
+
 // GeneratePruneContainerFilterFuncs return ContainerFilter functions based of filter for prune operation
 func GeneratePruneContainerFilterFuncs(filter string, filterValues []string, r *libpod.Runtime) (func(container *libpod.Container) bool, error) {
 	switch filter {
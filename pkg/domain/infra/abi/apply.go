@@ -16,10 +16,14 @@ import (
 
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	k8sAPI "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"sigs.k8s.io/yaml"
 )
 
-func (ic *ContainerEngine) KubeApply(ctx context.Context, body io.Reader, options entities.ApplyOptions) error {
+func (ic *ContainerEngine) KubeApply(ctx context.Context, body io.Reader, options entities.ApplyOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.KubeApply")
+	defer span.EndWithError(&retErr)
+
 	// Read the yaml file
 	content, err := io.ReadAll(body)
 	if err != nil {
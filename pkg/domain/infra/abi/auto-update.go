@@ -7,8 +7,12 @@ import (
 
 	"github.com/containers/podman/v5/pkg/autoupdate"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
 func (ic *ContainerEngine) AutoUpdate(ctx context.Context, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.AutoUpdate")
+	defer span.End()
+
 	return autoupdate.AutoUpdate(ctx, ic.Libpod, options)
 }
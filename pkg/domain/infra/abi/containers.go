@@ -17,15 +17,18 @@ import (
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/manifest"
+	encconfig "github.com/containers/ocicrypt/config"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/pkg/checkpoint"
+	"github.com/containers/podman/v5/pkg/checkpoint/crutils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	dfilters "github.com/containers/podman/v5/pkg/domain/filters"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/terminal"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/parallel"
 	parallelctr "github.com/containers/podman/v5/pkg/parallel/ctr"
 	"github.com/containers/podman/v5/pkg/ps"
 	"github.com/containers/podman/v5/pkg/rootless"
@@ -33,6 +36,7 @@ import (
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/specgen/generate"
 	"github.com/containers/podman/v5/pkg/specgenutil"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage"
 	"github.com/containers/storage/types"
@@ -161,7 +165,10 @@ func getContainers(runtime *libpod.Runtime, options getContainersOptions) ([]con
 }
 
 // ContainerExists returns whether the container exists in container storage
-func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerExists")
+	defer span.EndWithError(&retErr)
+
 	_, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		if !errors.Is(err, define.ErrNoSuchCtr) {
@@ -177,7 +184,10 @@ func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string,
 	return &entities.BoolReport{Value: err == nil}, nil
 }
 
-func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []string, options entities.WaitOptions) ([]entities.WaitReport, error) {
+func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []string, options entities.WaitOptions) (_ []entities.WaitReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerWait")
+	defer span.EndWithError(&retErr)
+
 	responses := make([]entities.WaitReport, 0, len(namesOrIds))
 	containers, err := getContainers(ic.Libpod, getContainersOptions{latest: options.Latest, ignore: options.Ignore, names: namesOrIds})
 	if err != nil {
@@ -207,7 +217,10 @@ func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []strin
 	return responses, nil
 }
 
-func (ic *ContainerEngine) ContainerPause(ctx context.Context, namesOrIds []string, options entities.PauseUnPauseOptions) ([]*entities.PauseUnpauseReport, error) {
+func (ic *ContainerEngine) ContainerPause(ctx context.Context, namesOrIds []string, options entities.PauseUnPauseOptions) (_ []*entities.PauseUnpauseReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerPause")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds, filters: options.Filters})
 	if err != nil {
 		return nil, err
@@ -228,7 +241,10 @@ func (ic *ContainerEngine) ContainerPause(ctx context.Context, namesOrIds []stri
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []string, options entities.PauseUnPauseOptions) ([]*entities.PauseUnpauseReport, error) {
+func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []string, options entities.PauseUnPauseOptions) (_ []*entities.PauseUnpauseReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerUnpause")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds, filters: options.Filters})
 	if err != nil {
 		return nil, err
@@ -248,7 +264,10 @@ func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []st
 	}
 	return reports, nil
 }
-func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []string, options entities.StopOptions) ([]*entities.StopReport, error) {
+func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []string, options entities.StopOptions) (_ []*entities.StopReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerStop")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod,
 		getContainersOptions{
 			all:     options.All,
@@ -271,7 +290,7 @@ func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []strin
 		libpodContainers = append(libpodContainers, containers[i].Container)
 	}
 
-	errMap, err := parallelctr.ContainerOp(ctx, libpodContainers, func(c *libpod.Container) error {
+	errMap, err := parallelctr.ContainerOp(ctx, libpodContainers, options.BulkOptions, func(c *libpod.Container) error {
 		var err error
 		if options.Timeout != nil {
 			err = c.StopWithTimeout(*options.Timeout)
@@ -328,17 +347,20 @@ func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []strin
 		return nil, err
 	}
 	reports := make([]*entities.StopReport, 0, len(errMap))
-	for ctr, err := range errMap {
+	for _, ctr := range libpodContainers {
 		report := new(entities.StopReport)
 		report.Id = ctr.ID()
 		report.RawInput = idToRawInput[ctr.ID()]
-		report.Err = err
+		report.Err = errMap[ctr]
 		reports = append(reports, report)
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerPrune(ctx context.Context, options entities.ContainerPruneOptions) ([]*reports.PruneReport, error) {
+func (ic *ContainerEngine) ContainerPrune(ctx context.Context, options entities.ContainerPruneOptions) (_ []*reports.PruneReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerPrune")
+	defer span.EndWithError(&retErr)
+
 	filterFuncs := make([]libpod.ContainerFilter, 0, len(options.Filters))
 	for k, v := range options.Filters {
 		generatedFunc, err := dfilters.GeneratePruneContainerFilterFuncs(k, v, ic.Libpod)
@@ -351,7 +373,10 @@ func (ic *ContainerEngine) ContainerPrune(ctx context.Context, options entities.
 	return ic.Libpod.PruneContainers(filterFuncs)
 }
 
-func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []string, options entities.KillOptions) ([]*entities.KillReport, error) {
+func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []string, options entities.KillOptions) (_ []*entities.KillReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerKill")
+	defer span.EndWithError(&retErr)
+
 	sig, err := signal.ParseSignalNameOrNumber(options.Signal)
 	if err != nil {
 		return nil, err
@@ -361,23 +386,36 @@ func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []strin
 		return nil, err
 	}
 
-	reports := make([]*entities.KillReport, 0, len(containers))
-	for _, con := range containers {
+	// Containers which are not running are left out with --all.
+	skip := make([]bool, len(containers))
+	errs := parallel.Run(ctx, len(containers), options.BulkOptions.SerialByDefault(), func(i int) error {
+		con := containers[i]
 		err := con.Kill(uint(sig))
 		if options.All && errors.Is(err, define.ErrCtrStateInvalid) {
 			logrus.Debugf("Container %s is not running", con.ID())
+			skip[i] = true
+			return nil
+		}
+		return err
+	})
+	reports := make([]*entities.KillReport, 0, len(containers))
+	for i, con := range containers {
+		if skip[i] {
 			continue
 		}
 		reports = append(reports, &entities.KillReport{
 			Id:       con.ID(),
-			Err:      err,
+			Err:      errs[i],
 			RawInput: con.rawInput,
 		})
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerRestart(ctx context.Context, namesOrIds []string, options entities.RestartOptions) ([]*entities.RestartReport, error) {
+func (ic *ContainerEngine) ContainerRestart(ctx context.Context, namesOrIds []string, options entities.RestartOptions) (_ []*entities.RestartReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRestart")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{
 		all:     options.All,
 		filters: options.Filters,
@@ -389,15 +427,19 @@ func (ic *ContainerEngine) ContainerRestart(ctx context.Context, namesOrIds []st
 		return nil, err
 	}
 
-	reports := make([]*entities.RestartReport, 0, len(containers))
-	for _, c := range containers {
+	errs := parallel.Run(ctx, len(containers), options.BulkOptions.SerialByDefault(), func(i int) error {
+		c := containers[i]
 		timeout := c.StopTimeout()
 		if options.Timeout != nil {
 			timeout = *options.Timeout
 		}
+		return c.RestartWithTimeout(ctx, timeout)
+	})
+	reports := make([]*entities.RestartReport, 0, len(containers))
+	for i, c := range containers {
 		reports = append(reports, &entities.RestartReport{
 			Id:       c.ID(),
-			Err:      c.RestartWithTimeout(ctx, timeout),
+			Err:      errs[i],
 			RawInput: c.rawInput,
 		})
 	}
@@ -425,7 +467,10 @@ func (ic *ContainerEngine) removeContainer(ctx context.Context, ctr *libpod.Cont
 	return ctrs, pods, err
 }
 
-func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string, options entities.RmOptions) ([]*reports.RmReport, error) {
+func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string, options entities.RmOptions) (_ []*reports.RmReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRm")
+	defer span.EndWithError(&retErr)
+
 	rmReports := []*reports.RmReport{}
 
 	containers, err := getContainers(ic.Libpod, getContainersOptions{
@@ -464,7 +509,7 @@ func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string,
 	ctrsMap := make(map[string]error)
 	mapMutex := sync.Mutex{}
 
-	errMap, err := parallelctr.ContainerOp(ctx, libpodContainers, func(c *libpod.Container) error {
+	errMap, err := parallelctr.ContainerOp(ctx, libpodContainers, options.BulkOptions, func(c *libpod.Container) error {
 		mapMutex.Lock()
 		if _, ok := ctrsMap[c.ID()]; ok {
 			mapMutex.Unlock()
@@ -494,7 +539,19 @@ func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string,
 		ctrsMap[ctr.ID()] = err
 	}
 
-	for ctr, err := range ctrsMap {
+	// Report the containers in the order they were given, followed by
+	// their removed dependencies.
+	ids := make([]string, 0, len(ctrsMap))
+	for _, ctr := range libpodContainers {
+		ids = append(ids, ctr.ID())
+	}
+	for ctr := range ctrsMap {
+		if _, ok := idToRawInput[ctr]; !ok {
+			ids = append(ids, ctr)
+		}
+	}
+	for _, ctr := range ids {
+		err := ctrsMap[ctr]
 		report := new(reports.RmReport)
 		report.Id = ctr
 		if !(errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved)) {
@@ -507,7 +564,10 @@ func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string,
 	return rmReports, nil
 }
 
-func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []string, options entities.InspectOptions) ([]*entities.ContainerInspectReport, []error, error) {
+func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []string, options entities.InspectOptions) (_ []*entities.ContainerInspectReport, _ []error, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerInspect")
+	defer span.EndWithError(&retErr)
+
 	if options.Latest {
 		ctr, err := ic.Libpod.GetLatestContainer()
 		if err != nil {
@@ -560,7 +620,10 @@ func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []st
 	return reports, errs, nil
 }
 
-func (ic *ContainerEngine) ContainerTop(ctx context.Context, options entities.TopOptions) (*entities.StringSliceReport, error) {
+func (ic *ContainerEngine) ContainerTop(ctx context.Context, options entities.TopOptions) (_ *entities.StringSliceReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerTop")
+	defer span.EndWithError(&retErr)
+
 	var (
 		container *libpod.Container
 		err       error
@@ -582,7 +645,10 @@ func (ic *ContainerEngine) ContainerTop(ctx context.Context, options entities.To
 	return report, err
 }
 
-func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string, options entities.CommitOptions) (*entities.CommitReport, error) {
+func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string, options entities.CommitOptions) (_ *entities.CommitReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerCommit")
+	defer span.EndWithError(&retErr)
+
 	var (
 		mimeType string
 	)
@@ -640,7 +706,10 @@ func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string,
 	return &entities.CommitReport{Id: newImage.ID()}, nil
 }
 
-func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string, options entities.ContainerExportOptions) error {
+func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string, options entities.ContainerExportOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerExport")
+	defer span.EndWithError(&retErr)
+
 	ctr, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return err
@@ -648,7 +717,10 @@ func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string,
 	return ctr.Export(options.Output)
 }
 
-func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, options entities.CheckpointOptions) ([]*entities.CheckpointReport, error) {
+func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, options entities.CheckpointOptions) (_ []*entities.CheckpointReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerCheckpoint")
+	defer span.EndWithError(&retErr)
+
 	checkOpts := libpod.ContainerCheckpointOptions{
 		Keep:           options.Keep,
 		TCPEstablished: options.TCPEstablished,
@@ -662,6 +734,14 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		PrintStats:     options.PrintStats,
 		FileLocks:      options.FileLocks,
 		CreateImage:    options.CreateImage,
+		EncryptConfig:  options.OciEncryptConfig,
+	}
+	if options.EncryptionSecret != "" {
+		passphrase, err := ic.secretPassphrase(options.EncryptionSecret)
+		if err != nil {
+			return nil, err
+		}
+		checkOpts.EncryptConfig = crutils.CREncryptConfigWithPassphrase(checkOpts.EncryptConfig, passphrase)
 	}
 	// NOTE: all maps to running
 	containers, err := getContainers(ic.Libpod, getContainersOptions{running: options.All, latest: options.Latest, names: namesOrIds})
@@ -683,7 +763,10 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, options entities.RestoreOptions) ([]*entities.RestoreReport, error) {
+func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, options entities.RestoreOptions) (_ []*entities.RestoreReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRestore")
+	defer span.EndWithError(&retErr)
+
 	var (
 		ctrs                        []*libpod.Container
 		checkpointImageImportErrors []error
@@ -712,9 +795,29 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 		},
 	}
 
+	decryptConfig := options.OciDecryptConfig
+	if options.DecryptionSecret != "" {
+		passphrase, err := ic.secretPassphrase(options.DecryptionSecret)
+		if err != nil {
+			return nil, err
+		}
+		decryptConfig = crutils.CRDecryptConfigWithPassphrase(decryptConfig, passphrase)
+	}
+
 	idToRawInput := map[string]string{}
 	switch {
 	case options.Import != "":
+		var (
+			plainImport string
+			cleanup     func()
+		)
+		plainImport, cleanup, err = decryptCheckpoint(options.Import, decryptConfig)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		options.Import = plainImport
+		restoreOptions.TargetFile = plainImport
 		ctrs, err = checkpoint.CRImportCheckpointTar(ctx, ic.Libpod, options)
 	case options.All:
 		ctrs, err = ic.Libpod.GetContainers(false, filterFuncs...)
@@ -751,7 +854,23 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 				if err != nil {
 					return nil, err
 				}
-				importedCtrs, err := checkpoint.CRImportCheckpoint(ctx, ic.Libpod, options, mountPoint)
+				plainImport, cleanup, err := decryptCheckpoint(mountPoint, decryptConfig)
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				var importedCtrs []*libpod.Container
+				if plainImport != mountPoint {
+					// Encrypted checkpoint images are restored like an
+					// exported checkpoint archive.
+					importOptions := options
+					importOptions.Import = plainImport
+					restoreOptions.TargetFile = plainImport
+					restoreOptions.CheckpointImageID = ""
+					importedCtrs, err = checkpoint.CRImportCheckpointTar(ctx, ic.Libpod, importOptions)
+				} else {
+					importedCtrs, err = checkpoint.CRImportCheckpoint(ctx, ic.Libpod, options, mountPoint)
+				}
 				if err != nil {
 					// CRImportCheckpoint is expected to import exactly one container from checkpoint image
 					checkpointImageImportErrors = append(
@@ -789,7 +908,57 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerCreate(ctx context.Context, s *specgen.SpecGenerator) (*entities.ContainerCreateReport, error) {
+// secretPassphrase returns the content of the secret nameOrID to be used as a
+// passphrase.
+func (ic *ContainerEngine) secretPassphrase(nameOrID string) ([]byte, error) {
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	_, data, err := manager.LookupSecretData(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// decryptCheckpoint returns the path of the plain checkpoint archive of the
+// checkpoint archive or mounted checkpoint image at path.  Unless the
+// checkpoint is encrypted, path is returned as is.  Otherwise, it is
+// decrypted into a temporary file which is removed by the returned function.
+func decryptCheckpoint(path string, dc *encconfig.DecryptConfig) (string, func(), error) {
+	encrypted, err := crutils.CRIsEncryptedCheckpoint(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if !encrypted {
+		return path, func() {}, nil
+	}
+
+	plainFile, err := os.CreateTemp("", "checkpoint_decrypt_")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		if err := os.Remove(plainFile.Name()); err != nil {
+			logrus.Errorf("Could not remove %s: %v", plainFile.Name(), err)
+		}
+	}
+	err = crutils.CRDecryptCheckpoint(path, plainFile, dc)
+	if closeErr := plainFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return plainFile.Name(), cleanup, nil
+}
+
+func (ic *ContainerEngine) ContainerCreate(ctx context.Context, s *specgen.SpecGenerator) (_ *entities.ContainerCreateReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerCreate")
+	defer span.EndWithError(&retErr)
+
 	warn, err := generate.CompleteSpec(ctx, ic.Libpod, s)
 	if err != nil {
 		return nil, err
@@ -809,7 +978,10 @@ func (ic *ContainerEngine) ContainerCreate(ctx context.Context, s *specgen.SpecG
 	return &entities.ContainerCreateReport{Id: ctr.ID()}, nil
 }
 
-func (ic *ContainerEngine) ContainerAttach(ctx context.Context, nameOrID string, options entities.AttachOptions) error {
+func (ic *ContainerEngine) ContainerAttach(ctx context.Context, nameOrID string, options entities.AttachOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerAttach")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{latest: options.Latest, names: []string{nameOrID}})
 	if err != nil {
 		return err
@@ -841,6 +1013,7 @@ func makeExecConfig(options entities.ExecOptions, rt *libpod.Runtime) (*libpod.E
 	execConfig.PreserveFDs = options.PreserveFDs
 	execConfig.PreserveFD = options.PreserveFD
 	execConfig.AttachStdin = options.Interactive
+	execConfig.RecordFile = options.RecordFile
 
 	// Make an exit command
 	storageConfig := rt.StorageConfig()
@@ -883,7 +1056,10 @@ func checkExecPreserveFDs(options entities.ExecOptions) error {
 	return nil
 }
 
-func (ic *ContainerEngine) ContainerExec(ctx context.Context, nameOrID string, options entities.ExecOptions, streams define.AttachStreams) (int, error) {
+func (ic *ContainerEngine) ContainerExec(ctx context.Context, nameOrID string, options entities.ExecOptions, streams define.AttachStreams) (_ int, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerExec")
+	defer span.EndWithError(&retErr)
+
 	ec := define.ExecErrorCodeGeneric
 	err := checkExecPreserveFDs(options)
 	if err != nil {
@@ -912,7 +1088,10 @@ func (ic *ContainerEngine) ContainerExec(ctx context.Context, nameOrID string, o
 	return define.TranslateExecErrorToExitCode(ec, err), err
 }
 
-func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID string, options entities.ExecOptions) (string, error) {
+func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID string, options entities.ExecOptions) (_ string, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerExecDetached")
+	defer span.EndWithError(&retErr)
+
 	err := checkExecPreserveFDs(options)
 	if err != nil {
 		return "", err
@@ -946,7 +1125,10 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return id, nil
 }
 
-func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []string, options entities.ContainerStartOptions) ([]*entities.ContainerStartReport, error) {
+func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []string, options entities.ContainerStartOptions) (_ []*entities.ContainerStartReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerStart")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.ContainerStartReport{}
 	var exitCode = define.ExecErrorCodeGeneric
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds, filters: options.Filters})
@@ -1009,8 +1191,12 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 			})
 			return reports, nil
 		} // end attach
+	}
 
-		// Handle non-attach start
+	// Handle non-attach start
+	startReports := make([]*entities.ContainerStartReport, len(containers))
+	errs := parallel.Run(ctx, len(containers), options.BulkOptions.SerialByDefault(), func(i int) error {
+		ctr := containers[i]
 		// If the container is in a pod, also set to recursively start dependencies
 		report := &entities.ContainerStartReport{
 			Id:       ctr.ID(),
@@ -1024,16 +1210,15 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 				// so do not include the entry in the result.
 				if !options.All {
 					report.ExitCode = 0
-					reports = append(reports, report)
+					startReports[i] = report
 				}
-				continue
+				return nil
 			}
 
-			report.Err = err
 			if errors.Is(err, define.ErrWillDeadlock) {
 				report.Err = fmt.Errorf("please run 'podman system renumber' to resolve deadlocks: %w", err)
-				reports = append(reports, report)
-				continue
+				startReports[i] = report
+				return report.Err
 			}
 			report.Err = fmt.Errorf("unable to start container %q: %w", ctr.ID(), err)
 			if ctr.AutoRemove() {
@@ -1041,29 +1226,55 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 					logrus.Errorf("Removing container %s: %v", ctr.ID(), err)
 				}
 			}
-			reports = append(reports, report)
-			continue
+			startReports[i] = report
+			return report.Err
 		}
 		// no error set exit code to 0
 		report.ExitCode = 0
+		startReports[i] = report
+		return nil
+	})
+	for i, report := range startReports {
+		if report == nil {
+			// Containers which were already running with --all are
+			// not reported, unlike skipped ones.
+			if errs[i] == nil {
+				continue
+			}
+			report = &entities.ContainerStartReport{
+				Id:       containers[i].ID(),
+				RawInput: containers[i].rawInput,
+				Err:      errs[i],
+				ExitCode: 125,
+			}
+		}
 		reports = append(reports, report)
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerList(ctx context.Context, options entities.ContainerListOptions) ([]entities.ListContainer, error) {
+func (ic *ContainerEngine) ContainerList(ctx context.Context, options entities.ContainerListOptions) (_ []entities.ListContainer, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerList")
+	defer span.EndWithError(&retErr)
+
 	if options.Latest {
 		options.Last = 1
 	}
 	return ps.GetContainerLists(ic.Libpod, options)
 }
 
-func (ic *ContainerEngine) ContainerListExternal(ctx context.Context) ([]entities.ListContainer, error) {
+func (ic *ContainerEngine) ContainerListExternal(ctx context.Context) (_ []entities.ListContainer, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerListExternal")
+	defer span.EndWithError(&retErr)
+
 	return ps.GetExternalContainerLists(ic.Libpod)
 }
 
 // Diff provides changes to given container
-func (ic *ContainerEngine) Diff(ctx context.Context, namesOrIDs []string, opts entities.DiffOptions) (*entities.DiffReport, error) {
+func (ic *ContainerEngine) Diff(ctx context.Context, namesOrIDs []string, opts entities.DiffOptions) (_ *entities.DiffReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Diff")
+	defer span.EndWithError(&retErr)
+
 	var (
 		base   string
 		parent string
@@ -1081,11 +1292,55 @@ func (ic *ContainerEngine) Diff(ctx context.Context, namesOrIDs []string, opts e
 			parent = namesOrIDs[1]
 		}
 	}
+	if opts.Content || opts.Export != "" {
+		return ic.containerContentDiff(base, opts)
+	}
 	changes, err := ic.Libpod.GetDiff(parent, base, opts.Type)
 	return &entities.DiffReport{Changes: changes}, err
 }
 
-func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.ContainerRunOptions) (*entities.ContainerRunReport, error) {
+// containerContentDiff compares the files of a container with its image.
+func (ic *ContainerEngine) containerContentDiff(nameOrID string, opts entities.DiffOptions) (*entities.DiffReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("--content and --export require a container: %w", err)
+	}
+
+	if opts.Export != "" {
+		f, err := os.Create(opts.Export)
+		if err != nil {
+			return nil, err
+		}
+		if err := ic.Libpod.ExportDiff(ctr, opts.Paths, f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	report := new(entities.DiffReport)
+	if opts.Content {
+		report.Files, err = ic.Libpod.GetDiffContent(ctr, opts.Paths)
+		return report, err
+	}
+	changes, err := ic.Libpod.GetDiff("", ctr.ID(), define.DiffContainer)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		if libpod.MatchesDiffPaths(change.Path, opts.Paths) {
+			report.Changes = append(report.Changes, change)
+		}
+	}
+	return report, nil
+}
+
+func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.ContainerRunOptions) (_ *entities.ContainerRunReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRun")
+	defer span.EndWithError(&retErr)
+
 	removeContainer := func(ctr *libpod.Container, force bool) error {
 		var timeout *uint
 		if err := ic.Libpod.RemoveContainer(ctx, ctr, force, true, timeout); err != nil {
@@ -1179,6 +1434,7 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 			}
 			return &report, err
 		}
+		report.Timing = containerRunTiming(ctr)
 
 		return &report, nil
 	}
@@ -1190,6 +1446,7 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 		// Just exit immediately
 		if errors.Is(err, define.ErrDetach) {
 			report.ExitCode = 0
+			report.Timing = containerRunTiming(ctr)
 			return &report, nil
 		}
 		if opts.Rm {
@@ -1204,6 +1461,7 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 		return &report, err
 	}
 	report.ExitCode, _ = ic.ContainerWaitForExitCode(ctx, ctr)
+	report.Timing = containerRunTiming(ctr)
 	if opts.Rm && !ctr.ShouldRestart(ctx) {
 		if err := removeContainer(ctr, false); err != nil {
 			if errors.Is(err, define.ErrNoSuchCtr) ||
@@ -1217,7 +1475,20 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 	return &report, nil
 }
 
-func (ic *ContainerEngine) ContainerWaitForExitCode(ctx context.Context, ctr *libpod.Container) (int, error) {
+// containerRunTiming returns the timing spans of starting the container.
+// Failing to get them must not fail the run.
+func containerRunTiming(ctr *libpod.Container) []define.TimingSpan {
+	timing, err := ctr.Timing()
+	if err != nil {
+		logrus.Debugf("Getting timing of container %s: %v", ctr.ID(), err)
+	}
+	return timing
+}
+
+func (ic *ContainerEngine) ContainerWaitForExitCode(ctx context.Context, ctr *libpod.Container) (_ int, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerWaitForExitCode")
+	defer span.EndWithError(&retErr)
+
 	exitCode, err := ctr.Wait(ctx)
 	if err != nil {
 		intExitCode := int(define.ExecErrorCodeNotFound)
@@ -1226,7 +1497,10 @@ func (ic *ContainerEngine) ContainerWaitForExitCode(ctx context.Context, ctr *li
 	return int(exitCode), nil
 }
 
-func (ic *ContainerEngine) ContainerLogs(ctx context.Context, namesOrIds []string, options entities.ContainerLogsOptions) error {
+func (ic *ContainerEngine) ContainerLogs(ctx context.Context, namesOrIds []string, options entities.ContainerLogsOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerLogs")
+	defer span.EndWithError(&retErr)
+
 	if options.StdoutWriter == nil && options.StderrWriter == nil {
 		return errors.New("no io.Writer set for container logs")
 	}
@@ -1287,7 +1561,10 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, namesOrIds []strin
 	return nil
 }
 
-func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []string, options entities.ContainerCleanupOptions) ([]*entities.ContainerCleanupReport, error) {
+func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []string, options entities.ContainerCleanupOptions) (_ []*entities.ContainerCleanupReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerCleanup")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
 	if err != nil {
 		// cleanup command spawned by conmon lost race as another process already removed the ctr
@@ -1340,7 +1617,10 @@ func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []st
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []string, options entities.ContainerInitOptions) ([]*entities.ContainerInitReport, error) {
+func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []string, options entities.ContainerInitOptions) (_ []*entities.ContainerInitReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerInit")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
 	if err != nil {
 		return nil, err
@@ -1360,7 +1640,10 @@ func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []strin
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerMount(ctx context.Context, nameOrIDs []string, options entities.ContainerMountOptions) ([]*entities.ContainerMountReport, error) {
+func (ic *ContainerEngine) ContainerMount(ctx context.Context, nameOrIDs []string, options entities.ContainerMountOptions) (_ []*entities.ContainerMountReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerMount")
+	defer span.EndWithError(&retErr)
+
 	if os.Geteuid() != 0 {
 		if driver := ic.Libpod.StorageConfig().GraphDriverName; driver != "vfs" {
 			// Do not allow to mount a graphdriver that is not vfs if we are creating the userns as part
@@ -1465,7 +1748,10 @@ func (ic *ContainerEngine) ContainerMount(ctx context.Context, nameOrIDs []strin
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerUnmount(ctx context.Context, nameOrIDs []string, options entities.ContainerUnmountOptions) ([]*entities.ContainerUnmountReport, error) {
+func (ic *ContainerEngine) ContainerUnmount(ctx context.Context, nameOrIDs []string, options entities.ContainerUnmountOptions) (_ []*entities.ContainerUnmountReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerUnmount")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.ContainerUnmountReport{}
 	names := []string{}
 	if options.All {
@@ -1528,7 +1814,10 @@ func (ic *ContainerEngine) Config(_ context.Context) (*config.Config, error) {
 	return ic.Libpod.GetConfig()
 }
 
-func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, options entities.ContainerPortOptions) ([]*entities.ContainerPortReport, error) {
+func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, options entities.ContainerPortOptions) (_ []*entities.ContainerPortReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerPort")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: []string{nameOrID}})
 	if err != nil {
 		return nil, err
@@ -1564,6 +1853,9 @@ func (ic *ContainerEngine) Shutdown(_ context.Context) {
 }
 
 func (ic *ContainerEngine) ContainerStats(ctx context.Context, namesOrIds []string, options entities.ContainerStatsOptions) (statsChan chan entities.ContainerStatsReport, err error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerStats")
+	defer span.EndWithError(&err)
+
 	if options.Interval < 1 {
 		return nil, errors.New("invalid interval, must be a positive number greater zero")
 	}
@@ -1661,7 +1953,10 @@ func (ic *ContainerEngine) ContainerStats(ctx context.Context, namesOrIds []stri
 }
 
 // ShouldRestart returns whether the container should be restarted
-func (ic *ContainerEngine) ShouldRestart(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) ShouldRestart(ctx context.Context, nameOrID string) (_ *entities.BoolReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ShouldRestart")
+	defer span.EndWithError(&retErr)
+
 	ctr, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return nil, err
@@ -1671,7 +1966,10 @@ func (ic *ContainerEngine) ShouldRestart(ctx context.Context, nameOrID string) (
 }
 
 // ContainerRename renames the given container.
-func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string, opts entities.ContainerRenameOptions) error {
+func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string, opts entities.ContainerRenameOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRename")
+	defer span.EndWithError(&retErr)
+
 	ctr, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return err
@@ -1684,7 +1982,10 @@ func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string,
 	return nil
 }
 
-func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts entities.ContainerCloneOptions) (*entities.ContainerCreateReport, error) {
+func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts entities.ContainerCloneOptions) (_ *entities.ContainerCreateReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerClone")
+	defer span.EndWithError(&retErr)
+
 	spec := specgen.NewSpecGenerator(ctrCloneOpts.Image, ctrCloneOpts.CreateOpts.RootFS)
 	var c *libpod.Container
 	c, _, err := generate.ConfigToSpec(ic.Libpod, spec, ctrCloneOpts.ID)
@@ -1790,7 +2091,10 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 }
 
 // ContainerUpdate finds and updates the given container's cgroup config with the specified options
-func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
+func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (_ string, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.ContainerUpdate")
+	defer span.EndWithError(&retErr)
+
 	err := specgen.WeightDevices(updateOptions.Specgen)
 	if err != nil {
 		return "", err
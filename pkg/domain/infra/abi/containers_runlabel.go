@@ -16,13 +16,17 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	envLib "github.com/containers/podman/v5/pkg/env"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/utils"
 	"github.com/containers/storage/pkg/fileutils"
 	"github.com/google/shlex"
 	"github.com/sirupsen/logrus"
 )
 
-func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string, imageRef string, args []string, options entities.ContainerRunlabelOptions) error {
+func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string, imageRef string, args []string, options entities.ContainerRunlabelOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerRunlabel")
+	defer span.EndWithError(&retErr)
+
 	pullOptions := &libimage.PullOptions{}
 	pullOptions.AuthFilePath = options.Authfile
 	pullOptions.CertDirPath = options.CertDir
@@ -38,7 +42,7 @@ func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string,
 		pullOptions.Writer = os.Stderr
 	}
 
-	pulledImages, err := ic.Libpod.LibimageRuntime().Pull(ctx, imageRef, pullPolicy, pullOptions)
+	pulledImages, err := ic.Libpod.PullImage(ctx, imageRef, pullPolicy, pullOptions)
 	if err != nil {
 		return err
 	}
@@ -6,9 +6,13 @@ import (
 	"context"
 
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, containerPath string) (*entities.ContainerStatReport, error) {
+func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, containerPath string) (_ *entities.ContainerStatReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.ContainerStat")
+	defer span.EndWithError(&retErr)
+
 	container, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return nil, err
@@ -7,9 +7,13 @@ import (
 
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ic *ContainerEngine) Events(ctx context.Context, opts entities.EventsOptions) error {
-	readOpts := events.ReadOptions{FromStart: opts.FromStart, Stream: opts.Stream, Filters: opts.Filter, EventChannel: opts.EventChan, Since: opts.Since, Until: opts.Until}
+func (ic *ContainerEngine) Events(ctx context.Context, opts entities.EventsOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.Events")
+	defer span.EndWithError(&retErr)
+
+	readOpts := events.ReadOptions{AfterID: opts.AfterID, FromStart: opts.FromStart, Stream: opts.Stream, Filters: opts.Filter, EventChannel: opts.EventChan, Since: opts.Since, Until: opts.Until}
 	return ic.Libpod.Events(ctx, readOpts)
 }
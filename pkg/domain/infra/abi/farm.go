@@ -11,15 +11,22 @@ import (
 	lplatform "github.com/containers/common/libimage/platform"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/emulation"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
 // FarmNodeName returns the local engine's name.
 func (ir *ImageEngine) FarmNodeName(ctx context.Context) string {
+	_, span := tracing.Start(ctx, "ImageEngine.FarmNodeName")
+	defer span.End()
+
 	return entities.LocalFarmImageBuilderName
 }
 
 // FarmNodeDriver returns a description of the local image builder driver
 func (ir *ImageEngine) FarmNodeDriver(ctx context.Context) string {
+	_, span := tracing.Start(ctx, "ImageEngine.FarmNodeDriver")
+	defer span.End()
+
 	return entities.LocalFarmImageBuilderDriver
 }
 
@@ -44,7 +51,10 @@ func (ir *ImageEngine) fetchInfo(_ context.Context) (os, arch, variant string, n
 }
 
 // FarmNodeInspect returns information about the remote engines in the farm
-func (ir *ImageEngine) FarmNodeInspect(ctx context.Context) (*entities.FarmInspectReport, error) {
+func (ir *ImageEngine) FarmNodeInspect(ctx context.Context) (_ *entities.FarmInspectReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.FarmNodeInspect")
+	defer span.EndWithError(&retErr)
+
 	ir.platforms.Do(func() {
 		ir.os, ir.arch, ir.variant, ir.nativePlatforms, ir.emulatedPlatforms, ir.platformsErr = ir.fetchInfo(ctx)
 	})
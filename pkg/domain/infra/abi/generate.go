@@ -16,10 +16,14 @@ import (
 	"github.com/containers/podman/v5/pkg/specgen"
 	generateUtils "github.com/containers/podman/v5/pkg/specgen/generate"
 	"github.com/containers/podman/v5/pkg/systemd/generate"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"sigs.k8s.io/yaml"
 )
 
-func (ic *ContainerEngine) GenerateSystemd(ctx context.Context, nameOrID string, options entities.GenerateSystemdOptions) (*entities.GenerateSystemdReport, error) {
+func (ic *ContainerEngine) GenerateSystemd(ctx context.Context, nameOrID string, options entities.GenerateSystemdOptions) (_ *entities.GenerateSystemdReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.GenerateSystemd")
+	defer span.EndWithError(&retErr)
+
 	// First assume it's a container.
 	ctr, ctrErr := ic.Libpod.LookupContainer(nameOrID)
 	if ctrErr == nil {
@@ -46,7 +50,10 @@ func (ic *ContainerEngine) GenerateSystemd(ctx context.Context, nameOrID string,
 	return &entities.GenerateSystemdReport{Units: units}, nil
 }
 
-func (ic *ContainerEngine) GenerateSpec(ctx context.Context, opts *entities.GenerateSpecOptions) (*entities.GenerateSpecReport, error) {
+func (ic *ContainerEngine) GenerateSpec(ctx context.Context, opts *entities.GenerateSpecOptions) (_ *entities.GenerateSpecReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.GenerateSpec")
+	defer span.EndWithError(&retErr)
+
 	var spec *specgen.SpecGenerator
 	var pspec *specgen.PodSpecGenerator
 	var err error
@@ -103,7 +110,10 @@ func (ic *ContainerEngine) GenerateSpec(ctx context.Context, opts *entities.Gene
 	return &entities.GenerateSpecReport{Data: j}, nil // regular output
 }
 
-func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string, options entities.GenerateKubeOptions) (*entities.GenerateKubeReport, error) {
+func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string, options entities.GenerateKubeOptions) (_ *entities.GenerateKubeReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.GenerateKube")
+	defer span.EndWithError(&retErr)
+
 	var (
 		pods        []*libpod.Pod
 		ctrs        []*libpod.Container
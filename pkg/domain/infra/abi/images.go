@@ -36,7 +36,9 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	domainUtils "github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/storage"
 	"github.com/containers/storage/types"
 	"github.com/opencontainers/go-digest"
@@ -54,12 +56,41 @@ func (ir *ImageEngine) Exists(_ context.Context, nameOrID string) (*entities.Boo
 	return &entities.BoolReport{Value: exists}, nil
 }
 
-func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOptions) ([]*reports.PruneReport, error) {
+func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOptions) (_ []*reports.PruneReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Prune")
+	defer span.EndWithError(&retErr)
+
+	// The unused-for filter is not known to libimage and is applied by
+	// excluding all images used within the specified duration.
+	filters := make([]string, 0, len(opts.Filter)+1)
+	var unusedFor time.Duration
+	for _, filter := range opts.Filter {
+		value, found := strings.CutPrefix(filter, "unused-for=")
+		if !found {
+			filters = append(filters, filter)
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unused-for filter %q: %w", value, err)
+		}
+		unusedFor = duration
+	}
+	if unusedFor > 0 {
+		usedIDs, err := ir.imagesUsedSince(ctx, time.Now().Add(-unusedFor))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range usedIDs {
+			filters = append(filters, "id!="+id)
+		}
+	}
+
 	pruneOptions := &libimage.RemoveImagesOptions{
 		RemoveContainerFunc:     ir.Libpod.RemoveContainersForImageCallback(ctx),
 		IsExternalContainerFunc: ir.Libpod.IsExternalContainerCallback(ctx),
 		ExternalContainers:      opts.External,
-		Filters:                 append(opts.Filter, "readonly=false"),
+		Filters:                 append(filters, "readonly=false"),
 		WithSize:                true,
 	}
 
@@ -118,6 +149,37 @@ func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOption
 	return pruneReports, nil
 }
 
+// imagesUsedSince returns the IDs of all images which were used by a
+// container after the specified time.  Images without a recorded use are
+// considered used when they were last pulled or loaded or, if that is not
+// known either, when they were created.
+func (ir *ImageEngine) imagesUsedSince(ctx context.Context, since time.Time) ([]string, error) {
+	images, err := ir.Libpod.LibimageRuntime().ListImages(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, img := range images {
+		lastUsed, err := ir.Libpod.ImageLastUsed(img.ID())
+		if err != nil {
+			return nil, fmt.Errorf("retrieving last use of image %q: %w", img.ID(), err)
+		}
+		if lastUsed.IsZero() {
+			lastUsed, err = ir.Libpod.ImageLastPulled(img.ID())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving last pull of image %q: %w", img.ID(), err)
+			}
+		}
+		if lastUsed.IsZero() {
+			lastUsed = img.Created()
+		}
+		if lastUsed.After(since) {
+			ids = append(ids, img.ID())
+		}
+	}
+	return ids, nil
+}
+
 func toDomainHistoryLayer(layer *libimage.ImageHistory) entities.ImageHistoryLayer {
 	l := entities.ImageHistoryLayer{
 		Comment:   layer.Comment,
@@ -132,7 +194,10 @@ func toDomainHistoryLayer(layer *libimage.ImageHistory) entities.ImageHistoryLay
 	return l
 }
 
-func (ir *ImageEngine) History(ctx context.Context, nameOrID string, opts entities.ImageHistoryOptions) (*entities.ImageHistoryReport, error) {
+func (ir *ImageEngine) History(ctx context.Context, nameOrID string, opts entities.ImageHistoryOptions) (_ *entities.ImageHistoryReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.History")
+	defer span.EndWithError(&retErr)
+
 	image, _, err := ir.Libpod.LibimageRuntime().LookupImage(nameOrID, nil)
 	if err != nil {
 		return nil, err
@@ -153,7 +218,10 @@ func (ir *ImageEngine) History(ctx context.Context, nameOrID string, opts entiti
 	return &history, nil
 }
 
-func (ir *ImageEngine) Mount(ctx context.Context, nameOrIDs []string, opts entities.ImageMountOptions) ([]*entities.ImageMountReport, error) {
+func (ir *ImageEngine) Mount(ctx context.Context, nameOrIDs []string, opts entities.ImageMountOptions) (_ []*entities.ImageMountReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Mount")
+	defer span.EndWithError(&retErr)
+
 	listMountsOnly := false
 	var images []*libimage.Image
 	var err error
@@ -232,7 +300,10 @@ func (ir *ImageEngine) Mount(ctx context.Context, nameOrIDs []string, opts entit
 	return mountReports, nil
 }
 
-func (ir *ImageEngine) Unmount(ctx context.Context, nameOrIDs []string, options entities.ImageUnmountOptions) ([]*entities.ImageUnmountReport, error) {
+func (ir *ImageEngine) Unmount(ctx context.Context, nameOrIDs []string, options entities.ImageUnmountOptions) (_ []*entities.ImageUnmountReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Unmount")
+	defer span.EndWithError(&retErr)
+
 	var images []*libimage.Image
 	var err error
 	switch {
@@ -273,7 +344,10 @@ func (ir *ImageEngine) Unmount(ctx context.Context, nameOrIDs []string, options
 	return unmountReports, nil
 }
 
-func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, options entities.ImagePullOptions) (*entities.ImagePullReport, error) {
+func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, options entities.ImagePullOptions) (_ *entities.ImagePullReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Pull")
+	defer span.EndWithError(&retErr)
+
 	pullOptions := &libimage.PullOptions{AllTags: options.AllTags}
 	pullOptions.AuthFilePath = options.Authfile
 	pullOptions.CertDirPath = options.CertDir
@@ -300,20 +374,74 @@ func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, options entiti
 		pullOptions.Writer = os.Stderr
 	}
 
-	pulledImages, err := ir.Libpod.LibimageRuntime().Pull(ctx, rawImage, options.PullPolicy, pullOptions)
-	if err != nil {
-		return nil, err
+	run := runOnce
+	var finishProgress func(*entities.ProgressEvent)
+	if options.ProgressFormat == progress.FormatJSON && pullOptions.Writer != nil {
+		run, finishProgress = jsonProgress(&pullOptions.CopyOptions)
 	}
 
+	var pulledImages []*libimage.Image
+	err := run(ctx, func() error {
+		var err error
+		pulledImages, err = ir.Libpod.PullImage(ctx, rawImage, options.PullPolicy, pullOptions)
+		return err
+	})
+
 	pulledIDs := make([]string, len(pulledImages))
 	for i := range pulledImages {
 		pulledIDs[i] = pulledImages[i].ID()
 	}
+	if finishProgress != nil {
+		result := progress.Result(err)
+		result.Images = pulledIDs
+		finishProgress(result)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	return &entities.ImagePullReport{Images: pulledIDs}, nil
 }
 
-func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts entities.InspectOptions) ([]*entities.ImageInspectReport, []error, error) {
+// jsonProgress replaces the human-readable progress written to the writer
+// of copyOptions with one JSON-encoded ProgressEvent per line.  The retries
+// of copyOptions are taken over from libimage so that they can be reported,
+// hence the operation must be run with the returned run function.  The
+// returned finish function must be called with the final event of the
+// operation, or with nil if no final event should be reported.
+func jsonProgress(copyOptions *libimage.CopyOptions) (run func(context.Context, func() error) error, finish func(*entities.ProgressEvent)) {
+	emit := progress.NewJSONEmitter(copyOptions.Writer)
+	tracker := progress.NewTracker(emit)
+	progressChan, stop := progress.Forward(tracker)
+	copyOptions.Progress = progressChan
+	copyOptions.Writer = nil
+
+	retryOptions := progress.RetryOptions(copyOptions.MaxRetries, copyOptions.RetryDelay)
+	noRetries := uint(0)
+	copyOptions.MaxRetries = &noRetries
+
+	run = func(ctx context.Context, op func() error) error {
+		return tracker.Run(ctx, retryOptions, op)
+	}
+	finish = func(result *entities.ProgressEvent) {
+		stop()
+		if result != nil {
+			emit(result)
+		}
+	}
+	return run, finish
+}
+
+// runOnce runs op.  It is the run function of operations without
+// machine-readable progress, which are retried by libimage.
+func runOnce(_ context.Context, op func() error) error {
+	return op()
+}
+
+func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts entities.InspectOptions) (_ []*entities.ImageInspectReport, _ []error, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Inspect")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.ImageInspectReport{}
 	errs := []error{}
 
@@ -339,7 +467,10 @@ func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts en
 	return reports, errs, nil
 }
 
-func (ir *ImageEngine) Push(ctx context.Context, source string, destination string, options entities.ImagePushOptions) (*entities.ImagePushReport, error) {
+func (ir *ImageEngine) Push(ctx context.Context, source string, destination string, options entities.ImagePushOptions) (_ *entities.ImagePushReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Push")
+	defer span.EndWithError(&retErr)
+
 	var manifestType string
 	switch options.Format {
 	case "":
@@ -412,9 +543,26 @@ func (ir *ImageEngine) Push(ctx context.Context, source string, destination stri
 		pushOptions.Writer = os.Stderr
 	}
 
-	pushedManifestBytes, pushError := ir.Libpod.LibimageRuntime().Push(ctx, source, destination, pushOptions)
+	pushOptions.Progress = options.Progress
+	run := runOnce
+	var finishProgress func(*entities.ProgressEvent)
+	if options.ProgressFormat == progress.FormatJSON && pushOptions.Progress == nil && pushOptions.Writer != nil {
+		run, finishProgress = jsonProgress(&pushOptions.CopyOptions)
+	}
+
+	var pushedManifestBytes []byte
+	pushError := run(ctx, func() error {
+		var err error
+		pushedManifestBytes, err = ir.Libpod.LibimageRuntime().Push(ctx, source, destination, pushOptions)
+		return err
+	})
 	if pushError == nil {
 		manifestDigest, err := manifest.Digest(pushedManifestBytes)
+		if finishProgress != nil {
+			result := progress.Result(err)
+			result.ManifestDigest = manifestDigest.String()
+			finishProgress(result)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -425,16 +573,26 @@ func (ir *ImageEngine) Push(ctx context.Context, source string, destination stri
 	// containers storage. In that case, fall back and attempt to push the
 	// (entire) manifest.
 	if _, err := ir.Libpod.LibimageRuntime().LookupManifestList(source); err == nil {
+		if finishProgress != nil {
+			// The manifest push reports its own progress.
+			finishProgress(nil)
+		}
 		pushedManifestString, err := ir.ManifestPush(ctx, source, destination, options)
 		if err != nil {
 			return nil, err
 		}
 		return &entities.ImagePushReport{ManifestDigest: pushedManifestString}, nil
 	}
+	if finishProgress != nil {
+		finishProgress(progress.Result(pushError))
+	}
 	return nil, pushError
 }
 
-func (ir *ImageEngine) Tag(ctx context.Context, nameOrID string, tags []string, options entities.ImageTagOptions) error {
+func (ir *ImageEngine) Tag(ctx context.Context, nameOrID string, tags []string, options entities.ImageTagOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.Tag")
+	defer span.EndWithError(&retErr)
+
 	// Allow tagging manifest list instead of resolving instances from manifest
 	lookupOptions := &libimage.LookupImageOptions{ManifestList: true}
 	image, _, err := ir.Libpod.LibimageRuntime().LookupImage(nameOrID, lookupOptions)
@@ -449,7 +607,10 @@ func (ir *ImageEngine) Tag(ctx context.Context, nameOrID string, tags []string,
 	return nil
 }
 
-func (ir *ImageEngine) Untag(ctx context.Context, nameOrID string, tags []string, options entities.ImageUntagOptions) error {
+func (ir *ImageEngine) Untag(ctx context.Context, nameOrID string, tags []string, options entities.ImageUntagOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.Untag")
+	defer span.EndWithError(&retErr)
+
 	image, _, err := ir.Libpod.LibimageRuntime().LookupImage(nameOrID, nil)
 	if err != nil {
 		return err
@@ -466,21 +627,62 @@ func (ir *ImageEngine) Untag(ctx context.Context, nameOrID string, tags []string
 	return nil
 }
 
-func (ir *ImageEngine) Load(ctx context.Context, options entities.ImageLoadOptions) (*entities.ImageLoadReport, error) {
+func (ir *ImageEngine) Load(ctx context.Context, options entities.ImageLoadOptions) (report *entities.ImageLoadReport, finalErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Load")
+	defer span.EndWithError(&finalErr)
+
 	loadOptions := &libimage.LoadOptions{}
 	loadOptions.SignaturePolicyPath = options.SignaturePolicy
-	if !options.Quiet {
+	loadOptions.Writer = options.Writer
+	if !options.Quiet && loadOptions.Writer == nil {
 		loadOptions.Writer = os.Stderr
 	}
+	run := runOnce
+	if options.ProgressFormat == progress.FormatJSON && loadOptions.Writer != nil {
+		var finishProgress func(*entities.ProgressEvent)
+		run, finishProgress = jsonProgress(&loadOptions.CopyOptions)
+		defer func() {
+			result := progress.Result(finalErr)
+			if report != nil {
+				result.Images = report.Names
+			}
+			finishProgress(result)
+		}()
+	}
 
-	loadedImages, err := ir.Libpod.LibimageRuntime().Load(ctx, options.Input, loadOptions)
+	// Image indexes are restored as manifest lists rather than as the
+	// single instance matching the local platform.
+	listNames, err := ir.loadManifestList(ctx, options.Input, loadOptions)
+	if err != nil {
+		return nil, err
+	}
+	if listNames != nil {
+		return &entities.ImageLoadReport{Names: listNames}, nil
+	}
+
+	var loadedImages []string
+	err = run(ctx, func() error {
+		var err error
+		loadedImages, err = ir.Libpod.LibimageRuntime().Load(ctx, options.Input, loadOptions)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &entities.ImageLoadReport{Names: loadedImages}, nil
 }
 
-func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string, options entities.ImageSaveOptions) error {
+func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string, options entities.ImageSaveOptions) (_ *entities.ImageSaveReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Save")
+	defer span.EndWithError(&retErr)
+
+	if options.AllPlatforms {
+		if err := ir.saveManifestList(ctx, nameOrID, options); err != nil {
+			return nil, err
+		}
+		return &entities.ImageSaveReport{}, nil
+	}
+
 	saveOptions := &libimage.SaveOptions{}
 	saveOptions.DirForceCompress = options.Compress
 	saveOptions.OciAcceptUncompressedLayers = options.OciAcceptUncompressedLayers
@@ -500,10 +702,16 @@ func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string,
 	} else {
 		saveOptions.AdditionalTags = tags
 	}
-	return ir.Libpod.LibimageRuntime().Save(ctx, names, options.Format, options.Output, saveOptions)
+	if err := ir.Libpod.LibimageRuntime().Save(ctx, names, options.Format, options.Output, saveOptions); err != nil {
+		return nil, err
+	}
+	return &entities.ImageSaveReport{}, nil
 }
 
-func (ir *ImageEngine) Import(ctx context.Context, options entities.ImageImportOptions) (*entities.ImageImportReport, error) {
+func (ir *ImageEngine) Import(ctx context.Context, options entities.ImageImportOptions) (_ *entities.ImageImportReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Import")
+	defer span.EndWithError(&retErr)
+
 	importOptions := &libimage.ImportOptions{}
 	importOptions.Changes = options.Changes
 	importOptions.CommitMessage = options.Message
@@ -526,7 +734,10 @@ func (ir *ImageEngine) Import(ctx context.Context, options entities.ImageImportO
 }
 
 // Search for images using term and filters
-func (ir *ImageEngine) Search(ctx context.Context, term string, opts entities.ImageSearchOptions) ([]entities.ImageSearchReport, error) {
+func (ir *ImageEngine) Search(ctx context.Context, term string, opts entities.ImageSearchOptions) (_ []entities.ImageSearchReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Search")
+	defer span.EndWithError(&retErr)
+
 	filter, err := filter.ParseSearchFilter(opts.Filters)
 	if err != nil {
 		return nil, err
@@ -572,7 +783,10 @@ func (ir *ImageEngine) Config(_ context.Context) (*config.Config, error) {
 	return ir.Libpod.GetConfig()
 }
 
-func (ir *ImageEngine) Build(ctx context.Context, containerFiles []string, opts entities.BuildOptions) (*entities.BuildReport, error) {
+func (ir *ImageEngine) Build(ctx context.Context, containerFiles []string, opts entities.BuildOptions) (_ *entities.BuildReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Build")
+	defer span.EndWithError(&retErr)
+
 	id, _, err := ir.Libpod.Build(ctx, opts.BuildOptions, containerFiles...)
 	if err != nil {
 		return nil, err
@@ -584,7 +798,10 @@ func (ir *ImageEngine) Build(ctx context.Context, containerFiles []string, opts
 	return &entities.BuildReport{ID: id, SaveFormat: saveFormat}, nil
 }
 
-func (ir *ImageEngine) Tree(ctx context.Context, nameOrID string, opts entities.ImageTreeOptions) (*entities.ImageTreeReport, error) {
+func (ir *ImageEngine) Tree(ctx context.Context, nameOrID string, opts entities.ImageTreeOptions) (_ *entities.ImageTreeReport, retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.Tree")
+	defer span.EndWithError(&retErr)
+
 	image, _, err := ir.Libpod.LibimageRuntime().LookupImage(nameOrID, nil)
 	if err != nil {
 		return nil, err
@@ -642,6 +859,9 @@ func removeErrorsToExitCode(rmErrors []error) int {
 
 // Remove removes one or more images from local storage.
 func (ir *ImageEngine) Remove(ctx context.Context, images []string, opts entities.ImageRemoveOptions) (report *entities.ImageRemoveReport, rmErrors []error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Remove")
+	defer span.End()
+
 	report = &entities.ImageRemoveReport{}
 
 	// Set the exit code at very end.
@@ -681,7 +901,10 @@ func (ir *ImageEngine) Shutdown(_ context.Context) {
 	})
 }
 
-func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entities.SignOptions) (*entities.SignReport, error) {
+func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entities.SignOptions) (_ *entities.SignReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Sign")
+	defer span.EndWithError(&retErr)
+
 	mech, err := signature.NewGPGSigningMechanism()
 	if err != nil {
 		return nil, fmt.Errorf("initializing GPG: %w", err)
@@ -772,7 +995,10 @@ func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entitie
 	return nil, nil
 }
 
-func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, opts entities.ImageScpOptions) (*entities.ImageScpReport, error) {
+func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, opts entities.ImageScpOptions) (_ *entities.ImageScpReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.Scp")
+	defer span.EndWithError(&retErr)
+
 	report, err := domainUtils.ExecuteTransfer(src, dst, opts.ScpExecuteTransferOptions)
 	if err != nil {
 		return nil, err
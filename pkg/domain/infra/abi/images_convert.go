@@ -0,0 +1,214 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/common/libimage"
+	"github.com/containers/image/v5/directory"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/pkg/compression"
+	storageTransport "github.com/containers/image/v5/storage"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// Convert rewrites the layers and the manifest of a local image with another
+// compression and manifest type.  The image is copied to a temporary
+// directory, which compresses its layers, and from there back into the
+// local storage, so that no registry is needed.
+//
+// Local storage identifies images by their config, which the conversion
+// keeps, so the converted image usually gets the ID of the source image and
+// replaces its manifest and signatures.  This is refused unless
+// options.InPlace is set.
+func (ir *ImageEngine) Convert(ctx context.Context, nameOrID string, options entities.ImageConvertOptions) (*entities.ImageConvertReport, error) {
+	var manifestType string
+	switch options.Format {
+	case "":
+		// Default
+	case "oci":
+		manifestType = imgspecv1.MediaTypeImageManifest
+	case "v2s2", "docker":
+		manifestType = manifest.DockerV2Schema2MediaType
+	default:
+		return nil, fmt.Errorf("unknown format %q. Choose one of the supported formats: 'oci' or 'v2s2'", options.Format)
+	}
+
+	runtime := ir.Libpod.LibimageRuntime()
+	img, resolvedName, err := runtime.LookupImage(nameOrID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a tag, the converted image takes over the name of the source
+	// image.
+	tags := options.Tags
+	if len(tags) == 0 {
+		if strings.HasPrefix(img.ID(), resolvedName) {
+			return nil, fmt.Errorf("converting image %s referenced by ID requires a tag", nameOrID)
+		}
+		tags = []string{resolvedName}
+	}
+	name, err := libimage.NormalizeName(tags[0])
+	if err != nil {
+		return nil, err
+	}
+	destRef, err := storageTransport.Transport.ParseReference(name.String())
+	if err != nil {
+		return nil, err
+	}
+
+	srcRef, err := img.StorageReference()
+	if err != nil {
+		return nil, err
+	}
+	report := &entities.ImageConvertReport{SourceID: img.ID()}
+	// The source may be converted in place, so look at it before
+	// copying.
+	report.SourceFormat, report.SourceSize, err = imageManifestSize(ctx, runtime.SystemContext(), srcRef)
+	if err != nil {
+		return nil, err
+	}
+	configDigest, err := imageConfigDigest(ctx, runtime.SystemContext(), srcRef)
+	if err != nil {
+		return nil, err
+	}
+	// The converted image is stored under the digest of its config,
+	// unlike images whose layers were pulled by table of contents.
+	if configDigest.Encoded() == img.ID() && !options.InPlace {
+		return nil, fmt.Errorf("converting image %s would change it in place, as the converted image gets the same ID, replacing the manifest and removing the signatures of the image under all its names: use --in-place to convert it anyway", nameOrID)
+	}
+
+	config, err := ir.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	copyOptions := &libimage.CopyOptions{
+		// The dir transport only changes the compression if forced.
+		DirForceCompress:       true,
+		ForceCompressionFormat: true,
+		ManifestMIMEType:       manifestType,
+		// Signatures do not match the rewritten manifest.
+		RemoveSignatures:    true,
+		PolicyAllowStorage:  true,
+		SignaturePolicyPath: options.SignaturePolicy,
+		CompressionLevel:    options.CompressionLevel,
+		Writer:              options.Writer,
+	}
+	compressionFormat := options.CompressionFormat
+	if compressionFormat == "" {
+		compressionFormat = config.Engine.CompressionFormat
+	}
+	if compressionFormat != "" {
+		algo, err := compression.AlgorithmByName(compressionFormat)
+		if err != nil {
+			return nil, err
+		}
+		copyOptions.CompressionFormat = &algo
+	}
+	if copyOptions.CompressionLevel == nil {
+		copyOptions.CompressionLevel = config.Engine.CompressionLevel
+	}
+	if !options.Quiet && copyOptions.Writer == nil {
+		copyOptions.Writer = os.Stderr
+	}
+
+	tmpDir, err := config.ImageCopyTmpDir()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp(tmpDir, "podman-convert")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Removing temporary directory %s: %v", dir, err)
+		}
+	}()
+	dirRef, err := directory.NewReference(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copyImage(ctx, runtime.SystemContext(), copyOptions, srcRef, dirRef); err != nil {
+		return nil, fmt.Errorf("converting image %s: %w", nameOrID, err)
+	}
+	// The directory holds the converted image, store it as it is.
+	importOptions := &libimage.CopyOptions{
+		SignaturePolicyPath: options.SignaturePolicy,
+		Writer:              copyOptions.Writer,
+	}
+	if err := copyImage(ctx, runtime.SystemContext(), importOptions, dirRef, destRef); err != nil {
+		return nil, fmt.Errorf("storing converted image %s: %w", name, err)
+	}
+
+	converted, _, err := runtime.LookupImage(name.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags[1:] {
+		if err := converted.Tag(tag); err != nil {
+			return nil, err
+		}
+	}
+	report.ID = converted.ID()
+	convertedRef, err := converted.StorageReference()
+	if err != nil {
+		return nil, err
+	}
+	report.Format, report.Size, err = imageManifestSize(ctx, runtime.SystemContext(), convertedRef)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// copyImage copies an image between two references.
+func copyImage(ctx context.Context, sys *types.SystemContext, options *libimage.CopyOptions, src, dest types.ImageReference) error {
+	copier, err := libimage.NewCopier(options, sys, nil)
+	if err != nil {
+		return err
+	}
+	defer copier.Close()
+	_, err = copier.Copy(ctx, src, dest)
+	return err
+}
+
+// imageConfigDigest returns the digest of the config of an image.
+func imageConfigDigest(ctx context.Context, sys *types.SystemContext, ref types.ImageReference) (digest.Digest, error) {
+	img, err := ref.NewImage(ctx, sys)
+	if err != nil {
+		return "", err
+	}
+	defer img.Close()
+	return img.ConfigInfo().Digest, nil
+}
+
+// imageManifestSize returns the manifest type of an image and the size of
+// its manifest, config and layers as recorded in the manifest.
+func imageManifestSize(ctx context.Context, sys *types.SystemContext, ref types.ImageReference) (string, int64, error) {
+	img, err := ref.NewImage(ctx, sys)
+	if err != nil {
+		return "", 0, err
+	}
+	defer img.Close()
+
+	rawManifest, manifestType, err := img.Manifest(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	size := int64(len(rawManifest)) + img.ConfigInfo().Size
+	for _, layer := range img.LayerInfos() {
+		size += layer.Size
+	}
+	return manifestType, size, nil
+}
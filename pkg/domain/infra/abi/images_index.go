@@ -0,0 +1,554 @@
+//go:build !remote
+
+package abi
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/common/libimage"
+	"github.com/containers/common/libimage/manifests"
+	cp "github.com/containers/image/v5/copy"
+	"github.com/containers/image/v5/manifest"
+	ociTransport "github.com/containers/image/v5/oci/layout"
+	"github.com/containers/image/v5/transports"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/events"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/fileutils"
+	"github.com/opencontainers/go-digest"
+	imgspec "github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// saveManifestList writes the manifest list nameOrID, including all of its
+// instances, their signatures and attached artifacts, as an OCI image index
+// to an oci-archive or an oci-dir.
+func (ir *ImageEngine) saveManifestList(ctx context.Context, nameOrID string, options entities.ImageSaveOptions) error {
+	manifestList, err := ir.Libpod.LibimageRuntime().LookupManifestList(nameOrID)
+	if err != nil {
+		return fmt.Errorf("looking up manifest list %q: %w", nameOrID, err)
+	}
+
+	// Unless the list was referenced by ID, record the resolved name in
+	// the index so that a subsequent load can restore it.
+	var tag string
+	_, resolvedName, err := ir.Libpod.LibimageRuntime().LookupImage(nameOrID, &libimage.LookupImageOptions{ManifestList: true})
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(manifestList.ID(), resolvedName) {
+		tag = resolvedName
+	}
+
+	switch options.Format {
+	case define.OCIArchive, define.OCIManifestDir:
+	default:
+		return fmt.Errorf("saving all platforms of a manifest list is only supported for %q and %q, not %q", define.OCIArchive, define.OCIManifestDir, options.Format)
+	}
+
+	// An OCI archive is written as a layout first, so that the
+	// signatures can be stored along with it.
+	layoutDir := options.Output
+	if options.Format == define.OCIArchive {
+		tmpDir, err := ir.imageCopyTmpDir("podman-save-index")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+		layoutDir = tmpDir
+	}
+	layoutRef, err := ociTransport.NewReference(layoutDir, tag)
+	if err != nil {
+		return err
+	}
+
+	// The list is pushed with c/common/libimage/manifests rather than
+	// libimage, which only takes a destination name, to wrap the
+	// destination.
+	store := ir.Libpod.GetStore()
+	_, list, err := manifests.LoadFromImage(store, manifestList.ID())
+	if err != nil {
+		return err
+	}
+	sys := ir.Libpod.SystemContext()
+	if options.SignaturePolicy != "" {
+		sys.SignaturePolicyPath = options.SignaturePolicy
+	}
+	sys.OCIAcceptUncompressedLayers = options.OciAcceptUncompressedLayers
+	pushOptions := manifests.PushOptions{
+		Store:              store,
+		SystemContext:      sys,
+		ImageListSelection: cp.CopyAllImages,
+		ManifestType:       imgspecv1.MediaTypeImageManifest,
+	}
+	if !options.Quiet {
+		pushOptions.ReportWriter = os.Stderr
+	}
+	destRef := &signatureLayoutReference{ImageReference: layoutRef, dir: layoutDir}
+	if _, _, err := list.Push(ctx, destRef, pushOptions); err != nil {
+		return err
+	}
+
+	if options.Format == define.OCIArchive {
+		if err := tarLayout(layoutDir, options.Output); err != nil {
+			return err
+		}
+	}
+	// libimage writes the save event of single images.
+	ir.Libpod.NewImageEvent(events.Save, manifestList.ID(), options.Output)
+	return nil
+}
+
+// tarLayout writes the OCI layout at dir as an OCI archive to output.
+func tarLayout(dir, output string) (retErr error) {
+	stream, err := archive.Tar(dir, archive.Uncompressed)
+	if err != nil {
+		return fmt.Errorf("creating OCI archive: %w", err)
+	}
+	defer stream.Close()
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if _, err := io.Copy(f, stream); err != nil {
+		return fmt.Errorf("writing OCI archive %q: %w", output, err)
+	}
+	return nil
+}
+
+// loadManifestList checks whether input is an OCI layout or an OCI archive
+// whose only top-level entry is an image index.  If so, all image instances
+// of the index are loaded into local storage, its artifacts are stored with
+// the list and the index is restored as a manifest list.  The returned names are nil if input does not contain an
+// image index and should be loaded as a regular image instead.  If loading
+// fails, the manifest list and its artifacts are removed again.
+func (ir *ImageEngine) loadManifestList(ctx context.Context, input string, options *libimage.LoadOptions) (_ []string, retErr error) {
+	indexDescriptor, isDir, err := readLayoutIndexDescriptor(input)
+	if err != nil {
+		logrus.Debugf("Not loading %q as an image index: %v", input, err)
+		return nil, nil
+	}
+	if indexDescriptor == nil {
+		return nil, nil
+	}
+	// The digests of the archive are used in paths, they must not
+	// point outside of the layout.
+	if err := indexDescriptor.Digest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid digest %q of image index: %w", indexDescriptor.Digest, err)
+	}
+
+	layoutDir := input
+	if !isDir {
+		tmpDir, err := ir.imageCopyTmpDir("podman-load-index")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tmpDir)
+		if err := archive.UntarPath(input, tmpDir); err != nil {
+			return nil, fmt.Errorf("extracting OCI archive %q: %w", input, err)
+		}
+		layoutDir = tmpDir
+	}
+
+	var index imgspecv1.Index
+	indexBlob, err := os.ReadFile(layoutBlobPath(layoutDir, indexDescriptor.Digest))
+	if err != nil {
+		return nil, fmt.Errorf("reading image index: %w", err)
+	}
+	if err := json.Unmarshal(indexBlob, &index); err != nil {
+		return nil, fmt.Errorf("decoding image index: %w", err)
+	}
+	for _, instance := range index.Manifests {
+		if err := instance.Digest.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid digest %q of an instance of the image index: %w", instance.Digest, err)
+		}
+	}
+
+	name := indexDescriptor.Annotations[imgspecv1.AnnotationRefName]
+	if name == "" {
+		name = indexDescriptor.Digest.Encoded()[:12]
+	}
+
+	runtime := ir.Libpod.LibimageRuntime()
+	if _, _, err := runtime.LookupImage(name, &libimage.LookupImageOptions{ManifestList: true}); err == nil {
+		return nil, fmt.Errorf("loading image index: an image or manifest list named %q already exists, remove it first", name)
+	}
+
+	var artifacts []imgspecv1.Descriptor
+	isImage := make([]bool, len(index.Manifests))
+	for i, instance := range index.Manifests {
+		if isImage[i], err = layoutInstanceIsImage(layoutDir, instance); err != nil {
+			return nil, err
+		}
+		if !isImage[i] {
+			artifacts = append(artifacts, instance)
+		}
+	}
+
+	manifestList, err := runtime.CreateManifestList(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		// The artifacts are in the directory of the list, remove
+		// them before the list.
+		if imageDir, err := ir.Libpod.GetStore().ImageDirectory(manifestList.ID()); err == nil {
+			if err := os.RemoveAll(filepath.Join(imageDir, layoutArtifactsDir)); err != nil {
+				logrus.Errorf("Removing artifacts of manifest list %q: %v", name, err)
+			}
+		}
+		if _, rmErrors := runtime.RemoveImages(context.Background(), []string{manifestList.ID()}, &libimage.RemoveImagesOptions{LookupManifest: true}); len(rmErrors) > 0 {
+			logrus.Errorf("Removing manifest list %q: %v", name, errors.Join(rmErrors...))
+		}
+	}()
+	var artifactsDir string
+	if len(artifacts) > 0 {
+		if artifactsDir, err = ir.storeLayoutArtifacts(layoutDir, manifestList.ID(), artifacts); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, instance := range index.Manifests {
+		var instanceRef string
+		if isImage[i] {
+			imageID, err := ir.loadLayoutInstance(ctx, layoutDir, instance, options)
+			if err != nil {
+				return nil, fmt.Errorf("loading instance %s: %w", instance.Digest, err)
+			}
+			instanceRef = "containers-storage:" + imageID
+		} else {
+			ref, err := ociTransport.NewReference(artifactsDir, instance.Digest.Encoded())
+			if err != nil {
+				return nil, err
+			}
+			instanceRef = transports.ImageName(ref)
+			if sigs, err := readLayoutSignatures(layoutDir, instance.Digest); err == nil && len(sigs) > 0 {
+				logrus.Warnf("Signatures of artifact instance %s of image index %q are not loaded", instance.Digest, name)
+			}
+		}
+		instanceDigest, err := manifestList.Add(ctx, instanceRef, nil)
+		if err != nil {
+			return nil, err
+		}
+		annotateOptions := &libimage.ManifestListAnnotateOptions{Annotations: instance.Annotations}
+		if instance.Platform != nil {
+			annotateOptions.Architecture = instance.Platform.Architecture
+			annotateOptions.OS = instance.Platform.OS
+			annotateOptions.OSFeatures = instance.Platform.OSFeatures
+			annotateOptions.OSVersion = instance.Platform.OSVersion
+			annotateOptions.Variant = instance.Platform.Variant
+		}
+		if err := manifestList.AnnotateInstance(instanceDigest, annotateOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(index.Annotations) > 0 {
+		if err := manifestList.AnnotateInstance("", &libimage.ManifestListAnnotateOptions{IndexAnnotations: index.Annotations}); err != nil {
+			return nil, err
+		}
+	}
+
+	return []string{name}, nil
+}
+
+// imageCopyTmpDir creates a temporary directory in the image copy tmpdir
+// configured in containers.conf.
+func (ir *ImageEngine) imageCopyTmpDir(pattern string) (string, error) {
+	config, err := ir.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return "", err
+	}
+	parentDir, err := config.ImageCopyTmpDir()
+	if err != nil {
+		return "", err
+	}
+	return os.MkdirTemp(parentDir, pattern)
+}
+
+// layoutArtifactsDir is the directory of a manifest list loaded from an
+// image index that holds the OCI layout of its artifacts.
+const layoutArtifactsDir = "artifacts"
+
+// storeLayoutArtifacts copies the artifact instances of the image index in
+// layoutDir to an OCI layout in the directory of the manifest list listID
+// and returns the path of the layout.  Artifacts cannot be stored as images,
+// the layout is their source when the list is pushed and it is removed
+// along with the list.  Each artifact is tagged with its digest.
+func (ir *ImageEngine) storeLayoutArtifacts(layoutDir, listID string, artifacts []imgspecv1.Descriptor) (string, error) {
+	imageDir, err := ir.Libpod.GetStore().ImageDirectory(listID)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(imageDir, layoutArtifactsDir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	index := imgspecv1.Index{
+		Versioned: imgspec.Versioned{SchemaVersion: 2},
+		MediaType: imgspecv1.MediaTypeImageIndex,
+	}
+	for _, artifact := range artifacts {
+		if manifest.MIMETypeIsMultiImage(artifact.MediaType) {
+			return "", fmt.Errorf("instance %s is an image index, nested image indexes are not supported", artifact.Digest)
+		}
+		manifestBlob, err := os.ReadFile(layoutBlobPath(layoutDir, artifact.Digest))
+		if err != nil {
+			return "", fmt.Errorf("reading manifest of instance %s: %w", artifact.Digest, err)
+		}
+		var m imgspecv1.Manifest
+		if err := json.Unmarshal(manifestBlob, &m); err != nil {
+			return "", fmt.Errorf("decoding manifest of instance %s: %w", artifact.Digest, err)
+		}
+		blobs := []digest.Digest{artifact.Digest, m.Config.Digest}
+		for _, layer := range m.Layers {
+			blobs = append(blobs, layer.Digest)
+		}
+		for _, blob := range blobs {
+			if err := blob.Validate(); err != nil {
+				return "", fmt.Errorf("invalid digest %q of a blob of instance %s: %w", blob, artifact.Digest, err)
+			}
+			if err := copyLayoutBlob(layoutDir, dir, blob); err != nil {
+				return "", fmt.Errorf("copying blob %s of instance %s: %w", blob, artifact.Digest, err)
+			}
+		}
+		index.Manifests = append(index.Manifests, imgspecv1.Descriptor{
+			MediaType:    artifact.MediaType,
+			ArtifactType: artifact.ArtifactType,
+			Digest:       artifact.Digest,
+			Size:         artifact.Size,
+			Annotations:  map[string]string{imgspecv1.AnnotationRefName: artifact.Digest.Encoded()},
+		})
+	}
+
+	layout, err := json.Marshal(imgspecv1.ImageLayout{Version: imgspecv1.ImageLayoutVersion})
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, imgspecv1.ImageLayoutFile), layout, 0o644); err != nil {
+		return "", err
+	}
+	indexBlob, err := json.Marshal(index)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), indexBlob, 0o644); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// copyLayoutBlob copies the blob d of the OCI layout at srcDir to the OCI
+// layout at destDir.  The empty JSON blob, which writers may leave out, is
+// created if it is missing.
+func copyLayoutBlob(srcDir, destDir string, d digest.Digest) error {
+	destPath := layoutBlobPath(destDir, d)
+	if err := fileutils.Exists(destPath); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o700); err != nil {
+		return err
+	}
+
+	src, err := os.Open(layoutBlobPath(srcDir, d))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && d == imgspecv1.DescriptorEmptyJSON.Digest {
+			return os.WriteFile(destPath, imgspecv1.DescriptorEmptyJSON.Data, 0o644)
+		}
+		return err
+	}
+	defer src.Close()
+	dest, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, src); err != nil {
+		dest.Close()
+		return err
+	}
+	return dest.Close()
+}
+
+// loadLayoutInstance loads the single instance of the image index stored in
+// layoutDir into local storage, along with its signatures, and returns the
+// ID of the loaded image.
+func (ir *ImageEngine) loadLayoutInstance(ctx context.Context, layoutDir string, instance imgspecv1.Descriptor, options *libimage.LoadOptions) (string, error) {
+	blobsDir, err := filepath.Abs(filepath.Join(layoutDir, imgspecv1.ImageBlobsDir))
+	if err != nil {
+		return "", err
+	}
+	instanceDir, err := ir.imageCopyTmpDir("podman-load-instance")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(instanceDir)
+
+	// Each instance is exposed as its own OCI layout sharing the blobs
+	// of the index, so it can be loaded like any other OCI image.
+	if err := os.Symlink(blobsDir, filepath.Join(instanceDir, imgspecv1.ImageBlobsDir)); err != nil {
+		return "", err
+	}
+	layout, err := json.Marshal(imgspecv1.ImageLayout{Version: imgspecv1.ImageLayoutVersion})
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(instanceDir, imgspecv1.ImageLayoutFile), layout, 0o644); err != nil {
+		return "", err
+	}
+	index, err := json.Marshal(imgspecv1.Index{
+		Versioned: imgspec.Versioned{SchemaVersion: 2},
+		MediaType: imgspecv1.MediaTypeImageIndex,
+		Manifests: []imgspecv1.Descriptor{{
+			MediaType: instance.MediaType,
+			Digest:    instance.Digest,
+			Size:      instance.Size,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(instanceDir, "index.json"), index, 0o644); err != nil {
+		return "", err
+	}
+
+	layoutRef, err := ociTransport.NewReference(instanceDir, "")
+	if err != nil {
+		return "", err
+	}
+	logrus.Debugf("Loading instance %s from %q", instance.Digest, transports.ImageName(layoutRef))
+	ref := &signatureLayoutReference{ImageReference: layoutRef, dir: layoutDir, instance: instance.Digest}
+	loaded, err := ir.Libpod.LibimageRuntime().LoadReference(ctx, ref, options)
+	if err != nil {
+		return "", err
+	}
+	if len(loaded) != 1 {
+		return "", fmt.Errorf("expected to load one image but loaded %d", len(loaded))
+	}
+	image, _, err := ir.Libpod.LibimageRuntime().LookupImage(loaded[0], nil)
+	if err != nil {
+		return "", err
+	}
+	return image.ID(), nil
+}
+
+// readLayoutIndexDescriptor returns the descriptor of the image index if the
+// OCI layout or OCI archive at path has exactly one top-level entry and that
+// entry is an image index.  Otherwise, the returned descriptor is nil.
+func readLayoutIndexDescriptor(path string) (*imgspecv1.Descriptor, bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var indexBlob []byte
+	if info.IsDir() {
+		indexBlob, err = os.ReadFile(filepath.Join(path, "index.json"))
+		if err != nil {
+			return nil, true, err
+		}
+	} else {
+		indexBlob, err = readArchiveIndex(path)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var index imgspecv1.Index
+	if err := json.Unmarshal(indexBlob, &index); err != nil {
+		return nil, info.IsDir(), err
+	}
+	if len(index.Manifests) != 1 || !manifest.MIMETypeIsMultiImage(index.Manifests[0].MediaType) {
+		return nil, info.IsDir(), nil
+	}
+	return &index.Manifests[0], info.IsDir(), nil
+}
+
+// readArchiveIndex returns the contents of the index.json file of the
+// (possibly compressed) OCI archive at path.
+func readArchiveIndex(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stream, err := archive.DecompressStream(f)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	reader := tar.NewReader(stream)
+	for {
+		header, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("no index.json found in archive")
+			}
+			return nil, err
+		}
+		if filepath.Clean(header.Name) == "index.json" {
+			return io.ReadAll(reader)
+		}
+	}
+}
+
+// ociLayerMediaTypePrefix is the prefix of the media types of OCI
+// filesystem layers.
+const ociLayerMediaTypePrefix = "application/vnd.oci.image.layer."
+
+// layoutInstanceIsImage returns true if the manifest referred to by instance
+// describes a container image rather than an artifact.
+func layoutInstanceIsImage(layoutDir string, instance imgspecv1.Descriptor) (bool, error) {
+	if instance.MediaType == manifest.DockerV2Schema2MediaType {
+		return true, nil
+	}
+	if instance.MediaType != imgspecv1.MediaTypeImageManifest {
+		return false, nil
+	}
+	manifestBlob, err := os.ReadFile(layoutBlobPath(layoutDir, instance.Digest))
+	if err != nil {
+		return false, fmt.Errorf("reading manifest of instance %s: %w", instance.Digest, err)
+	}
+	var m imgspecv1.Manifest
+	if err := json.Unmarshal(manifestBlob, &m); err != nil {
+		return false, fmt.Errorf("decoding manifest of instance %s: %w", instance.Digest, err)
+	}
+	if m.ArtifactType != "" || m.Config.MediaType != imgspecv1.MediaTypeImageConfig {
+		return false, nil
+	}
+	// Attestations, for example, use an image config but their layers
+	// are not filesystem layers.
+	for _, layer := range m.Layers {
+		if !strings.HasPrefix(layer.MediaType, ociLayerMediaTypePrefix) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// layoutBlobPath returns the path of the blob d in the OCI layout at dir.
+// d must have been validated.
+func layoutBlobPath(dir string, d digest.Digest) string {
+	return filepath.Join(dir, imgspecv1.ImageBlobsDir, d.Algorithm().String(), d.Encoded())
+}
@@ -0,0 +1,132 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/image/v5/types"
+	"github.com/opencontainers/go-digest"
+)
+
+// layoutSignaturesDir is the directory of an OCI layout written by podman
+// save --all-platforms that holds the signatures of the instances of the
+// image index.  OCI layouts have no place for detached signatures, so they
+// are stored like in a dir: layout, per instance digest.
+const layoutSignaturesDir = "signatures"
+
+// layoutSignaturePath returns the path of the signature with the given
+// index of the instance d in the OCI layout at dir.  d must have been
+// validated.
+func layoutSignaturePath(dir string, d digest.Digest, index int) string {
+	return filepath.Join(dir, layoutSignaturesDir, d.Algorithm().String(), d.Encoded(), fmt.Sprintf("signature-%d", index+1))
+}
+
+// readLayoutSignatures returns the signatures of the instance d stored in
+// the OCI layout at dir.
+func readLayoutSignatures(dir string, d digest.Digest) ([][]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+	sigs := [][]byte{}
+	for i := 0; ; i++ {
+		sig, err := os.ReadFile(layoutSignaturePath(dir, d, i))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return sigs, nil
+			}
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+}
+
+// writeLayoutSignatures replaces the signatures of the instance d stored in
+// the OCI layout at dir.
+func writeLayoutSignatures(dir string, d digest.Digest, sigs [][]byte) error {
+	if err := d.Validate(); err != nil {
+		return fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+	sigDir := filepath.Dir(layoutSignaturePath(dir, d, 0))
+	if err := os.RemoveAll(sigDir); err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(sigDir, 0o755); err != nil {
+		return err
+	}
+	for i, sig := range sigs {
+		if err := os.WriteFile(layoutSignaturePath(dir, d, i), sig, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signatureLayoutReference is a reference to the OCI layout at dir whose
+// destination stores and whose source returns the signatures kept in the
+// layout.
+type signatureLayoutReference struct {
+	types.ImageReference
+	dir string
+	// instance is the digest of the manifest whose signatures the
+	// source returns for the top-level manifest.
+	instance digest.Digest
+}
+
+func (r *signatureLayoutReference) NewImageSource(ctx context.Context, sys *types.SystemContext) (types.ImageSource, error) {
+	src, err := r.ImageReference.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	return &signatureLayoutSource{ImageSource: src, ref: r}, nil
+}
+
+func (r *signatureLayoutReference) NewImageDestination(ctx context.Context, sys *types.SystemContext) (types.ImageDestination, error) {
+	dest, err := r.ImageReference.NewImageDestination(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	return &signatureLayoutDestination{ImageDestination: dest, ref: r}, nil
+}
+
+type signatureLayoutSource struct {
+	types.ImageSource
+	ref *signatureLayoutReference
+}
+
+func (s *signatureLayoutSource) GetSignatures(_ context.Context, instanceDigest *digest.Digest) ([][]byte, error) {
+	d := s.ref.instance
+	if instanceDigest != nil {
+		d = *instanceDigest
+	}
+	if d == "" {
+		return [][]byte{}, nil
+	}
+	return readLayoutSignatures(s.ref.dir, d)
+}
+
+type signatureLayoutDestination struct {
+	types.ImageDestination
+	ref *signatureLayoutReference
+}
+
+func (d *signatureLayoutDestination) SupportsSignatures(context.Context) error {
+	return nil
+}
+
+func (d *signatureLayoutDestination) PutSignatures(_ context.Context, signatures [][]byte, instanceDigest *digest.Digest) error {
+	if instanceDigest == nil {
+		if len(signatures) == 0 {
+			return nil
+		}
+		return errors.New("signatures of the image index itself cannot be saved")
+	}
+	return writeLayoutSignatures(d.ref.dir, *instanceDigest, signatures)
+}
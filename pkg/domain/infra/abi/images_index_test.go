@@ -0,0 +1,125 @@
+//go:build !remote
+
+package abi
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/image/v5/manifest"
+	"github.com/opencontainers/go-digest"
+	imgspec "github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestLayoutIndex(t *testing.T, dir string, mediaTypes ...string) []byte {
+	index := imgspecv1.Index{
+		Versioned: imgspec.Versioned{SchemaVersion: 2},
+		MediaType: imgspecv1.MediaTypeImageIndex,
+	}
+	for _, mediaType := range mediaTypes {
+		index.Manifests = append(index.Manifests, imgspecv1.Descriptor{
+			MediaType:   mediaType,
+			Digest:      digest.FromString(mediaType),
+			Annotations: map[string]string{imgspecv1.AnnotationRefName: "localhost/list:latest"},
+		})
+	}
+	indexBlob, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), indexBlob, 0o644))
+	return indexBlob
+}
+
+func TestReadLayoutIndexDescriptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		mediaTypes []string
+		isIndex    bool
+	}{
+		{"single image", []string{imgspecv1.MediaTypeImageManifest}, false},
+		{"oci index", []string{imgspecv1.MediaTypeImageIndex}, true},
+		{"docker list", []string{manifest.DockerV2ListMediaType}, true},
+		{"multiple entries", []string{imgspecv1.MediaTypeImageIndex, imgspecv1.MediaTypeImageIndex}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTestLayoutIndex(t, dir, tt.mediaTypes...)
+
+			descriptor, isDir, err := readLayoutIndexDescriptor(dir)
+			require.NoError(t, err)
+			assert.True(t, isDir)
+			if !tt.isIndex {
+				assert.Nil(t, descriptor)
+				return
+			}
+			require.NotNil(t, descriptor)
+			assert.Equal(t, "localhost/list:latest", descriptor.Annotations[imgspecv1.AnnotationRefName])
+		})
+	}
+}
+
+func TestReadLayoutIndexDescriptorArchive(t *testing.T) {
+	indexBlob := writeTestLayoutIndex(t, t.TempDir(), imgspecv1.MediaTypeImageIndex)
+
+	archivePath := filepath.Join(t.TempDir(), "archive.tar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	tw := tar.NewWriter(f)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "oci-layout", Mode: 0o644, Size: 2}))
+	_, err = tw.Write([]byte("{}"))
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "index.json", Mode: 0o644, Size: int64(len(indexBlob))}))
+	_, err = tw.Write(indexBlob)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, f.Close())
+
+	descriptor, isDir, err := readLayoutIndexDescriptor(archivePath)
+	require.NoError(t, err)
+	assert.False(t, isDir)
+	require.NotNil(t, descriptor)
+	assert.Equal(t, imgspecv1.MediaTypeImageIndex, descriptor.MediaType)
+}
+
+func TestLayoutSignatures(t *testing.T) {
+	dir := t.TempDir()
+	ref := &signatureLayoutReference{dir: dir}
+	dest := &signatureLayoutDestination{ref: ref}
+	instance := digest.FromString("instance")
+
+	require.NoError(t, dest.SupportsSignatures(context.Background()))
+	require.NoError(t, dest.PutSignatures(context.Background(), [][]byte{[]byte("sig1"), []byte("sig2")}, &instance))
+	assert.FileExists(t, filepath.Join(dir, "signatures", "sha256", instance.Encoded(), "signature-2"))
+	require.NoError(t, dest.PutSignatures(context.Background(), nil, nil))
+	assert.ErrorContains(t, dest.PutSignatures(context.Background(), [][]byte{[]byte("sig")}, nil), "image index itself")
+
+	src := &signatureLayoutSource{ref: &signatureLayoutReference{dir: dir, instance: instance}}
+	sigs, err := src.GetSignatures(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("sig1"), []byte("sig2")}, sigs)
+
+	// Signatures are replaced, not merged.
+	require.NoError(t, dest.PutSignatures(context.Background(), [][]byte{[]byte("sig3")}, &instance))
+	sigs, err = src.GetSignatures(context.Background(), &instance)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("sig3")}, sigs)
+
+	other := digest.FromString("other")
+	sigs, err = src.GetSignatures(context.Background(), &other)
+	require.NoError(t, err)
+	assert.Empty(t, sigs)
+
+	// Digests must not point outside of the layout.
+	invalid := digest.Digest("sha256:../../x")
+	assert.ErrorContains(t, dest.PutSignatures(context.Background(), [][]byte{[]byte("sig")}, &invalid), "invalid digest")
+	_, err = src.GetSignatures(context.Background(), &invalid)
+	assert.ErrorContains(t, err, "invalid digest")
+	assert.NoFileExists(t, filepath.Join(dir, "x", "signature-1"))
+}
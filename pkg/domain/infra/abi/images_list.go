@@ -10,9 +10,13 @@ import (
 	"github.com/containers/common/libimage"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ir *ImageEngine) List(ctx context.Context, opts entities.ImageListOptions) ([]*entities.ImageSummary, error) {
+func (ir *ImageEngine) List(ctx context.Context, opts entities.ImageListOptions) (_ []*entities.ImageSummary, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.List")
+	defer span.EndWithError(&retErr)
+
 	listImagesOptions := &libimage.ListImagesOptions{
 		Filters:     opts.Filter,
 		SetListData: true,
@@ -90,6 +94,13 @@ func (ir *ImageEngine) List(ctx context.Context, opts entities.ImageListOptions)
 				return nil, fmt.Errorf("retrieving size of image %q: you may need to remove the image to resolve the error: %w", img.ID(), err)
 			}
 			s.Size = sz
+			lastUsed, err := ir.Libpod.ImageLastUsed(img.ID())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving last use of image %q: %w", img.ID(), err)
+			}
+			if !lastUsed.IsZero() {
+				s.LastUsed = lastUsed.Unix()
+			}
 			// This is good enough for now, but has to be
 			// replaced later with correct calculation logic
 			s.VirtualSize = sz
@@ -0,0 +1,33 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/containers/podman/v5/pkg/domain/entities"
+)
+
+// Jobs are run by the API service, so they only exist for remote clients.
+var errJobsRemoteOnly = errors.New("jobs are only available when connected to a Podman service, use podman --remote")
+
+func (ic *ContainerEngine) JobCancel(ctx context.Context, ids []string) ([]*entities.JobCancelReport, error) {
+	return nil, errJobsRemoteOnly
+}
+
+func (ic *ContainerEngine) JobInspect(ctx context.Context, id string) (*entities.JobReport, error) {
+	return nil, errJobsRemoteOnly
+}
+
+func (ic *ContainerEngine) JobList(ctx context.Context) ([]*entities.JobReport, error) {
+	return nil, errJobsRemoteOnly
+}
+
+func (ic *ContainerEngine) JobLogs(ctx context.Context, id string, options entities.JobLogsOptions) error {
+	return errJobsRemoteOnly
+}
+
+func (ic *ContainerEngine) JobRm(ctx context.Context, ids []string) ([]*entities.JobRmReport, error) {
+	return nil, errJobsRemoteOnly
+}
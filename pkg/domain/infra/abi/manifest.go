@@ -11,19 +11,26 @@ import (
 	"path"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/libimage/define"
+	"github.com/containers/common/libimage/manifests"
 	cp "github.com/containers/image/v5/copy"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/pkg/compression"
 	"github.com/containers/image/v5/pkg/shortnames"
+	"github.com/containers/image/v5/signature"
+	storageTransport "github.com/containers/image/v5/storage"
 	"github.com/containers/image/v5/transports"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	envLib "github.com/containers/podman/v5/pkg/env"
+	"github.com/containers/podman/v5/pkg/progress"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/storage"
 	"github.com/opencontainers/go-digest"
 	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
@@ -31,7 +38,10 @@ import (
 )
 
 // ManifestCreate implements logic for creating manifest lists via ImageEngine
-func (ir *ImageEngine) ManifestCreate(ctx context.Context, name string, images []string, opts entities.ManifestCreateOptions) (string, error) {
+func (ir *ImageEngine) ManifestCreate(ctx context.Context, name string, images []string, opts entities.ManifestCreateOptions) (_ string, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestCreate")
+	defer span.EndWithError(&retErr)
+
 	if len(name) == 0 {
 		return "", errors.New("no name specified for creating a manifest list")
 	}
@@ -68,7 +78,10 @@ func (ir *ImageEngine) ManifestCreate(ctx context.Context, name string, images [
 }
 
 // ManifestExists checks if a manifest list with the given name exists in local storage
-func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.ManifestExists")
+	defer span.EndWithError(&retErr)
+
 	_, err := ir.Libpod.LibimageRuntime().LookupManifestList(name)
 	if err != nil {
 		if errors.Is(err, storage.ErrImageUnknown) {
@@ -81,7 +94,10 @@ func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entiti
 }
 
 // ManifestInspect returns the content of a manifest list or image
-func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string, opts entities.ManifestInspectOptions) (*define.ManifestListData, error) {
+func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string, opts entities.ManifestInspectOptions) (_ *define.ManifestListData, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestInspect")
+	defer span.EndWithError(&retErr)
+
 	// NOTE: we have to do a bit of a limbo here as `podman manifest
 	// inspect foo` wants to do a remote-inspect of foo iff "foo" in the
 	// containers storage is an ordinary image but not a manifest list.
@@ -190,7 +206,10 @@ func (ir *ImageEngine) remoteManifestInspect(ctx context.Context, name string, o
 }
 
 // ManifestAdd adds images to the manifest list
-func (ir *ImageEngine) ManifestAdd(ctx context.Context, name string, images []string, opts entities.ManifestAddOptions) (string, error) {
+func (ir *ImageEngine) ManifestAdd(ctx context.Context, name string, images []string, opts entities.ManifestAddOptions) (_ string, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestAdd")
+	defer span.EndWithError(&retErr)
+
 	if len(images) < 1 {
 		return "", errors.New("manifest add requires at least one image")
 	}
@@ -269,7 +288,10 @@ func mergeAnnotations(preferred map[string]string, aux []string) (map[string]str
 }
 
 // ManifestAnnotate updates an entry of the manifest list
-func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, name, image string, opts entities.ManifestAnnotateOptions) (string, error) {
+func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, name, image string, opts entities.ManifestAnnotateOptions) (_ string, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestAnnotate")
+	defer span.EndWithError(&retErr)
+
 	manifestList, err := ir.Libpod.LibimageRuntime().LookupManifestList(name)
 	if err != nil {
 		return "", err
@@ -340,7 +362,10 @@ func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, name, image string,
 }
 
 // ManifestAddArtifact creates artifact manifest for files and adds them to the manifest list
-func (ir *ImageEngine) ManifestAddArtifact(ctx context.Context, name string, files []string, opts entities.ManifestAddArtifactOptions) (string, error) {
+func (ir *ImageEngine) ManifestAddArtifact(ctx context.Context, name string, files []string, opts entities.ManifestAddArtifactOptions) (_ string, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestAddArtifact")
+	defer span.EndWithError(&retErr)
+
 	if len(files) < 1 {
 		return "", errors.New("manifest add artifact requires at least one file")
 	}
@@ -442,7 +467,10 @@ func (ir *ImageEngine) digestFromDigestOrManifestListMember(ctx context.Context,
 }
 
 // ManifestRemoveDigest removes specified digest from the specified manifest list
-func (ir *ImageEngine) ManifestRemoveDigest(ctx context.Context, name, image string) (string, error) {
+func (ir *ImageEngine) ManifestRemoveDigest(ctx context.Context, name, image string) (_ string, retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.ManifestRemoveDigest")
+	defer span.EndWithError(&retErr)
+
 	instanceDigest, err := digest.Parse(image)
 	if err != nil {
 		return "", fmt.Errorf(`invalid image digest "%s": %v`, image, err)
@@ -462,11 +490,17 @@ func (ir *ImageEngine) ManifestRemoveDigest(ctx context.Context, name, image str
 
 // ManifestRm removes the specified manifest list from storage
 func (ir *ImageEngine) ManifestRm(ctx context.Context, names []string) (report *entities.ImageRemoveReport, rmErrors []error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestRm")
+	defer span.End()
+
 	return ir.Remove(ctx, names, entities.ImageRemoveOptions{LookupManifest: true})
 }
 
 // ManifestPush pushes a manifest list or image index to the destination
-func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination string, opts entities.ImagePushOptions) (string, error) {
+func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination string, opts entities.ImagePushOptions) (_ string, retErr error) {
+	ctx, span := tracing.Start(ctx, "ImageEngine.ManifestPush")
+	defer span.EndWithError(&retErr)
+
 	manifestList, err := ir.Libpod.LibimageRuntime().LookupManifestList(name)
 	if err != nil {
 		return "", fmt.Errorf("retrieving local image from image name %s: %w", name, err)
@@ -533,7 +567,18 @@ func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination strin
 		pushOptions.Writer = os.Stderr
 	}
 
-	manDigest, err := manifestList.Push(ctx, destination, pushOptions)
+	var manDigest digest.Digest
+	if opts.ProgressFormat == progress.FormatJSON && pushOptions.Writer != nil {
+		emit := progress.NewJSONEmitter(pushOptions.Writer)
+		manDigest, err = ir.pushManifestListProgress(ctx, manifestList, destination, pushOptions, progress.NewTracker(emit))
+		result := progress.Result(err)
+		if err == nil {
+			result.ManifestDigest = manDigest.String()
+		}
+		emit(result)
+	} else {
+		manDigest, err = manifestList.Push(ctx, destination, pushOptions)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -548,8 +593,132 @@ func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination strin
 	return manDigest.String(), err
 }
 
+// pushManifestListProgress pushes the manifest list like
+// libimage.ManifestList.Push but reports the progress of the copy to
+// tracker, which libimage does not support for manifest lists.
+func (ir *ImageEngine) pushManifestListProgress(ctx context.Context, manifestList *libimage.ManifestList, destination string, options *libimage.ManifestListPushOptions, tracker *progress.Tracker) (digest.Digest, error) {
+	dest, err := alltransports.ParseImageName(destination)
+	if err != nil {
+		oldErr := err
+		dest, err = alltransports.ParseImageName("docker://" + destination)
+		if err != nil {
+			return "", oldErr
+		}
+	}
+
+	store := ir.Libpod.GetStore()
+	_, list, err := manifests.LoadFromImage(store, manifestList.ID())
+	if err != nil {
+		return "", err
+	}
+	src, err := list.Reference(store, options.ImageListSelection, options.Instances)
+	if err != nil {
+		return "", err
+	}
+
+	// Set up the system context the way libimage does for copies.
+	sys := *ir.Libpod.SystemContext()
+	if options.InsecureSkipTLSVerify != types.OptionalBoolUndefined {
+		sys.DockerInsecureSkipTLSVerify = options.InsecureSkipTLSVerify
+		sys.OCIInsecureSkipTLSVerify = options.InsecureSkipTLSVerify == types.OptionalBoolTrue
+		sys.DockerDaemonInsecureSkipTLSVerify = options.InsecureSkipTLSVerify == types.OptionalBoolTrue
+	}
+	if options.AuthFilePath != "" {
+		sys.AuthFilePath = options.AuthFilePath
+	}
+	if options.CertDirPath != "" {
+		sys.DockerCertPath = options.CertDirPath
+	}
+	if options.Username != "" {
+		sys.DockerAuthConfig = &types.DockerAuthConfig{Username: options.Username, Password: options.Password}
+	}
+	if options.CompressionFormat != nil {
+		sys.CompressionFormat = options.CompressionFormat
+	}
+	if options.CompressionLevel != nil {
+		sys.CompressionLevel = options.CompressionLevel
+	}
+
+	policy, err := signature.DefaultPolicy(&sys)
+	if err != nil {
+		return "", fmt.Errorf("obtaining default signature policy: %w", err)
+	}
+	// The instances are always read from the local storage.
+	policy.Transports[storageTransport.Transport.Name()] = signature.PolicyTransportScopes{
+		"": []signature.PolicyRequirement{signature.NewPRInsecureAcceptAnything()},
+	}
+	policyContext, err := signature.NewPolicyContext(policy)
+	if err != nil {
+		return "", fmt.Errorf("creating new signature policy context: %w", err)
+	}
+	defer func() {
+		if err := policyContext.Destroy(); err != nil {
+			logrus.Errorf("Destroying signature policy context: %v", err)
+		}
+	}()
+
+	// The list type is inferred from the forced type of the instances.
+	singleImageManifestType := options.ManifestMIMEType
+	switch singleImageManifestType {
+	case imgspecv1.MediaTypeImageIndex:
+		singleImageManifestType = imgspecv1.MediaTypeImageManifest
+	case manifest.DockerV2ListMediaType:
+		singleImageManifestType = manifest.DockerV2Schema2MediaType
+	}
+	compressionVariants := []cp.OptionCompressionVariant{}
+	for _, name := range options.AddCompression {
+		algo, err := compression.AlgorithmByName(name)
+		if err != nil {
+			return "", fmt.Errorf("requested algorithm %s is not supported for replication: %w", name, err)
+		}
+		compressionVariants = append(compressionVariants, cp.OptionCompressionVariant{Algorithm: algo})
+	}
+
+	progressChan, stopProgress := progress.Forward(tracker)
+	defer stopProgress()
+	copyOptions := &cp.Options{
+		ImageListSelection:               options.ImageListSelection,
+		Instances:                        options.Instances,
+		SourceCtx:                        &sys,
+		DestinationCtx:                   &sys,
+		Progress:                         progressChan,
+		ProgressInterval:                 time.Second,
+		RemoveSignatures:                 options.RemoveSignatures,
+		Signers:                          options.Signers,
+		SignBy:                           options.SignBy,
+		SignPassphrase:                   options.SignPassphrase,
+		SignBySigstorePrivateKeyFile:     options.SignBySigstorePrivateKeyFile,
+		SignSigstorePrivateKeyPassphrase: options.SignSigstorePrivateKeyPassphrase,
+		ForceManifestMIMEType:            singleImageManifestType,
+		EnsureCompressionVariantsExist:   compressionVariants,
+		ForceCompressionFormat:           options.ForceCompressionFormat,
+	}
+	if config, err := ir.Libpod.GetConfigNoCopy(); err == nil {
+		copyOptions.MaxParallelDownloads = config.Engine.ImageParallelCopies
+	}
+
+	var manifestDigest digest.Digest
+	err = tracker.Run(ctx, progress.RetryOptions(options.MaxRetries, options.RetryDelay), func() error {
+		manifestBytes, err := cp.Image(ctx, policyContext, dest, src, copyOptions)
+		if err != nil {
+			return err
+		}
+		manifestDigest, err = manifest.Digest(manifestBytes)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	// libimage writes the push event of the other pushes.
+	ir.Libpod.NewImageEvent(events.Push, manifestList.ID(), destination)
+	return manifestDigest, nil
+}
+
 // ManifestListClear clears out all instances from the manifest list
-func (ir *ImageEngine) ManifestListClear(ctx context.Context, name string) (string, error) {
+func (ir *ImageEngine) ManifestListClear(ctx context.Context, name string) (_ string, retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.ManifestListClear")
+	defer span.EndWithError(&retErr)
+
 	manifestList, err := ir.Libpod.LibimageRuntime().LookupManifestList(name)
 	if err != nil {
 		return "", err
@@ -16,9 +16,13 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, netName string, options entities.NetworkUpdateOptions) error {
+func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, netName string, options entities.NetworkUpdateOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkUpdate")
+	defer span.EndWithError(&retErr)
+
 	var networkUpdateOptions types.NetworkUpdateOptions
 	networkUpdateOptions.AddDNSServers = options.AddDNSServers
 	networkUpdateOptions.RemoveDNSServers = options.RemoveDNSServers
@@ -29,7 +33,10 @@ func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, netName string, op
 	return nil
 }
 
-func (ic *ContainerEngine) NetworkList(ctx context.Context, options entities.NetworkListOptions) ([]types.Network, error) {
+func (ic *ContainerEngine) NetworkList(ctx context.Context, options entities.NetworkListOptions) (_ []types.Network, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkList")
+	defer span.EndWithError(&retErr)
+
 	// dangling filter is not provided by netutil
 	var wantDangling bool
 
@@ -67,7 +74,10 @@ func (ic *ContainerEngine) NetworkList(ctx context.Context, options entities.Net
 	return nets, err
 }
 
-func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []string, options entities.InspectOptions) ([]entities.NetworkInspectReport, []error, error) {
+func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []string, options entities.InspectOptions) (_ []entities.NetworkInspectReport, _ []error, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkInspect")
+	defer span.EndWithError(&retErr)
+
 	var errs []error
 	statuses, err := ic.GetContainerNetStatuses()
 	if err != nil {
@@ -104,7 +114,10 @@ func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []stri
 	return networks, errs, nil
 }
 
-func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, options entities.NetworkReloadOptions) ([]*entities.NetworkReloadReport, error) {
+func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, options entities.NetworkReloadOptions) (_ []*entities.NetworkReloadReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkReload")
+	defer span.EndWithError(&retErr)
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: names})
 	if err != nil {
 		return nil, err
@@ -126,7 +139,10 @@ func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, op
 	return reports, nil
 }
 
-func (ic *ContainerEngine) NetworkRm(ctx context.Context, namesOrIds []string, options entities.NetworkRmOptions) ([]*entities.NetworkRmReport, error) {
+func (ic *ContainerEngine) NetworkRm(ctx context.Context, namesOrIds []string, options entities.NetworkRmOptions) (_ []*entities.NetworkRmReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.NetworkRm")
+	defer span.EndWithError(&retErr)
+
 	reports := make([]*entities.NetworkRmReport, 0, len(namesOrIds))
 	for _, name := range namesOrIds {
 		report := entities.NetworkRmReport{Name: name}
@@ -179,7 +195,10 @@ func (ic *ContainerEngine) NetworkRm(ctx context.Context, namesOrIds []string, o
 	return reports, nil
 }
 
-func (ic *ContainerEngine) NetworkCreate(ctx context.Context, network types.Network, createOptions *types.NetworkCreateOptions) (*types.Network, error) {
+func (ic *ContainerEngine) NetworkCreate(ctx context.Context, network types.Network, createOptions *types.NetworkCreateOptions) (_ *types.Network, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkCreate")
+	defer span.EndWithError(&retErr)
+
 	if slices.Contains([]string{"none", "host", "bridge", "private", slirp4netns.BinaryName, pasta.BinaryName, "container", "ns", "default"}, network.Name) {
 		return nil, fmt.Errorf("cannot create network with name %q because it conflicts with a valid network mode", network.Name)
 	}
@@ -192,16 +211,25 @@ func (ic *ContainerEngine) NetworkCreate(ctx context.Context, network types.Netw
 }
 
 // NetworkDisconnect removes a container from a given network
-func (ic *ContainerEngine) NetworkDisconnect(ctx context.Context, networkname string, options entities.NetworkDisconnectOptions) error {
+func (ic *ContainerEngine) NetworkDisconnect(ctx context.Context, networkname string, options entities.NetworkDisconnectOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkDisconnect")
+	defer span.EndWithError(&retErr)
+
 	return ic.Libpod.DisconnectContainerFromNetwork(options.Container, networkname, options.Force)
 }
 
-func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname string, options entities.NetworkConnectOptions) error {
+func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname string, options entities.NetworkConnectOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkConnect")
+	defer span.EndWithError(&retErr)
+
 	return ic.Libpod.ConnectContainerToNetwork(options.Container, networkname, options.PerNetworkOptions)
 }
 
 // NetworkExists checks if the given network exists
-func (ic *ContainerEngine) NetworkExists(ctx context.Context, networkname string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) NetworkExists(ctx context.Context, networkname string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkExists")
+	defer span.EndWithError(&retErr)
+
 	_, err := ic.Libpod.Network().NetworkInspect(networkname)
 	exists := true
 	// if err is ErrNoSuchNetwork do not return it
@@ -216,7 +244,10 @@ func (ic *ContainerEngine) NetworkExists(ctx context.Context, networkname string
 }
 
 // Network prune removes unused networks
-func (ic *ContainerEngine) NetworkPrune(ctx context.Context, options entities.NetworkPruneOptions) ([]*entities.NetworkPruneReport, error) {
+func (ic *ContainerEngine) NetworkPrune(ctx context.Context, options entities.NetworkPruneOptions) (_ []*entities.NetworkPruneReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.NetworkPrune")
+	defer span.EndWithError(&retErr)
+
 	// get all filters
 	filters, err := netutil.GenerateNetworkPruneFilters(options.Filters)
 	if err != nil {
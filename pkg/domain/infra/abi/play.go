@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -37,6 +39,7 @@ import (
 	"github.com/containers/podman/v5/pkg/specgen/generate/kube"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/systemd/notifyproxy"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/podman/v5/utils"
 	"github.com/containers/storage/pkg/fileutils"
@@ -236,6 +239,9 @@ func k8sName(content []byte, suffix string) string {
 }
 
 func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options entities.PlayKubeOptions) (_ *entities.PlayKubeReport, finalErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PlayKube")
+	defer span.EndWithError(&finalErr)
+
 	if options.ServiceContainer && options.Start == types.OptionalBoolFalse { // Sanity check to be future proof
 		return nil, fmt.Errorf("running a service container requires starting the pod(s)")
 	}
@@ -755,6 +761,24 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		return nil, nil, err
 	}
 
+	pullSecrets, err := kube.LoadPullSecrets(podYAML.Spec.ImagePullSecrets, secretsManager)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pullSecretNames []string
+	if pullSecrets != nil {
+		pullSecretNames = pullSecrets.Names
+	}
+
+	// Map the runtime class to the OCI runtime with the same name
+	var ociRuntime string
+	if podYAML.Spec.RuntimeClassName != nil && *podYAML.Spec.RuntimeClassName != "" {
+		ociRuntime = *podYAML.Spec.RuntimeClassName
+		if _, ok := cfg.Engine.OCIRuntimes[ociRuntime]; !ok {
+			return nil, nil, fmt.Errorf("runtime class %q does not match an OCI runtime in containers.conf: %w", ociRuntime, define.ErrInvalidArg)
+		}
+	}
+
 	volumes, err := kube.InitializeVolumes(podYAML.Spec.Volumes, configMaps, secretsManager, mountLabel)
 	if err != nil {
 		return nil, nil, err
@@ -814,7 +838,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 				}
 			}
 
-			_, err := ic.buildOrPullImage(ctx, cwd, writer, v.Source, v.ImagePullPolicy, options)
+			_, err := ic.buildOrPullImage(ctx, cwd, writer, v.Source, v.ImagePullPolicy, pullSecrets, options)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -928,7 +952,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		if initCtr.Lifecycle != nil || initCtr.LivenessProbe != nil || initCtr.ReadinessProbe != nil || initCtr.StartupProbe != nil {
 			return nil, nil, fmt.Errorf("cannot create an init container that has either of lifecycle, livenessProbe, readinessProbe, or startupProbe set")
 		}
-		pulledImage, labels, err := ic.getImageAndLabelInfo(ctx, cwd, annotations, writer, initCtr, options)
+		pulledImage, labels, err := ic.getImageAndLabelInfo(ctx, cwd, annotations, writer, initCtr, pullSecrets, options)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -976,6 +1000,8 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			VolumesFrom:        volumesFrom,
 			ImageVolumes:       automountImages,
 			UtsNSIsHost:        p.UtsNs.IsHost(),
+			OCIRuntime:         ociRuntime,
+			ImagePullSecrets:   pullSecretNames,
 		}
 		specGen, err := kube.ToSpecGen(ctx, &specgenOpts)
 		if err != nil {
@@ -1012,19 +1038,18 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		initContainers = append(initContainers, ctr)
 	}
 
-	// Callers are expected to close the proxies
-	var sdNotifyProxies []*notifyproxy.NotifyProxy
-
-	for _, container := range podYAML.Spec.Containers {
+	// makeContainerSpec returns the spec of a regular or ephemeral
+	// container of the pod.
+	makeContainerSpec := func(container v1.Container, restartPolicy string) (*specgen.SpecGenerator, error) {
 		// Error out if the same name is used for more than one container
 		if _, ok := ctrNames[container.Name]; ok {
-			return nil, nil, fmt.Errorf("the pod %q is invalid; duplicate container name %q detected", podName, container.Name)
+			return nil, fmt.Errorf("the pod %q is invalid; duplicate container name %q detected", podName, container.Name)
 		}
 
 		ctrNames[container.Name] = ""
-		pulledImage, labels, err := ic.getImageAndLabelInfo(ctx, cwd, annotations, writer, container, options)
+		pulledImage, labels, err := ic.getImageAndLabelInfo(ctx, cwd, annotations, writer, container, pullSecrets, options)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		for k, v := range podSpec.PodSpecGen.Labels { // add podYAML labels
@@ -1033,12 +1058,12 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 
 		automountImages, err := ic.prepareAutomountImages(ctx, container.Name, annotations)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		var volumesFrom []string
 		if list, err := prepareVolumesFrom(container.Name, podName, ctrNames, annotations); err != nil {
-			return nil, nil, err
+			return nil, err
 		} else if list != nil {
 			volumesFrom = list
 		}
@@ -1058,7 +1083,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			PodInfraID:         podInfraID,
 			PodName:            podName,
 			PodSecurityContext: podYAML.Spec.SecurityContext,
-			RestartPolicy:      podSpec.PodSpecGen.RestartPolicy, // pass the restart policy to the container (https://github.com/containers/podman/issues/20903)
+			RestartPolicy:      restartPolicy,
 			ReadOnly:           readOnly,
 			SeccompPaths:       seccompPaths,
 			SecretsManager:     secretsManager,
@@ -1067,29 +1092,52 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			VolumesFrom:        volumesFrom,
 			ImageVolumes:       automountImages,
 			UtsNSIsHost:        p.UtsNs.IsHost(),
+			OCIRuntime:         ociRuntime,
+			ImagePullSecrets:   pullSecretNames,
 		}
 
 		if podYAML.Spec.TerminationGracePeriodSeconds != nil {
 			specgenOpts.TerminationGracePeriodSeconds = podYAML.Spec.TerminationGracePeriodSeconds
 		}
 
-		specGen, err := kube.ToSpecGen(ctx, &specgenOpts)
-		if err != nil {
-			return nil, nil, err
-		}
+		return kube.ToSpecGen(ctx, &specgenOpts)
+	}
 
+	// createContainer completes the spec of a container of the pod and
+	// creates the container with the additional options.
+	createContainer := func(specGen *specgen.SpecGenerator, rawImageName string, ctrOpts ...libpod.CtrCreateOption) (*libpod.Container, error) {
 		// Make sure to complete the spec (#17016)
 		warn, err := generate.CompleteSpec(ctx, ic.Libpod, specGen)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		for _, w := range warn {
 			logrus.Warn(w)
 		}
 
-		specGen.RawImageName = container.Image
+		specGen.RawImageName = rawImageName
 		expandForKube(specGen)
 		rtSpec, spec, opts, err := generate.MakeContainer(ctx, ic.Libpod, specGen, false, nil)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, ctrOpts...)
+
+		if options.Replace {
+			if _, err := ic.ContainerRm(ctx, []string{spec.Name}, entities.RmOptions{Force: true, Ignore: true}); err != nil {
+				return nil, err
+			}
+		}
+
+		return generate.ExecuteCreate(ctx, ic.Libpod, rtSpec, spec, false, opts...)
+	}
+
+	// Callers are expected to close the proxies
+	var sdNotifyProxies []*notifyproxy.NotifyProxy
+
+	for _, container := range podYAML.Spec.Containers {
+		// pass the restart policy to the container (https://github.com/containers/podman/issues/20903)
+		specGen, err := makeContainerSpec(container, podSpec.PodSpecGen.RestartPolicy)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1106,7 +1154,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			sdNotifyMode = define.SdNotifyModeIgnore
 		}
 
-		opts = append(opts, libpod.WithSdNotifyMode(sdNotifyMode))
+		opts := []libpod.CtrCreateOption{libpod.WithSdNotifyMode(sdNotifyMode)}
 
 		var proxy *notifyproxy.NotifyProxy
 		// Create a notify proxy for the container.
@@ -1119,13 +1167,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			opts = append(opts, libpod.WithSdNotifySocket(proxy.SocketPath()))
 		}
 
-		if options.Replace {
-			if _, err := ic.ContainerRm(ctx, []string{spec.Name}, entities.RmOptions{Force: true, Ignore: true}); err != nil {
-				return nil, nil, err
-			}
-		}
-
-		ctr, err := generate.ExecuteCreate(ctx, ic.Libpod, rtSpec, spec, false, opts...)
+		ctr, err := createContainer(specGen, container.Image, opts...)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1135,6 +1177,35 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		containers = append(containers, ctr)
 	}
 
+	// Ephemeral containers are never restarted and, if they have a
+	// target, join the PID namespace of the target container.
+	for _, ephemeral := range podYAML.Spec.EphemeralContainers {
+		container := v1.Container(ephemeral.EphemeralContainerCommon)
+		target := ephemeral.TargetContainerName
+		if target != "" && !slices.ContainsFunc(podYAML.Spec.Containers, func(c v1.Container) bool { return c.Name == target }) {
+			return nil, nil, fmt.Errorf("the pod %q is invalid; target container %q of ephemeral container %q does not exist", podName, target, container.Name)
+		}
+
+		specGen, err := makeContainerSpec(container, define.RestartPolicyNo)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The annotations are shared by all containers of the pod.
+		specGen.Annotations = maps.Clone(specGen.Annotations)
+		if specGen.Annotations == nil {
+			specGen.Annotations = make(map[string]string)
+		}
+		specGen.Annotations[define.EphemeralContainerAnnotation] = target
+		if target != "" && !p.Pid.IsHost() && (podYAML.Spec.ShareProcessNamespace == nil || !*podYAML.Spec.ShareProcessNamespace) {
+			specGen.PidNS = specgen.Namespace{NSMode: specgen.FromContainer, Value: fmt.Sprintf("%s-%s", podName, target)}
+		}
+
+		ctr, err := createContainer(specGen, container.Image, libpod.WithSdNotifyMode(define.SdNotifyModeIgnore))
+		if err != nil {
+			return nil, nil, err
+		}
+		containers = append(containers, ctr)
+	}
 	if options.Start != types.OptionalBoolFalse {
 		// Start the containers
 		podStartErrors, err := pod.Start(ctx)
@@ -1229,7 +1300,9 @@ func (ic *ContainerEngine) buildImageFromContainerfile(ctx context.Context, cwd
 // If the PullPolicy is not set:
 // - use PullPolicyNewer if the image tag is set to "latest" or is not set
 // - use PullPolicyMissing the policy is set to PullPolicyNewer.
-func (ic *ContainerEngine) pullImageWithPolicy(ctx context.Context, writer io.Writer, image string, policy v1.PullPolicy, options entities.PlayKubeOptions) (*libimage.Image, error) {
+// Credentials from the image pull secrets of the pod are used unless
+// credentials are set in the options.
+func (ic *ContainerEngine) pullImageWithPolicy(ctx context.Context, writer io.Writer, image string, policy v1.PullPolicy, pullSecrets *kube.PullSecrets, options entities.PlayKubeOptions) (*libimage.Image, error) {
 	pullPolicy := config.PullPolicyMissing
 	if len(policy) > 0 {
 		// Make sure to lower the strings since K8s pull policy
@@ -1258,8 +1331,13 @@ func (ic *ContainerEngine) pullImageWithPolicy(ctx context.Context, writer io.Wr
 	pullOptions.Username = options.Username
 	pullOptions.Password = options.Password
 	pullOptions.InsecureSkipTLSVerify = options.SkipTLSVerify
+	if auth := pullSecrets.Credentials(image); auth != nil && options.Username == "" && options.Password == "" {
+		pullOptions.Username = auth.Username
+		pullOptions.Password = auth.Password
+		pullOptions.IdentityToken = auth.IdentityToken
+	}
 
-	pulledImages, err := ic.Libpod.LibimageRuntime().Pull(ctx, image, pullPolicy, pullOptions)
+	pulledImages, err := ic.Libpod.PullImage(ctx, image, pullPolicy, pullOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -1269,7 +1347,7 @@ func (ic *ContainerEngine) pullImageWithPolicy(ctx context.Context, writer io.Wr
 // buildOrPullImage builds the image if a Containerfile is present in a directory
 // with the name of the image. It pulls the image otherwise. It returns the image
 // details.
-func (ic *ContainerEngine) buildOrPullImage(ctx context.Context, cwd string, writer io.Writer, image string, policy v1.PullPolicy, options entities.PlayKubeOptions) (*libimage.Image, error) {
+func (ic *ContainerEngine) buildOrPullImage(ctx context.Context, cwd string, writer io.Writer, image string, policy v1.PullPolicy, pullSecrets *kube.PullSecrets, options entities.PlayKubeOptions) (*libimage.Image, error) {
 	buildImage, err := ic.buildImageFromContainerfile(ctx, cwd, writer, image, options)
 	if err != nil {
 		return nil, err
@@ -1277,17 +1355,17 @@ func (ic *ContainerEngine) buildOrPullImage(ctx context.Context, cwd string, wri
 	if buildImage != nil {
 		return buildImage, nil
 	} else {
-		return ic.pullImageWithPolicy(ctx, writer, image, policy, options)
+		return ic.pullImageWithPolicy(ctx, writer, image, policy, pullSecrets, options)
 	}
 }
 
 // getImageAndLabelInfo returns the image information and how the image should be pulled plus as well as labels to be used for the container in the pod.
 // Moved this to a separate function so that it can be used for both init and regular containers when playing a kube yaml.
-func (ic *ContainerEngine) getImageAndLabelInfo(ctx context.Context, cwd string, annotations map[string]string, writer io.Writer, container v1.Container, options entities.PlayKubeOptions) (*libimage.Image, map[string]string, error) {
+func (ic *ContainerEngine) getImageAndLabelInfo(ctx context.Context, cwd string, annotations map[string]string, writer io.Writer, container v1.Container, pullSecrets *kube.PullSecrets, options entities.PlayKubeOptions) (*libimage.Image, map[string]string, error) {
 	// Contains all labels obtained from kube
 	labels := make(map[string]string)
 
-	pulledImage, err := ic.buildOrPullImage(ctx, cwd, writer, container.Image, container.ImagePullPolicy, options)
+	pulledImage, err := ic.buildOrPullImage(ctx, cwd, writer, container.Image, container.ImagePullPolicy, pullSecrets, options)
 	if err != nil {
 		return nil, labels, err
 	}
@@ -1649,7 +1727,10 @@ func getBuildFile(imageName string, cwd string) (string, error) {
 	return "", err
 }
 
-func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, options entities.PlayKubeDownOptions) (*entities.PlayKubeReport, error) {
+func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, options entities.PlayKubeDownOptions) (_ *entities.PlayKubeReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PlayKubeDown")
+	defer span.EndWithError(&retErr)
+
 	var (
 		podNames    []string
 		volumeNames []string
@@ -13,9 +13,12 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	dfilters "github.com/containers/podman/v5/pkg/domain/filters"
+	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/parallel"
 	"github.com/containers/podman/v5/pkg/signal"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/specgen/generate"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/sirupsen/logrus"
 )
 
@@ -50,7 +53,10 @@ func getPodsByContext(all, latest bool, pods []string, runtime *libpod.Runtime)
 	return outpods, err
 }
 
-func (ic *ContainerEngine) PodExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) PodExists(ctx context.Context, nameOrID string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodExists")
+	defer span.EndWithError(&retErr)
+
 	_, err := ic.Libpod.LookupPod(nameOrID)
 	if err != nil && !errors.Is(err, define.ErrNoSuchPod) {
 		return nil, err
@@ -58,7 +64,10 @@ func (ic *ContainerEngine) PodExists(ctx context.Context, nameOrID string) (*ent
 	return &entities.BoolReport{Value: err == nil}, nil
 }
 
-func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, options entities.PodKillOptions) ([]*entities.PodKillReport, error) {
+func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, options entities.PodKillOptions) (_ []*entities.PodKillReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodKill")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.PodKillReport{}
 	sig, err := signal.ParseSignalNameOrNumber(options.Signal)
 	if err != nil {
@@ -89,7 +98,10 @@ func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, opt
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrID string, options entities.PodLogsOptions) error {
+func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrID string, options entities.PodLogsOptions) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodLogs")
+	defer span.EndWithError(&retErr)
+
 	// Implementation accepts slice
 	podName := []string{nameOrID}
 	pod, err := getPodsByContext(false, options.Latest, podName, ic.Libpod)
@@ -129,7 +141,10 @@ func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrID string, options
 	return ic.ContainerLogs(ctx, ctrNames, containerLogsOpts)
 }
 
-func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, options entities.PodPauseOptions) ([]*entities.PodPauseReport, error) {
+func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, options entities.PodPauseOptions) (_ []*entities.PodPauseReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodPause")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.PodPauseReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil {
@@ -155,7 +170,10 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
+func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) (_ []*entities.PodUnpauseReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodUnpause")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.PodUnpauseReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil {
@@ -189,36 +207,47 @@ func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string,
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, options entities.PodStopOptions) ([]*entities.PodStopReport, error) {
-	reports := []*entities.PodStopReport{}
+func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, options entities.PodStopOptions) (_ []*entities.PodStopReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodStop")
+	defer span.EndWithError(&retErr)
+
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil && !(options.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
-	for _, p := range pods {
-		report := entities.PodStopReport{
+	reports := make([]*entities.PodStopReport, len(pods))
+	for i, p := range pods {
+		reports[i] = &entities.PodStopReport{
 			Id:       p.ID(),
 			RawInput: p.Name(),
 		}
-		errs, err := p.StopWithTimeout(ctx, true, options.Timeout)
+	}
+	// Stopping a pod queues the jobs of its containers.
+	runErrs := parallel.RunUnqueued(ctx, len(pods), options.BulkOptions.SerialByDefault(), func(i int) error {
+		report := reports[i]
+		errs, err := pods[i].StopWithTimeout(ctx, true, options.Timeout)
 		if err != nil && !errors.Is(err, define.ErrPodPartialFail) {
 			report.Errs = []error{err}
-			reports = append(reports, &report)
-			continue
+			return err
 		}
-		if len(errs) > 0 {
-			for id, v := range errs {
-				report.Errs = append(report.Errs, fmt.Errorf("stopping container %s: %w", id, v))
-			}
-			reports = append(reports, &report)
-			continue
+		for id, v := range errs {
+			report.Errs = append(report.Errs, fmt.Errorf("stopping container %s: %w", id, v))
+		}
+		return errorhandling.JoinErrors(report.Errs)
+	})
+	for i, err := range runErrs {
+		// Pods which were not stopped, e.g. with --fail-fast
+		if err != nil && len(reports[i].Errs) == 0 {
+			reports[i].Errs = []error{err}
 		}
-		reports = append(reports, &report)
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string, options entities.PodRestartOptions) ([]*entities.PodRestartReport, error) {
+func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string, options entities.PodRestartOptions) (_ []*entities.PodRestartReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodRestart")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.PodRestartReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil {
@@ -244,55 +273,71 @@ func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string,
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, options entities.PodStartOptions) ([]*entities.PodStartReport, error) {
-	reports := []*entities.PodStartReport{}
+func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, options entities.PodStartOptions) (_ []*entities.PodStartReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodStart")
+	defer span.EndWithError(&retErr)
+
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, p := range pods {
-		report := entities.PodStartReport{
+	reports := make([]*entities.PodStartReport, len(pods))
+	for i, p := range pods {
+		reports[i] = &entities.PodStartReport{
 			Id:       p.ID(),
 			RawInput: p.Name(),
 		}
-		errs, err := p.Start(ctx)
+	}
+	// Starting a pod queues the jobs of its containers.
+	runErrs := parallel.RunUnqueued(ctx, len(pods), options.BulkOptions.SerialByDefault(), func(i int) error {
+		report := reports[i]
+		errs, err := pods[i].Start(ctx)
 		if err != nil && !errors.Is(err, define.ErrPodPartialFail) {
 			report.Errs = []error{err}
-			reports = append(reports, &report)
-			continue
+			return err
 		}
-		if len(errs) > 0 {
-			for id, v := range errs {
-				report.Errs = append(report.Errs, fmt.Errorf("starting container %s: %w", id, v))
-			}
-			reports = append(reports, &report)
-			continue
+		for id, v := range errs {
+			report.Errs = append(report.Errs, fmt.Errorf("starting container %s: %w", id, v))
+		}
+		return errorhandling.JoinErrors(report.Errs)
+	})
+	for i, err := range runErrs {
+		// Pods which were not started, e.g. with --fail-fast
+		if err != nil && len(reports[i].Errs) == 0 {
+			reports[i].Errs = []error{err}
 		}
-		reports = append(reports, &report)
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, options entities.PodRmOptions) ([]*entities.PodRmReport, error) {
+func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, options entities.PodRmOptions) (_ []*entities.PodRmReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodRm")
+	defer span.EndWithError(&retErr)
+
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil && !(options.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
-	reports := make([]*entities.PodRmReport, 0, len(pods))
-	for _, p := range pods {
-		report := entities.PodRmReport{Id: p.ID()}
-		ctrs, err := ic.Libpod.RemovePod(ctx, p, true, options.Force, options.Timeout)
-		if err != nil {
-			report.Err = err
-		}
-		report.RemovedCtrs = ctrs
-		reports = append(reports, &report)
+	reports := make([]*entities.PodRmReport, len(pods))
+	for i, p := range pods {
+		reports[i] = &entities.PodRmReport{Id: p.ID()}
+	}
+	errs := parallel.Run(ctx, len(pods), options.BulkOptions.SerialByDefault(), func(i int) error {
+		ctrs, err := ic.Libpod.RemovePod(ctx, pods[i], true, options.Force, options.Timeout)
+		reports[i].RemovedCtrs = ctrs
+		return err
+	})
+	for i, err := range errs {
+		reports[i].Err = err
 	}
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodPrune(ctx context.Context, options entities.PodPruneOptions) ([]*entities.PodPruneReport, error) {
+func (ic *ContainerEngine) PodPrune(ctx context.Context, options entities.PodPruneOptions) (_ []*entities.PodPruneReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodPrune")
+	defer span.EndWithError(&retErr)
+
 	return ic.prunePodHelper(ctx)
 }
 
@@ -311,7 +356,10 @@ func (ic *ContainerEngine) prunePodHelper(ctx context.Context) ([]*entities.PodP
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodCreate(ctx context.Context, specg entities.PodSpec) (*entities.PodCreateReport, error) {
+func (ic *ContainerEngine) PodCreate(ctx context.Context, specg entities.PodSpec) (_ *entities.PodCreateReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodCreate")
+	defer span.EndWithError(&retErr)
+
 	pod, err := generate.MakePod(&specg, ic.Libpod)
 	if err != nil {
 		return nil, err
@@ -319,7 +367,10 @@ func (ic *ContainerEngine) PodCreate(ctx context.Context, specg entities.PodSpec
 	return &entities.PodCreateReport{Id: pod.ID()}, nil
 }
 
-func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCloneOptions) (*entities.PodCloneReport, error) {
+func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCloneOptions) (_ *entities.PodCloneReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.PodClone")
+	defer span.EndWithError(&retErr)
+
 	spec := specgen.NewPodSpecGenerator()
 	p, err := generate.PodConfigToSpec(ic.Libpod, spec, &podClone.InfraOptions, podClone.ID)
 	if err != nil {
@@ -404,7 +455,10 @@ func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCl
 	return &entities.PodCloneReport{Id: pod.ID()}, nil
 }
 
-func (ic *ContainerEngine) PodTop(ctx context.Context, options entities.PodTopOptions) (*entities.StringSliceReport, error) {
+func (ic *ContainerEngine) PodTop(ctx context.Context, options entities.PodTopOptions) (_ *entities.StringSliceReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodTop")
+	defer span.EndWithError(&retErr)
+
 	var (
 		pod *libpod.Pod
 		err error
@@ -481,7 +535,10 @@ func (ic *ContainerEngine) listPodReportFromPod(p *libpod.Pod) (*entities.ListPo
 	}, nil
 }
 
-func (ic *ContainerEngine) PodPs(ctx context.Context, options entities.PodPSOptions) ([]*entities.ListPodsReport, error) {
+func (ic *ContainerEngine) PodPs(ctx context.Context, options entities.PodPSOptions) (_ []*entities.ListPodsReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodPs")
+	defer span.EndWithError(&retErr)
+
 	var (
 		err error
 		pds = []*libpod.Pod{}
@@ -522,7 +579,10 @@ func (ic *ContainerEngine) PodPs(ctx context.Context, options entities.PodPSOpti
 	return reports, nil
 }
 
-func (ic *ContainerEngine) PodInspect(ctx context.Context, nameOrIDs []string, options entities.InspectOptions) ([]*entities.PodInspectReport, []error, error) {
+func (ic *ContainerEngine) PodInspect(ctx context.Context, nameOrIDs []string, options entities.InspectOptions) (_ []*entities.PodInspectReport, _ []error, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodInspect")
+	defer span.EndWithError(&retErr)
+
 	if options.Latest {
 		pod, err := ic.Libpod.GetLatestPod()
 		if err != nil {
@@ -13,11 +13,15 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/docker/go-units"
 )
 
 // PodStats implements printing stats about pods.
-func (ic *ContainerEngine) PodStats(ctx context.Context, namesOrIds []string, options entities.PodStatsOptions) ([]*entities.PodStatsReport, error) {
+func (ic *ContainerEngine) PodStats(ctx context.Context, namesOrIds []string, options entities.PodStatsOptions) (_ []*entities.PodStatsReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.PodStats")
+	defer span.EndWithError(&retErr)
+
 	// Cgroups v2 check for rootless.
 	if rootless.IsRootless() {
 		unified, err := cgroups.IsCgroup2UnifiedMode()
@@ -13,9 +13,13 @@ import (
 	"github.com/containers/common/pkg/secrets"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/utils"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader io.Reader, options entities.SecretCreateOptions) (*entities.SecretCreateReport, error) {
+func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader io.Reader, options entities.SecretCreateOptions) (_ *entities.SecretCreateReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.SecretCreate")
+	defer span.EndWithError(&retErr)
+
 	data, _ := io.ReadAll(reader)
 	secretsPath := ic.Libpod.GetSecretsStorageDir()
 	manager, err := ic.Libpod.SecretsManager()
@@ -61,7 +65,10 @@ func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader
 	}, nil
 }
 
-func (ic *ContainerEngine) SecretInspect(ctx context.Context, nameOrIDs []string, options entities.SecretInspectOptions) ([]*entities.SecretInfoReport, []error, error) {
+func (ic *ContainerEngine) SecretInspect(ctx context.Context, nameOrIDs []string, options entities.SecretInspectOptions) (_ []*entities.SecretInfoReport, _ []error, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.SecretInspect")
+	defer span.EndWithError(&retErr)
+
 	var (
 		secret *secrets.Secret
 		data   []byte
@@ -98,7 +105,10 @@ func (ic *ContainerEngine) SecretInspect(ctx context.Context, nameOrIDs []string
 	return reports, errs, nil
 }
 
-func (ic *ContainerEngine) SecretList(ctx context.Context, opts entities.SecretListRequest) ([]*entities.SecretInfoReport, error) {
+func (ic *ContainerEngine) SecretList(ctx context.Context, opts entities.SecretListRequest) (_ []*entities.SecretInfoReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.SecretList")
+	defer span.EndWithError(&retErr)
+
 	manager, err := ic.Libpod.SecretsManager()
 	if err != nil {
 		return nil, err
@@ -120,7 +130,10 @@ func (ic *ContainerEngine) SecretList(ctx context.Context, opts entities.SecretL
 	return report, nil
 }
 
-func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, options entities.SecretRmOptions) ([]*entities.SecretRmReport, error) {
+func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, options entities.SecretRmOptions) (_ []*entities.SecretRmReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.SecretRm")
+	defer span.EndWithError(&retErr)
+
 	var (
 		err      error
 		toRemove []string
@@ -151,7 +164,10 @@ func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, opt
 	return reports, nil
 }
 
-func (ic *ContainerEngine) SecretExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) SecretExists(ctx context.Context, nameOrID string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.SecretExists")
+	defer span.EndWithError(&retErr)
+
 	manager, err := ic.Libpod.SecretsManager()
 	if err != nil {
 		return nil, err
@@ -15,6 +15,7 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage"
 	"github.com/containers/storage/pkg/directory"
@@ -22,7 +23,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func (ic *ContainerEngine) Info(ctx context.Context) (*define.Info, error) {
+func (ic *ContainerEngine) Info(ctx context.Context) (_ *define.Info, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Info")
+	defer span.EndWithError(&retErr)
+
 	info, err := ic.Libpod.Info()
 	if err != nil {
 		return nil, err
@@ -62,7 +66,10 @@ func (ic *ContainerEngine) Info(ctx context.Context) (*define.Info, error) {
 }
 
 // SystemPrune removes unused data from the system. Pruning pods, containers, networks, volumes and images.
-func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.SystemPruneOptions) (*entities.SystemPruneReport, error) {
+func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.SystemPruneOptions) (_ *entities.SystemPruneReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.SystemPrune")
+	defer span.EndWithError(&retErr)
+
 	var systemPruneReport = new(entities.SystemPruneReport)
 
 	if options.External {
@@ -164,7 +171,10 @@ func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.Sys
 	return systemPruneReport, nil
 }
 
-func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (_ *entities.SystemDfReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.SystemDf")
+	defer span.EndWithError(&retErr)
+
 	var (
 		dfImages = []*entities.SystemDfImageReport{}
 	)
@@ -284,18 +294,38 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.System
 	}, nil
 }
 
-func (ic *ContainerEngine) Reset(ctx context.Context) error {
+func (ic *ContainerEngine) Reset(ctx context.Context) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.Reset")
+	defer span.EndWithError(&retErr)
+
 	return ic.Libpod.Reset(ctx)
 }
 
-func (ic *ContainerEngine) Renumber(ctx context.Context) error {
+func (ic *ContainerEngine) Renumber(ctx context.Context) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Renumber")
+	defer span.EndWithError(&retErr)
+
 	return ic.Libpod.RenumberLocks()
 }
 
-func (ic *ContainerEngine) Migrate(ctx context.Context, options entities.SystemMigrateOptions) error {
+func (ic *ContainerEngine) Migrate(ctx context.Context, options entities.SystemMigrateOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Migrate")
+	defer span.EndWithError(&retErr)
+
 	return ic.Libpod.Migrate(options.NewRuntime)
 }
 
+func (ic *ContainerEngine) SystemStorageMigrate(ctx context.Context, options entities.SystemStorageMigrateOptions) (_ *entities.SystemStorageMigrateReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.SystemStorageMigrate")
+	defer span.EndWithError(&retErr)
+
+	report, err := ic.Libpod.MigrateStorage(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
 func (se SystemEngine) Shutdown(ctx context.Context) {
 	if err := se.Libpod.Shutdown(false); err != nil {
 		logrus.Error(err)
@@ -308,7 +338,10 @@ func unshareEnv(graphroot, runroot string) []string {
 		fmt.Sprintf("CONTAINERS_RUNROOT=%s", runroot))
 }
 
-func (ic *ContainerEngine) Unshare(ctx context.Context, args []string, options entities.SystemUnshareOptions) error {
+func (ic *ContainerEngine) Unshare(ctx context.Context, args []string, options entities.SystemUnshareOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Unshare")
+	defer span.EndWithError(&retErr)
+
 	unshare := func() error {
 		cmd := exec.Command(args[0], args[1:]...)
 		cmd.Env = unshareEnv(ic.Libpod.StorageConfig().GraphRoot, ic.Libpod.StorageConfig().RunRoot)
@@ -324,7 +357,10 @@ func (ic *ContainerEngine) Unshare(ctx context.Context, args []string, options e
 	return unshare()
 }
 
-func (ic *ContainerEngine) Version(ctx context.Context) (*entities.SystemVersionReport, error) {
+func (ic *ContainerEngine) Version(ctx context.Context) (_ *entities.SystemVersionReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Version")
+	defer span.EndWithError(&retErr)
+
 	var report entities.SystemVersionReport
 	v, err := define.GetVersion()
 	if err != nil {
@@ -334,7 +370,10 @@ func (ic *ContainerEngine) Version(ctx context.Context) (*entities.SystemVersion
 	return &report, err
 }
 
-func (ic *ContainerEngine) Locks(ctx context.Context) (*entities.LocksReport, error) {
+func (ic *ContainerEngine) Locks(ctx context.Context) (_ *entities.LocksReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.Locks")
+	defer span.EndWithError(&retErr)
+
 	var report entities.LocksReport
 	conflicts, held, err := ic.Libpod.LockConflicts()
 	if err != nil {
@@ -345,7 +384,10 @@ func (ic *ContainerEngine) Locks(ctx context.Context) (*entities.LocksReport, er
 	return &report, nil
 }
 
-func (ic *ContainerEngine) SystemCheck(ctx context.Context, options entities.SystemCheckOptions) (*entities.SystemCheckReport, error) {
+func (ic *ContainerEngine) SystemCheck(ctx context.Context, options entities.SystemCheckOptions) (_ *entities.SystemCheckReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.SystemCheck")
+	defer span.EndWithError(&retErr)
+
 	report, err := ic.Libpod.SystemCheck(ctx, options)
 	if err != nil {
 		return nil, err
@@ -8,10 +8,14 @@ import (
 	"os"
 
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/tracing"
 	"github.com/containers/podman/v5/pkg/trust"
 )
 
-func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options entities.ShowTrustOptions) (*entities.ShowTrustReport, error) {
+func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options entities.ShowTrustOptions) (_ *entities.ShowTrustReport, retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.ShowTrust")
+	defer span.EndWithError(&retErr)
+
 	var (
 		err    error
 		report entities.ShowTrustReport
@@ -38,7 +42,10 @@ func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options ent
 	return &report, nil
 }
 
-func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options entities.SetTrustOptions) error {
+func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options entities.SetTrustOptions) (retErr error) {
+	_, span := tracing.Start(ctx, "ImageEngine.SetTrust")
+	defer span.EndWithError(&retErr)
+
 	if len(args) != 1 {
 		return fmt.Errorf("SetTrust called with unexpected %d args", len(args))
 	}
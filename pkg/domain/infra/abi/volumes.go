@@ -13,9 +13,14 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/domain/filters"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/parse"
+	"github.com/containers/podman/v5/pkg/parallel"
+	"github.com/containers/podman/v5/pkg/tracing"
 )
 
-func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
+func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (_ *entities.IDOrNameResponse, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.VolumeCreate")
+	defer span.EndWithError(&retErr)
+
 	var (
 		volumeOptions []libpod.VolumeCreateOption
 	)
@@ -47,44 +52,60 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 	return &entities.IDOrNameResponse{IDOrName: vol.Name()}, nil
 }
 
-func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
-	var (
-		err     error
-		vols    []*libpod.Volume
-		reports = []*entities.VolumeRmReport{}
-	)
+func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) (_ []*entities.VolumeRmReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.VolumeRm")
+	defer span.EndWithError(&retErr)
 
+	lookup := func(i int) (*libpod.Volume, error) {
+		return ic.Libpod.LookupVolume(namesOrIds[i])
+	}
 	if opts.All {
-		vols, err = ic.Libpod.Volumes()
+		vols, err := ic.Libpod.Volumes()
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		for _, id := range namesOrIds {
-			vol, err := ic.Libpod.LookupVolume(id)
-			if err != nil {
-				if opts.Ignore && errors.Is(err, define.ErrNoSuchVolume) {
-					continue
-				}
-				reports = append(reports, &entities.VolumeRmReport{
-					Err: err,
-					Id:  id,
-				})
+		namesOrIds = make([]string, len(vols))
+		for i, vol := range vols {
+			namesOrIds[i] = vol.Name()
+		}
+		lookup = func(i int) (*libpod.Volume, error) {
+			return vols[i], nil
+		}
+	}
+
+	// Volumes are looked up by the jobs, so that --fail-fast skips the
+	// remaining volumes if a volume does not exist.
+	reports := make([]*entities.VolumeRmReport, len(namesOrIds))
+	errs := parallel.Run(ctx, len(namesOrIds), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		vol, err := lookup(i)
+		if err != nil {
+			if opts.Ignore && errors.Is(err, define.ErrNoSuchVolume) {
+				return nil
+			}
+			return err
+		}
+		reports[i] = &entities.VolumeRmReport{Id: vol.Name()}
+		return ic.Libpod.RemoveVolume(ctx, vol, opts.Force, opts.Timeout)
+	})
+	removed := []*entities.VolumeRmReport{}
+	for i, report := range reports {
+		if report == nil {
+			if errs[i] == nil {
+				// Ignored missing volume
 				continue
 			}
-			vols = append(vols, vol)
+			report = &entities.VolumeRmReport{Id: namesOrIds[i]}
 		}
+		report.Err = errs[i]
+		removed = append(removed, report)
 	}
-	for _, vol := range vols {
-		reports = append(reports, &entities.VolumeRmReport{
-			Err: ic.Libpod.RemoveVolume(ctx, vol, opts.Force, opts.Timeout),
-			Id:  vol.Name(),
-		})
-	}
-	return reports, nil
+	return removed, nil
 }
 
-func (ic *ContainerEngine) VolumeInspect(ctx context.Context, namesOrIds []string, opts entities.InspectOptions) ([]*entities.VolumeInspectReport, []error, error) {
+func (ic *ContainerEngine) VolumeInspect(ctx context.Context, namesOrIds []string, opts entities.InspectOptions) (_ []*entities.VolumeInspectReport, _ []error, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeInspect")
+	defer span.EndWithError(&retErr)
+
 	var (
 		err  error
 		errs []error
@@ -126,7 +147,10 @@ func (ic *ContainerEngine) VolumeInspect(ctx context.Context, namesOrIds []strin
 	return reports, errs, nil
 }
 
-func (ic *ContainerEngine) VolumePrune(ctx context.Context, options entities.VolumePruneOptions) ([]*reports.PruneReport, error) {
+func (ic *ContainerEngine) VolumePrune(ctx context.Context, options entities.VolumePruneOptions) (_ []*reports.PruneReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.VolumePrune")
+	defer span.EndWithError(&retErr)
+
 	funcs := []libpod.VolumeFilter{}
 	for filter, filterValues := range options.Filters {
 		filterFunc, err := filters.GenerateVolumeFilters(filter, filterValues, ic.Libpod)
@@ -146,7 +170,10 @@ func (ic *ContainerEngine) pruneVolumesHelper(ctx context.Context, filterFuncs [
 	return pruned, nil
 }
 
-func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeListOptions) ([]*entities.VolumeListReport, error) {
+func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeListOptions) (_ []*entities.VolumeListReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeList")
+	defer span.EndWithError(&retErr)
+
 	volumeFilters := []libpod.VolumeFilter{}
 	for filter, value := range opts.Filter {
 		filterFunc, err := filters.GenerateVolumeFilters(filter, value, ic.Libpod)
@@ -178,7 +205,10 @@ func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeL
 }
 
 // VolumeExists check if a given volume name exists
-func (ic *ContainerEngine) VolumeExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) VolumeExists(ctx context.Context, nameOrID string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeExists")
+	defer span.EndWithError(&retErr)
+
 	exists, err := ic.Libpod.HasVolume(nameOrID)
 	if err != nil {
 		return nil, err
@@ -187,7 +217,10 @@ func (ic *ContainerEngine) VolumeExists(ctx context.Context, nameOrID string) (*
 }
 
 // Volumemounted check if a given volume using plugin or filesystem is mounted or not.
-func (ic *ContainerEngine) VolumeMounted(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
+func (ic *ContainerEngine) VolumeMounted(ctx context.Context, nameOrID string) (_ *entities.BoolReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeMounted")
+	defer span.EndWithError(&retErr)
+
 	vol, err := ic.Libpod.LookupVolume(nameOrID)
 	if err != nil {
 		return nil, err
@@ -203,7 +236,10 @@ func (ic *ContainerEngine) VolumeMounted(ctx context.Context, nameOrID string) (
 	return &entities.BoolReport{Value: false}, nil
 }
 
-func (ic *ContainerEngine) VolumeMount(ctx context.Context, nameOrIDs []string) ([]*entities.VolumeMountReport, error) {
+func (ic *ContainerEngine) VolumeMount(ctx context.Context, nameOrIDs []string) (_ []*entities.VolumeMountReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeMount")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.VolumeMountReport{}
 	for _, name := range nameOrIDs {
 		report := entities.VolumeMountReport{Id: name}
@@ -219,7 +255,10 @@ func (ic *ContainerEngine) VolumeMount(ctx context.Context, nameOrIDs []string)
 	return reports, nil
 }
 
-func (ic *ContainerEngine) VolumeUnmount(ctx context.Context, nameOrIDs []string) ([]*entities.VolumeUnmountReport, error) {
+func (ic *ContainerEngine) VolumeUnmount(ctx context.Context, nameOrIDs []string) (_ []*entities.VolumeUnmountReport, retErr error) {
+	_, span := tracing.Start(ctx, "ContainerEngine.VolumeUnmount")
+	defer span.EndWithError(&retErr)
+
 	reports := []*entities.VolumeUnmountReport{}
 	for _, name := range nameOrIDs {
 		report := entities.VolumeUnmountReport{Id: name}
@@ -235,7 +274,10 @@ func (ic *ContainerEngine) VolumeUnmount(ctx context.Context, nameOrIDs []string
 	return reports, nil
 }
 
-func (ic *ContainerEngine) VolumeReload(ctx context.Context) (*entities.VolumeReloadReport, error) {
+func (ic *ContainerEngine) VolumeReload(ctx context.Context) (_ *entities.VolumeReloadReport, retErr error) {
+	ctx, span := tracing.Start(ctx, "ContainerEngine.VolumeReload")
+	defer span.EndWithError(&retErr)
+
 	report := ic.Libpod.UpdateVolumePlugins(ctx)
 	return &entities.VolumeReloadReport{VolumeReload: *report}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/containers/common/pkg/config"
@@ -22,6 +23,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/parallel"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage/types"
@@ -120,32 +122,32 @@ func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []strin
 	if to := opts.Timeout; to != nil {
 		options.WithTimeout(*to)
 	}
-	reports := []*entities.StopReport{}
-	for _, c := range ctrs {
-		report := entities.StopReport{
-			Id:       c.ID,
-			RawInput: idToRawInput[c.ID],
-		}
-		if err = containers.Stop(ic.ClientCtx, c.ID, options); err != nil {
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(ctrs), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		c := ctrs[i]
+		if err := containers.Stop(ic.ClientCtx, c.ID, options); err != nil {
 			// These first two are considered non-fatal under the right conditions
 			if strings.Contains(err.Error(), define.ErrCtrStopped.Error()) {
 				logrus.Debugf("Container %s is already stopped", c.ID)
-				reports = append(reports, &report)
-				continue
+				return nil
 			} else if opts.All && strings.Contains(err.Error(), define.ErrCtrStateInvalid.Error()) {
 				logrus.Debugf("Container %s is not running, could not stop", c.ID)
-				reports = append(reports, &report)
-				continue
+				return nil
 			}
 
 			// TODO we need to associate errors returned by http with common
 			// define.errors so that we can equity tests. this will allow output
 			// to be the same as the native client
-			report.Err = err
-			reports = append(reports, &report)
-			continue
+			return err
 		}
-		reports = append(reports, &report)
+		return nil
+	})
+	reports := make([]*entities.StopReport, 0, len(ctrs))
+	for i, c := range ctrs {
+		reports = append(reports, &entities.StopReport{
+			Id:       c.ID,
+			RawInput: idToRawInput[c.ID],
+			Err:      errs[i],
+		})
 	}
 	return reports, nil
 }
@@ -160,16 +162,26 @@ func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []strin
 		idToRawInput[ctrs[i].ID] = rawInputs[i]
 	}
 	options := new(containers.KillOptions).WithSignal(opts.Signal)
-	reports := make([]*entities.KillReport, 0, len(ctrs))
-	for _, c := range ctrs {
+	// Containers which are not running are left out with --all.
+	skip := make([]bool, len(ctrs))
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(ctrs), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		c := ctrs[i]
 		err := containers.Kill(ic.ClientCtx, c.ID, options)
 		if err != nil && opts.All && strings.Contains(err.Error(), define.ErrCtrStateInvalid.Error()) {
 			logrus.Debugf("Container %s is not running", c.ID)
+			skip[i] = true
+			return nil
+		}
+		return err
+	})
+	reports := make([]*entities.KillReport, 0, len(ctrs))
+	for i, c := range ctrs {
+		if skip[i] {
 			continue
 		}
 		reports = append(reports, &entities.KillReport{
 			Id:       c.ID,
-			Err:      err,
+			Err:      errs[i],
 			RawInput: idToRawInput[c.ID],
 		})
 	}
@@ -193,13 +205,22 @@ func (ic *ContainerEngine) ContainerRestart(ctx context.Context, namesOrIds []st
 	for i := range ctrs {
 		idToRawInput[ctrs[i].ID] = rawInputs[i]
 	}
-	for _, c := range ctrs {
-		if opts.Running && c.State != define.ContainerStateRunning.String() {
-			continue
+	if opts.Running {
+		running := ctrs[:0]
+		for _, c := range ctrs {
+			if c.State == define.ContainerStateRunning.String() {
+				running = append(running, c)
+			}
 		}
+		ctrs = running
+	}
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(ctrs), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		return containers.Restart(ic.ClientCtx, ctrs[i].ID, options)
+	})
+	for i, c := range ctrs {
 		reports = append(reports, &entities.RestartReport{
 			Id:       c.ID,
-			Err:      containers.Restart(ic.ClientCtx, c.ID, options),
+			Err:      errs[i],
 			RawInput: idToRawInput[c.ID],
 		})
 	}
@@ -256,28 +277,49 @@ func (ic *ContainerEngine) ContainerRm(ctx context.Context, namesOrIds []string,
 		}
 	}
 
-	rmReports := make([]*reports.RmReport, 0, len(toRemove))
-	for _, rmCtr := range toRemove {
-		if alreadyRemoved[rmCtr] {
-			continue
+	var lock sync.Mutex
+	results := make([][]*reports.RmReport, len(toRemove))
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(toRemove), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		rmCtr := toRemove[i]
+		lock.Lock()
+		removed := alreadyRemoved[rmCtr]
+		lock.Unlock()
+		if removed {
+			return nil
 		}
 		if ctr, exist := idToRawInput[rmCtr]; exist {
 			rmCtr = ctr
 		}
 		newReports, err := containers.Remove(ic.ClientCtx, rmCtr, options)
 		if err != nil {
-			rmReports = append(rmReports, &reports.RmReport{
+			results[i] = []*reports.RmReport{{
 				Id:       rmCtr,
 				Err:      err,
 				RawInput: idToRawInput[rmCtr],
-			})
-			continue
+			}}
+			return err
 		}
-		for i := range newReports {
-			alreadyRemoved[newReports[i].Id] = true
-			newReports[i].RawInput = idToRawInput[newReports[i].Id]
-			rmReports = append(rmReports, newReports[i])
+		lock.Lock()
+		defer lock.Unlock()
+		for j := range newReports {
+			alreadyRemoved[newReports[j].Id] = true
+			newReports[j].RawInput = idToRawInput[newReports[j].Id]
 		}
+		results[i] = newReports
+		return nil
+	})
+
+	rmReports := make([]*reports.RmReport, 0, len(toRemove))
+	for i, result := range results {
+		if result == nil && errs[i] != nil {
+			// Not removed, e.g. with --fail-fast
+			result = []*reports.RmReport{{
+				Id:       toRemove[i],
+				Err:      errs[i],
+				RawInput: idToRawInput[toRemove[i]],
+			}}
+		}
+		rmReports = append(rmReports, result...)
 	}
 	return rmReports, nil
 }
@@ -374,6 +416,9 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		rawInputs    []string
 		idToRawInput = map[string]string{}
 	)
+	if opts.OciEncryptConfig != nil {
+		return nil, fmt.Errorf("--encryption-key is not supported on the remote client, use --encryption-secret instead")
+	}
 	options := new(containers.CheckpointOptions)
 	options.WithFileLocks(opts.FileLocks)
 	options.WithIgnoreRootfs(opts.IgnoreRootFS)
@@ -385,6 +430,9 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	options.WithPreCheckpoint(opts.PreCheckPoint)
 	options.WithLeaveRunning(opts.LeaveRunning)
 	options.WithWithPrevious(opts.WithPrevious)
+	if opts.EncryptionSecret != "" {
+		options.WithEncryptionSecret(opts.EncryptionSecret)
+	}
 
 	if opts.All {
 		allCtrs, err := getContainersByContext(ic.ClientCtx, true, false, []string{})
@@ -425,6 +473,9 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	if opts.ImportPrevious != "" {
 		return nil, fmt.Errorf("--import-previous is not supported on the remote client")
 	}
+	if opts.OciDecryptConfig != nil {
+		return nil, fmt.Errorf("--decryption-key is not supported on the remote client, use --decryption-secret instead")
+	}
 
 	var (
 		ids          []string
@@ -442,6 +493,9 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	options.WithPod(opts.Pod)
 	options.WithPrintStats(opts.PrintStats)
 	options.WithPublishPorts(opts.PublishPorts)
+	if opts.DecryptionSecret != "" {
+		options.WithDecryptionSecret(opts.DecryptionSecret)
+	}
 
 	if opts.Import != "" {
 		options.WithImportArchive(opts.Import)
@@ -583,6 +637,7 @@ func makeExecConfig(options entities.ExecOptions) *handlers.ExecCreateConfig {
 	createConfig.Env = env
 	createConfig.WorkingDir = options.WorkDir
 	createConfig.Cmd = options.Cmd
+	createConfig.RecordFile = options.RecordFile
 
 	return createConfig
 }
@@ -645,7 +700,9 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return sessionID, nil
 }
 
-func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, sigProxy bool, input, output, errput *os.File) (int, error) {
+// startAndAttach starts the container and attaches to it.  If timing is set,
+// the durations of the steps of starting the container are stored in it.
+func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, sigProxy bool, input, output, errput *os.File, timing *[]define.TimingSpan) (int, error) {
 	if output == nil && errput == nil {
 		fmt.Printf("%s\n", name)
 	}
@@ -679,7 +736,13 @@ func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, sigPro
 		if dk := detachKeys; dk != nil {
 			startOptions.WithDetachKeys(*dk)
 		}
-		if err := containers.Start(ic.ClientCtx, name, startOptions); err != nil {
+		if timing != nil {
+			spans, err := containers.StartWithTiming(ic.ClientCtx, name, startOptions)
+			if err != nil {
+				return -1, err
+			}
+			*timing = spans
+		} else if err := containers.Start(ic.ClientCtx, name, startOptions); err != nil {
 			return -1, err
 		}
 
@@ -752,7 +815,7 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 		}
 		ctrRunning := ctr.State == define.ContainerStateRunning.String()
 		if options.Attach {
-			code, err := startAndAttach(ic, name, &options.DetachKeys, options.SigProxy, options.Stdin, options.Stdout, options.Stderr)
+			code, err := startAndAttach(ic, name, &options.DetachKeys, options.SigProxy, options.Stdin, options.Stdout, options.Stderr, nil)
 			if err == define.ErrDetach {
 				// User manually detached
 				// Exit cleanly immediately
@@ -794,23 +857,46 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 			reports = append(reports, &report)
 			return reports, nil
 		}
-		// Start the container if it's not running already.
-		if !ctrRunning {
-			err = containers.Start(ic.ClientCtx, name, new(containers.StartOptions).WithDetachKeys(options.DetachKeys))
-			if err != nil {
-				if ctr.AutoRemove {
-					rmOptions := new(containers.RemoveOptions).WithForce(false).WithVolumes(true)
-					reports, err := containers.Remove(ic.ClientCtx, ctr.ID, rmOptions)
-					logIfRmError(ctr.ID, err, reports)
-				}
-				report.Err = fmt.Errorf("unable to start container %q: %w", name, err)
-				report.ExitCode = define.ExitCode(err)
-				reports = append(reports, &report)
-				continue
+	}
+
+	// Start the containers which are not running already.
+	toStart := make([]entities.ListContainer, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		if ctr.State != define.ContainerStateRunning.String() {
+			toStart = append(toStart, ctr)
+		}
+	}
+	startReports := make([]*entities.ContainerStartReport, len(toStart))
+	for i, ctr := range toStart {
+		startReports[i] = &entities.ContainerStartReport{
+			Id:       ctr.ID,
+			RawInput: idToRawInput[ctr.ID],
+			ExitCode: exitCode,
+		}
+	}
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(toStart), options.BulkOptions.SerialByDefault(), func(i int) error {
+		ctr := toStart[i]
+		report := startReports[i]
+		err := containers.Start(ic.ClientCtx, ctr.ID, new(containers.StartOptions).WithDetachKeys(options.DetachKeys))
+		if err != nil {
+			if ctr.AutoRemove {
+				rmOptions := new(containers.RemoveOptions).WithForce(false).WithVolumes(true)
+				reports, err := containers.Remove(ic.ClientCtx, ctr.ID, rmOptions)
+				logIfRmError(ctr.ID, err, reports)
 			}
-			report.ExitCode = 0
-			reports = append(reports, &report)
+			report.Err = fmt.Errorf("unable to start container %q: %w", ctr.ID, err)
+			report.ExitCode = define.ExitCode(err)
+			return report.Err
+		}
+		report.ExitCode = 0
+		return nil
+	})
+	for i, report := range startReports {
+		// Not started, e.g. with --fail-fast
+		if report.Err == nil && errs[i] != nil {
+			report.Err = errs[i]
 		}
+		reports = append(reports, report)
 	}
 	return reports, nil
 }
@@ -881,10 +967,22 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 	}
 
 	report := entities.ContainerRunReport{Id: con.ID}
+	// The timing is returned by the start request since the container
+	// may already be removed when it exits, e.g. with --rm.
+	var timing *[]define.TimingSpan
+	if opts.Spec != nil && opts.Spec.Timing != nil && *opts.Spec.Timing {
+		timing = &report.Timing
+	}
 
 	if opts.Detach {
 		// Detach and return early
-		err := containers.Start(ic.ClientCtx, con.ID, new(containers.StartOptions).WithRecursive(true))
+		startOptions := new(containers.StartOptions).WithRecursive(true)
+		var err error
+		if timing != nil {
+			report.Timing, err = containers.StartWithTiming(ic.ClientCtx, con.ID, startOptions)
+		} else {
+			err = containers.Start(ic.ClientCtx, con.ID, startOptions)
+		}
 		if err != nil {
 			report.ExitCode = define.ExitCode(err)
 			if opts.Rm {
@@ -903,7 +1001,7 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 		})
 	}
 
-	code, err := startAndAttach(ic, con.ID, &opts.DetachKeys, opts.SigProxy, opts.InputStream, opts.OutputStream, opts.ErrorStream)
+	code, err := startAndAttach(ic, con.ID, &opts.DetachKeys, opts.SigProxy, opts.InputStream, opts.OutputStream, opts.ErrorStream, timing)
 	if err != nil {
 		if err == define.ErrDetach {
 			return &report, nil
@@ -937,6 +1035,9 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 }
 
 func (ic *ContainerEngine) Diff(ctx context.Context, namesOrIDs []string, opts entities.DiffOptions) (*entities.DiffReport, error) {
+	if opts.Content || opts.Export != "" {
+		return nil, errors.New("--content and --export are not supported by the remote client")
+	}
 	var base string
 	options := new(containers.DiffOptions).WithDiffType(opts.Type.String())
 	if len(namesOrIDs) > 0 {
@@ -24,10 +24,16 @@ func (ic *ContainerEngine) Events(ctx context.Context, opts entities.EventsOptio
 	binChan := make(chan entities.Event)
 	go func() {
 		for e := range binChan {
+			if e.Type == entities.EventTypeHeartbeat {
+				continue
+			}
 			opts.EventChan <- events.ReadResult{Event: entities.ConvertToLibpodEvent(e)}
 		}
 		close(opts.EventChan)
 	}()
 	options := new(system.EventsOptions).WithFilters(filters).WithSince(opts.Since).WithStream(opts.Stream).WithUntil(opts.Until)
+	if opts.AfterID > 0 {
+		options.WithAfterID(opts.AfterID)
+	}
 	return system.Events(ic.ClientCtx, binChan, nil, options)
 }
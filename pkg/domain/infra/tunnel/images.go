@@ -20,6 +20,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/progress"
 	"github.com/containers/storage/pkg/archive"
 )
 
@@ -64,6 +65,10 @@ func (ir *ImageEngine) Mount(ctx context.Context, images []string, options entit
 	return nil, errors.New("mounting images is not supported for remote clients")
 }
 
+func (ir *ImageEngine) Convert(ctx context.Context, nameOrID string, opts entities.ImageConvertOptions) (*entities.ImageConvertReport, error) {
+	return nil, errors.New("converting images is not supported for remote clients")
+}
+
 func (ir *ImageEngine) Unmount(ctx context.Context, images []string, options entities.ImageUnmountOptions) ([]*entities.ImageUnmountReport, error) {
 	return nil, errors.New("unmounting images is not supported for remote clients")
 }
@@ -118,7 +123,7 @@ func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, opts entities.
 	options.WithAllTags(opts.AllTags).WithAuthfile(opts.Authfile).WithArch(opts.Arch).WithOS(opts.OS)
 	options.WithVariant(opts.Variant).WithPassword(opts.Password)
 	options.WithQuiet(opts.Quiet).WithUsername(opts.Username).WithPolicy(opts.PullPolicy.String())
-	options.WithProgressWriter(opts.Writer)
+	options.WithProgressWriter(opts.Writer).WithProgressFormat(opts.ProgressFormat)
 	if s := opts.SkipTLSVerify; s != types.OptionalBoolUndefined {
 		if s == types.OptionalBoolTrue {
 			options.WithSkipTLSVerify(true)
@@ -132,6 +137,13 @@ func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, opts entities.
 	if opts.RetryDelay != "" {
 		options.WithRetryDelay(opts.RetryDelay)
 	}
+	if opts.Async {
+		job, err := images.PullAsync(ir.ClientCtx, rawImage, options)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.ImagePullReport{JobID: job.ID}, nil
+	}
 	pulledImages, err := images.Pull(ir.ClientCtx, rawImage, options)
 	if err != nil {
 		return nil, err
@@ -221,6 +233,10 @@ func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts en
 }
 
 func (ir *ImageEngine) Load(ctx context.Context, opts entities.ImageLoadOptions) (*entities.ImageLoadReport, error) {
+	loadOptions := new(images.LoadOptions)
+	if opts.ProgressFormat == progress.FormatJSON && !opts.Quiet {
+		loadOptions.WithProgressFormat(progress.FormatJSON).WithProgressWriter(os.Stderr)
+	}
 	f, err := os.Open(opts.Input)
 	if err != nil {
 		return nil, err
@@ -233,7 +249,7 @@ func (ir *ImageEngine) Load(ctx context.Context, opts entities.ImageLoadOptions)
 	if fInfo.IsDir() {
 		return nil, fmt.Errorf("remote client supports archives only but %q is a directory", opts.Input)
 	}
-	return images.Load(ir.ClientCtx, f)
+	return images.LoadWithOptions(ir.ClientCtx, f, loadOptions)
 }
 
 func (ir *ImageEngine) Import(ctx context.Context, opts entities.ImageImportOptions) (*entities.ImageImportReport, error) {
@@ -264,6 +280,7 @@ func (ir *ImageEngine) Push(ctx context.Context, source string, destination stri
 
 	options := new(images.PushOptions)
 	options.WithAll(opts.All).WithCompress(opts.Compress).WithUsername(opts.Username).WithPassword(opts.Password).WithAuthfile(opts.Authfile).WithFormat(opts.Format).WithRemoveSignatures(opts.RemoveSignatures).WithQuiet(opts.Quiet).WithCompressionFormat(opts.CompressionFormat).WithProgressWriter(opts.Writer).WithForceCompressionFormat(opts.ForceCompressionFormat)
+	options.WithProgressFormat(opts.ProgressFormat)
 
 	if opts.CompressionLevel != nil {
 		options.WithCompressionLevel(*opts.CompressionLevel)
@@ -282,19 +299,41 @@ func (ir *ImageEngine) Push(ctx context.Context, source string, destination stri
 	if opts.RetryDelay != "" {
 		options.WithRetryDelay(opts.RetryDelay)
 	}
+	if opts.Async {
+		job, err := images.PushAsync(ir.ClientCtx, source, destination, options)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.ImagePushReport{JobID: job.ID}, nil
+	}
 	if err := images.Push(ir.ClientCtx, source, destination, options); err != nil {
 		return nil, err
 	}
 	return &entities.ImagePushReport{ManifestDigest: options.GetManifestDigest()}, nil
 }
 
-func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string, opts entities.ImageSaveOptions) error {
+func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string, opts entities.ImageSaveOptions) (*entities.ImageSaveReport, error) {
 	var (
 		f   *os.File
 		err error
 	)
 	options := new(images.ExportOptions).WithFormat(opts.Format).WithCompress(opts.Compress)
 	options = options.WithOciAcceptUncompressedLayers(opts.OciAcceptUncompressedLayers)
+	if opts.AllPlatforms {
+		options = options.WithAllPlatforms(true)
+	}
+
+	if opts.Async {
+		// The archive of a directory format is unpacked by the client.
+		if opts.Format == "oci-dir" || opts.Format == "docker-dir" {
+			return nil, fmt.Errorf("format %q cannot be saved asynchronously", opts.Format)
+		}
+		job, err := images.ExportAsync(ir.ClientCtx, append([]string{nameOrID}, tags...), options)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.ImageSaveReport{JobID: job.ID}, nil
+	}
 
 	switch opts.Format {
 	case "oci-dir", "docker-dir":
@@ -318,40 +357,43 @@ func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string,
 		}
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	exErr := images.Export(ir.ClientCtx, append([]string{nameOrID}, tags...), f, options)
 	if err := f.Close(); err != nil {
-		return err
+		return nil, err
 	}
 	if exErr != nil {
-		return exErr
+		return nil, exErr
 	}
 
 	if opts.Format != "oci-dir" && opts.Format != "docker-dir" {
-		return nil
+		return &entities.ImageSaveReport{}, nil
 	}
 
 	f, err = os.Open(f.Name())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	info, err := os.Stat(opts.Output)
 	switch {
 	case err == nil:
 		if info.Mode().IsRegular() {
-			return fmt.Errorf("%q already exists as a regular file", opts.Output)
+			return nil, fmt.Errorf("%q already exists as a regular file", opts.Output)
 		}
 	case os.IsNotExist(err):
 		if err := os.Mkdir(opts.Output, 0755); err != nil {
-			return err
+			return nil, err
 		}
 	default:
-		return err
+		return nil, err
 	}
 
-	return archive.Untar(f, opts.Output, &archive.TarOptions{NoLchown: true})
+	if err := archive.Untar(f, opts.Output, &archive.TarOptions{NoLchown: true}); err != nil {
+		return nil, err
+	}
+	return &entities.ImageSaveReport{}, nil
 }
 
 func (ir *ImageEngine) Search(ctx context.Context, term string, opts entities.ImageSearchOptions) ([]entities.ImageSearchReport, error) {
@@ -0,0 +1,36 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/containers/podman/v5/pkg/bindings/jobs"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+)
+
+func (ic *ContainerEngine) JobCancel(ctx context.Context, ids []string) ([]*entities.JobCancelReport, error) {
+	reports := make([]*entities.JobCancelReport, 0, len(ids))
+	for _, id := range ids {
+		reports = append(reports, &entities.JobCancelReport{ID: id, Err: jobs.Cancel(ic.ClientCtx, id)})
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) JobInspect(ctx context.Context, id string) (*entities.JobReport, error) {
+	return jobs.Inspect(ic.ClientCtx, id)
+}
+
+func (ic *ContainerEngine) JobList(ctx context.Context) ([]*entities.JobReport, error) {
+	return jobs.List(ic.ClientCtx)
+}
+
+func (ic *ContainerEngine) JobLogs(ctx context.Context, id string, options entities.JobLogsOptions) error {
+	return jobs.Logs(ic.ClientCtx, id, options.Writer, new(jobs.LogsOptions).WithFollow(options.Follow))
+}
+
+func (ic *ContainerEngine) JobRm(ctx context.Context, ids []string) ([]*entities.JobRmReport, error) {
+	reports := make([]*entities.JobRmReport, 0, len(ids))
+	for _, id := range ids {
+		reports = append(reports, &entities.JobRmReport{ID: id, Err: jobs.Remove(ic.ClientCtx, id)})
+	}
+	return reports, nil
+}
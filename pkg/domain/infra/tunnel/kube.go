@@ -75,6 +75,13 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, opts en
 	options.WithPublishPorts(opts.PublishPorts)
 	options.WithPublishAllPorts(opts.PublishAllPorts)
 	options.WithNoTrunc(opts.UseLongAnnotations)
+	if opts.Async {
+		job, err := kube.PlayWithBodyAsync(ic.ClientCtx, body, options)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.PlayKubeReport{JobID: job.ID}, nil
+	}
 	return play.KubeWithBody(ic.ClientCtx, body, options)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/containers/podman/v5/pkg/bindings/pods"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/parallel"
 	"github.com/containers/podman/v5/pkg/util"
 )
 
@@ -109,20 +110,24 @@ func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, opt
 	if opts.Timeout != -1 {
 		timeout = opts.Timeout
 	}
-	reports := make([]*entities.PodStopReport, 0, len(foundPods))
+	reports := make([]*entities.PodStopReport, len(foundPods))
 	options := new(pods.StopOptions).WithTimeout(timeout)
-	for _, p := range foundPods {
-		response, err := pods.Stop(ic.ClientCtx, p.Id, options)
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(foundPods), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		response, err := pods.Stop(ic.ClientCtx, foundPods[i].Id, options)
 		if err != nil {
-			report := entities.PodStopReport{
-				Errs:     []error{err},
+			return err
+		}
+		reports[i] = response
+		return errorhandling.JoinErrors(response.Errs)
+	})
+	for i, p := range foundPods {
+		if reports[i] == nil {
+			reports[i] = &entities.PodStopReport{
+				Errs:     []error{errs[i]},
 				Id:       p.Id,
 				RawInput: p.Name,
 			}
-			reports = append(reports, &report)
-			continue
 		}
-		reports = append(reports, response)
 	}
 	return reports, nil
 }
@@ -153,19 +158,23 @@ func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, op
 	if err != nil {
 		return nil, err
 	}
-	reports := make([]*entities.PodStartReport, 0, len(foundPods))
-	for _, p := range foundPods {
-		response, err := pods.Start(ic.ClientCtx, p.Id, nil)
+	reports := make([]*entities.PodStartReport, len(foundPods))
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(foundPods), options.BulkOptions.SerialByDefault(), func(i int) error {
+		response, err := pods.Start(ic.ClientCtx, foundPods[i].Id, nil)
 		if err != nil {
-			report := entities.PodStartReport{
-				Errs:     []error{err},
+			return err
+		}
+		reports[i] = response
+		return errorhandling.JoinErrors(response.Errs)
+	})
+	for i, p := range foundPods {
+		if reports[i] == nil {
+			reports[i] = &entities.PodStartReport{
+				Errs:     []error{errs[i]},
 				Id:       p.Id,
 				RawInput: p.Name,
 			}
-			reports = append(reports, &report)
-			continue
 		}
-		reports = append(reports, response)
 	}
 	return reports, nil
 }
@@ -180,17 +189,23 @@ func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, opts
 	if opts.Timeout != nil {
 		options = options.WithTimeout(*opts.Timeout)
 	}
-	for _, p := range foundPods {
-		response, err := pods.Remove(ic.ClientCtx, p.Id, options)
+	responses := make([]*entities.PodRmReport, len(foundPods))
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(foundPods), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		response, err := pods.Remove(ic.ClientCtx, foundPods[i].Id, options)
 		if err != nil {
-			report := entities.PodRmReport{
-				Err: err,
+			return err
+		}
+		responses[i] = response
+		return response.Err
+	})
+	for i, p := range foundPods {
+		if responses[i] == nil {
+			responses[i] = &entities.PodRmReport{
+				Err: errs[i],
 				Id:  p.Id,
 			}
-			reports = append(reports, &report)
-			continue
 		}
-		reports = append(reports, response)
+		reports = append(reports, responses[i])
 	}
 	return reports, nil
 }
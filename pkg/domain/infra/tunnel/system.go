@@ -36,6 +36,10 @@ func (ic *ContainerEngine) Migrate(ctx context.Context, options entities.SystemM
 	return errors.New("runtime migration is not supported on remote clients")
 }
 
+func (ic *ContainerEngine) SystemStorageMigrate(ctx context.Context, options entities.SystemStorageMigrateOptions) (*entities.SystemStorageMigrateReport, error) {
+	return nil, errors.New("storage migration is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) Renumber(ctx context.Context) error {
 	return errors.New("lock renumbering is not supported on remote clients")
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/parallel"
 )
 
 func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
@@ -29,14 +30,17 @@ func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, op
 			namesOrIds = append(namesOrIds, v.Name)
 		}
 	}
+	options := new(volumes.RemoveOptions).WithForce(opts.Force)
+	if opts.Timeout != nil {
+		options = options.WithTimeout(*opts.Timeout)
+	}
+	errs := parallel.RunUnqueued(ic.ClientCtx, len(namesOrIds), opts.BulkOptions.SerialByDefault(), func(i int) error {
+		return volumes.Remove(ic.ClientCtx, namesOrIds[i], options)
+	})
 	reports := make([]*entities.VolumeRmReport, 0, len(namesOrIds))
-	for _, id := range namesOrIds {
-		options := new(volumes.RemoveOptions).WithForce(opts.Force)
-		if opts.Timeout != nil {
-			options = options.WithTimeout(*opts.Timeout)
-		}
+	for i, id := range namesOrIds {
 		reports = append(reports, &entities.VolumeRmReport{
-			Err: volumes.Remove(ic.ClientCtx, id, options),
+			Err: errs[i],
 			Id:  id,
 		})
 	}
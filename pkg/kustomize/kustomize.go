@@ -0,0 +1,31 @@
+// Package kustomize renders kustomizations, the bases and overlays of
+// Kubernetes YAML described by a kustomization.yaml, for podman kube play.
+//
+// The kustomization is rendered in-process with the kustomize API, like
+// kubectl kustomize does, so that neither kustomize nor kubectl needs to be
+// installed.
+package kustomize
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// Build renders the kustomization in dir and returns the resulting YAML.
+// Like with kustomize build, the files the kustomization refers to must be
+// in dir or its subdirectories, and only the builtin generators and
+// transformers, e.g. configMapGenerator and secretGenerator, are available.
+func Build(dir string) ([]byte, error) {
+	k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+	resources, err := k.Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("rendering kustomization %s: %w", dir, err)
+	}
+	out, err := resources.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("rendering kustomization %s: %w", dir, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,324 @@
+package kustomize
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+// object is a decoded Kubernetes object.
+type object struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name      string            `yaml:"name"`
+		Namespace string            `yaml:"namespace"`
+		Labels    map[string]string `yaml:"labels"`
+	} `yaml:"metadata"`
+	Data map[string]string `yaml:"data"`
+	Type string            `yaml:"type"`
+	Spec struct {
+		Replicas int              `yaml:"replicas"`
+		Selector map[string]any   `yaml:"selector"`
+		Ports    []map[string]any `yaml:"ports"`
+		Template struct {
+			Metadata struct {
+				Labels map[string]string `yaml:"labels"`
+			} `yaml:"metadata"`
+			Spec podSpec `yaml:"spec"`
+		} `yaml:"template"`
+	} `yaml:"spec"`
+}
+
+type podSpec struct {
+	Containers []struct {
+		Image   string `yaml:"image"`
+		EnvFrom []struct {
+			ConfigMapRef struct {
+				Name string `yaml:"name"`
+			} `yaml:"configMapRef"`
+		} `yaml:"envFrom"`
+		Env []struct {
+			Name      string `yaml:"name"`
+			Value     string `yaml:"value"`
+			ValueFrom struct {
+				SecretKeyRef struct {
+					Name string `yaml:"name"`
+				} `yaml:"secretKeyRef"`
+			} `yaml:"valueFrom"`
+		} `yaml:"env"`
+	} `yaml:"containers"`
+	Volumes []struct {
+		ConfigMap struct {
+			Name string `yaml:"name"`
+		} `yaml:"configMap"`
+	} `yaml:"volumes"`
+}
+
+// buildObjects renders the kustomization in dir and returns the objects by
+// kind and name.
+func buildObjects(t *testing.T, dir string) map[string]*object {
+	out, err := Build(dir)
+	require.NoError(t, err)
+	objects := make(map[string]*object)
+	decoder := yaml.NewDecoder(bytes.NewReader(out))
+	for {
+		obj := new(object)
+		err := decoder.Decode(obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		objects[obj.Kind+"/"+obj.Metadata.Name] = obj
+	}
+	return objects
+}
+
+const baseKustomization = `
+resources:
+- deployment.yaml
+- service.yaml
+configMapGenerator:
+- name: app-config
+  literals:
+  - MODE=base
+  files:
+  - app.conf
+`
+
+const deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+      - name: app
+        image: quay.io/example/app:1.0
+        envFrom:
+        - configMapRef:
+            name: app-config
+        env:
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: app-secret
+              key: password
+        volumeMounts:
+        - name: config
+          mountPath: /etc/app
+      volumes:
+      - name: config
+        configMap:
+          name: app-config
+`
+
+const service = `apiVersion: v1
+kind: Service
+metadata:
+  name: app
+spec:
+  selector:
+    app: app
+  ports:
+  - port: 80
+`
+
+func TestBuildOverlay(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"base/kustomization.yaml": baseKustomization,
+		"base/deployment.yaml":    deployment,
+		"base/service.yaml":       service,
+		"base/app.conf":           "level=debug\n",
+		"overlay/kustomization.yaml": `
+resources:
+- ../base
+namePrefix: prod-
+namespace: prod
+labels:
+- pairs:
+    env: prod
+  includeSelectors: true
+images:
+- name: quay.io/example/app
+  newTag: "2.0"
+replicas:
+- name: app
+  count: 3
+configMapGenerator:
+- name: app-config
+  behavior: merge
+  literals:
+  - MODE=prod
+secretGenerator:
+- name: app-secret
+  envs:
+  - secret.env
+patches:
+- patch: |-
+    apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: app
+    spec:
+      template:
+        spec:
+          containers:
+          - name: app
+            env:
+            - name: LOG
+              value: "1"
+- target:
+    kind: Service
+  patch: |-
+    - op: replace
+      path: /spec/ports/0/port
+      value: 8080
+`,
+		"overlay/secret.env": "password=hunter2\n",
+	})
+
+	objects := buildObjects(t, filepath.Join(dir, "overlay"))
+	require.Len(t, objects, 4)
+
+	var configName, secretName string
+	for key, obj := range objects {
+		switch {
+		case regexp.MustCompile(`^ConfigMap/prod-app-config-[a-z0-9]{10}$`).MatchString(key):
+			configName = obj.Metadata.Name
+		case regexp.MustCompile(`^Secret/prod-app-secret-[a-z0-9]{10}$`).MatchString(key):
+			secretName = obj.Metadata.Name
+		}
+	}
+	require.NotEmpty(t, configName, "generated ConfigMap")
+	require.NotEmpty(t, secretName, "generated Secret")
+
+	config := objects["ConfigMap/"+configName]
+	assert.Equal(t, map[string]string{"MODE": "prod", "app.conf": "level=debug\n"}, config.Data)
+	assert.Equal(t, "prod", config.Metadata.Namespace)
+	secret := objects["Secret/"+secretName]
+	assert.Equal(t, map[string]string{"password": "aHVudGVyMg=="}, secret.Data)
+	assert.Equal(t, "Opaque", secret.Type)
+
+	dep := objects["Deployment/prod-app"]
+	require.NotNil(t, dep)
+	assert.Equal(t, map[string]string{"env": "prod"}, dep.Metadata.Labels)
+	assert.Equal(t, map[string]any{"matchLabels": map[string]any{"app": "app", "env": "prod"}}, dep.Spec.Selector)
+	assert.Equal(t, map[string]string{"app": "app", "env": "prod"}, dep.Spec.Template.Metadata.Labels)
+	assert.Equal(t, 3, dep.Spec.Replicas)
+
+	spec := dep.Spec.Template.Spec
+	require.Len(t, spec.Containers, 1)
+	ctr := spec.Containers[0]
+	assert.Equal(t, "quay.io/example/app:2.0", ctr.Image)
+	assert.Equal(t, configName, ctr.EnvFrom[0].ConfigMapRef.Name)
+	require.Len(t, ctr.Env, 2)
+	names := []string{ctr.Env[0].Name, ctr.Env[1].Name}
+	assert.ElementsMatch(t, []string{"PASSWORD", "LOG"}, names)
+	for _, env := range ctr.Env {
+		if env.Name == "PASSWORD" {
+			assert.Equal(t, secretName, env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	assert.Equal(t, configName, spec.Volumes[0].ConfigMap.Name)
+
+	svc := objects["Service/prod-app"]
+	require.NotNil(t, svc)
+	assert.Equal(t, map[string]any{"app": "app", "env": "prod"}, svc.Spec.Selector)
+	assert.Equal(t, 8080, svc.Spec.Ports[0]["port"])
+}
+
+func TestBuildHash(t *testing.T) {
+	dir := t.TempDir()
+	kustomization := `
+configMapGenerator:
+- name: config
+  literals:
+  - key=%s
+- name: fixed
+  literals:
+  - key=value
+  options:
+    disableNameSuffixHash: true
+`
+	name := func(value string) string {
+		writeFiles(t, dir, map[string]string{"kustomization.yaml": fmt.Sprintf(kustomization, value)})
+		objects := buildObjects(t, dir)
+		require.Contains(t, objects, "ConfigMap/fixed")
+		for key := range objects {
+			if key != "ConfigMap/fixed" {
+				return key
+			}
+		}
+		return ""
+	}
+	first := name("one")
+	assert.Regexp(t, `^ConfigMap/config-[a-z0-9]{10}$`, first)
+	assert.Equal(t, first, name("one"), "the hash only depends on the content")
+	assert.NotEqual(t, first, name("two"), "the hash changes with the content")
+}
+
+func TestBuildErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name:  "no kustomization",
+			files: map[string]string{"pod.yaml": "kind: Pod\n"},
+			err:   "unable to find one of 'kustomization.yaml', 'kustomization.yml' or 'Kustomization'",
+		},
+		{
+			name: "file outside of the kustomization",
+			files: map[string]string{
+				"kustomization.yaml": "configMapGenerator:\n- name: c\n  files:\n  - ../secret\n",
+				"../secret":          "password\n",
+			},
+			err: "is not in or below",
+		},
+		{
+			name: "patch without a match",
+			files: map[string]string{
+				"kustomization.yaml": "resources:\n- svc.yaml\npatches:\n- path: patch.yaml\n",
+				"svc.yaml":           service,
+				"patch.yaml":         "apiVersion: v1\nkind: Service\nmetadata:\n  name: other\n",
+			},
+			err: "no resource matches strategic merge patch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+			_, err := Build(dir)
+			assert.ErrorContains(t, err, "rendering kustomization "+dir)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -11,31 +11,19 @@ import (
 )
 
 // ContainerOp performs the given function on the given set of
-// containers, using a number of parallel threads.
+// containers, using the parallel jobs queue and at most opts.Parallel
+// threads.
 // If no error is returned, each container specified in ctrs will have an entry
 // in the resulting map; containers with no error will be set to nil.
-func ContainerOp(ctx context.Context, ctrs []*libpod.Container, applyFunc func(*libpod.Container) error) (map[*libpod.Container]error, error) {
-	// We could use a sync.Map but given Go's lack of generic I'd rather
-	// just use a lock on a normal map...
-	// The expectation is that most of the time is spent in applyFunc
-	// anyways.
-	var (
-		errMap = make(map[*libpod.Container]<-chan error)
-	)
+func ContainerOp(ctx context.Context, ctrs []*libpod.Container, opts parallel.Options, applyFunc func(*libpod.Container) error) (map[*libpod.Container]error, error) {
+	errs := parallel.Run(ctx, len(ctrs), opts, func(i int) error {
+		logrus.Debugf("Starting parallel job on container %s", ctrs[i].ID())
+		return applyFunc(ctrs[i])
+	})
 
-	for _, ctr := range ctrs {
-		c := ctr
-		logrus.Debugf("Starting parallel job on container %s", c.ID())
-		errChan := parallel.Enqueue(ctx, func() error {
-			return applyFunc(c)
-		})
-		errMap[c] = errChan
-	}
-
-	finalErr := make(map[*libpod.Container]error)
-	for ctr, errChan := range errMap {
-		err := <-errChan
-		finalErr[ctr] = err
+	finalErr := make(map[*libpod.Container]error, len(ctrs))
+	for i, ctr := range ctrs {
+		finalErr[ctr] = errs[i]
 	}
 
 	return finalErr, nil
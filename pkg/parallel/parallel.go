@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
@@ -17,7 +18,7 @@ var (
 	numThreads uint = 8
 	// Semaphore to control thread creation and ensure numThreads is
 	// respected.
-	jobControl *semaphore.Weighted
+	jobControl = semaphore.NewWeighted(int64(numThreads))
 	// Lock to control changing the semaphore - we don't want to do it
 	// while anyone is using it.
 	jobControlLock sync.RWMutex
@@ -73,3 +74,91 @@ func Enqueue(ctx context.Context, fn func() error) <-chan error {
 
 	return retChan
 }
+
+// ErrSkipped is the error of the jobs of a bulk operation which were not run
+// because a previous job failed and Options.FailFast is set.
+var ErrSkipped = errors.New("skipped after a previous failure")
+
+// Options control how the jobs of a bulk operation are run.
+type Options struct {
+	// Parallel is the maximum number of jobs of the operation run at the
+	// same time. 0 means the maximum number of threads set with
+	// SetMaxThreads.
+	Parallel uint
+	// FailFast skips the jobs which have not started yet once a job has
+	// failed.
+	FailFast bool
+}
+
+// Run runs fn for each of the n items of a bulk operation and returns the
+// error of each item, in the order of the items. Each job is added to the
+// jobs queue with Enqueue, so that the number of jobs run at the same time
+// by all operations, e.g. all requests to the API service, is limited by
+// SetMaxThreads. opts.Parallel limits the jobs of this operation further;
+// with opts.Parallel set to 1, the jobs run in the order of the items. Jobs
+// which are not run, because ctx is done or a previous job failed with
+// opts.FailFast set, return an error without calling fn.
+func Run(ctx context.Context, n int, opts Options, fn func(i int) error) []error {
+	return run(ctx, n, opts, func(i int) error {
+		return <-Enqueue(ctx, func() error {
+			return fn(i)
+		})
+	})
+}
+
+// RunUnqueued is like Run, but its jobs do not take threads from the jobs
+// queue. It is meant for jobs which add their work to the queue themselves,
+// e.g. to act on the containers of a pod, or which are requests to the API
+// service: a job holding a thread while waiting for jobs it queued could
+// deadlock the queue.
+func RunUnqueued(ctx context.Context, n int, opts Options, fn func(i int) error) []error {
+	return run(ctx, n, opts, fn)
+}
+
+func run(ctx context.Context, n int, opts Options, fn func(i int) error) []error {
+	limit := opts.Parallel
+	if limit == 0 {
+		limit = GetMaxThreads()
+	}
+	sem := semaphore.NewWeighted(int64(limit))
+	errs := make([]error, n)
+
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Bool
+	)
+	for i := 0; i < n; i++ {
+		// Acquire in the loop to start the jobs in order.
+		if err := sem.Acquire(ctx, 1); err != nil {
+			for j := i; j < n; j++ {
+				errs[j] = fmt.Errorf("acquiring job control semaphore: %w", err)
+			}
+			break
+		}
+		if opts.FailFast && failed.Load() {
+			sem.Release(1)
+			errs[i] = ErrSkipped
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer sem.Release(1)
+			if err := fn(i); err != nil {
+				errs[i] = err
+				failed.Store(true)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// SerialByDefault returns the options with Parallel set to 1 unless set,
+// for bulk operations which process one item at a time by default.
+func (o Options) SerialByDefault() Options {
+	if o.Parallel == 0 {
+		o.Parallel = 1
+	}
+	return o
+}
@@ -0,0 +1,96 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunParallel(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		run      func(context.Context, int, Options, func(int) error) []error
+		parallel uint
+		limit    int32
+	}{
+		{name: "parallel", run: Run, parallel: 3, limit: 3},
+		// The jobs queue limits the jobs of all operations.
+		{name: "max threads", run: Run, parallel: 10, limit: 2},
+		{name: "unqueued", run: RunUnqueued, parallel: 3, limit: 3},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.NoError(t, SetMaxThreads(2))
+			defer func() {
+				assert.NoError(t, SetMaxThreads(8))
+			}()
+			testRunParallel(t, test.run, test.parallel, test.limit)
+		})
+	}
+}
+
+func testRunParallel(t *testing.T, run func(context.Context, int, Options, func(int) error) []error, parallel uint, limit int32) {
+	var running, maxRunning atomic.Int32
+	var lock sync.Mutex
+	var order []int
+	errs := run(context.Background(), 10, Options{Parallel: parallel}, func(i int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		lock.Lock()
+		order = append(order, i)
+		lock.Unlock()
+		if i%2 == 1 {
+			return errors.New("odd")
+		}
+		return nil
+	})
+	assert.Len(t, errs, 10)
+	for i, err := range errs {
+		if i%2 == 1 {
+			assert.EqualError(t, err, "odd")
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+	assert.Len(t, order, 10)
+	assert.LessOrEqual(t, maxRunning.Load(), limit)
+}
+
+func TestRunFailFast(t *testing.T) {
+	var calls []int
+	errs := Run(context.Background(), 5, Options{Parallel: 1, FailFast: true}, func(i int) error {
+		calls = append(calls, i)
+		if i == 2 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Equal(t, []int{0, 1, 2}, calls)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.EqualError(t, errs[2], "failed")
+	assert.ErrorIs(t, errs[3], ErrSkipped)
+	assert.ErrorIs(t, errs[4], ErrSkipped)
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	errs := Run(ctx, 2, Options{}, func(i int) error {
+		called = true
+		return nil
+	})
+	assert.False(t, called)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+	assert.ErrorIs(t, errs[1], context.Canceled)
+}
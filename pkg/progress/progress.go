@@ -0,0 +1,256 @@
+// Package progress converts the progress reported by containers/image into
+// machine-readable events.
+package progress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/common/pkg/retry"
+	imageTypes "github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/opencontainers/go-digest"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// FormatText reports progress as human-readable text.
+	FormatText = "text"
+	// FormatJSON reports progress as one JSON object per event.
+	FormatJSON = "json"
+)
+
+// ValidateFormat returns an error if format is not a known progress format.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatText, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid progress format %q: must be %q or %q", format, FormatText, FormatJSON)
+	}
+}
+
+// EmitFunc is called for every progress event.
+type EmitFunc func(*types.ProgressEvent)
+
+// NewJSONEmitter returns an EmitFunc writing each event as a single line of
+// JSON to w.  It is safe for concurrent use.
+func NewJSONEmitter(w io.Writer) EmitFunc {
+	var mutex sync.Mutex
+	enc := json.NewEncoder(w)
+	return func(event *types.ProgressEvent) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err := enc.Encode(event); err != nil {
+			logrus.Warnf("Failed to encode progress event: %v", err)
+		}
+	}
+}
+
+// Tracker converts the progress properties reported by containers/image into
+// events.  A blob that is copied again after the operation was retried, see
+// Retry, is reported as a retry.
+type Tracker struct {
+	emit    EmitFunc
+	mutex   sync.Mutex
+	attempt int
+	// started maps the blobs being copied to the attempt they were
+	// started in.
+	started map[digest.Digest]int
+}
+
+// NewTracker returns a Tracker passing events to emit.
+func NewTracker(emit EmitFunc) *Tracker {
+	return &Tracker{
+		emit:    emit,
+		started: make(map[digest.Digest]int),
+	}
+}
+
+// Retry records that the operation is attempted again after an error.
+func (t *Tracker) Retry() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.attempt++
+}
+
+// Report converts p into an event and emits it.
+func (t *Tracker) Report(p imageTypes.ProgressProperties) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	event := &types.ProgressEvent{
+		Time:      time.Now(),
+		Digest:    p.Artifact.Digest.String(),
+		MediaType: p.Artifact.MediaType,
+		Total:     p.Artifact.Size,
+		Attempt:   t.attempt,
+	}
+	d := p.Artifact.Digest
+	switch p.Event {
+	case imageTypes.ProgressEventNewArtifact:
+		event.Event = types.ProgressEventStart
+		// Instances of an image index may share blobs, so a blob
+		// started again within the same attempt is not a retry.
+		if attempt, ok := t.started[d]; ok && attempt < t.attempt {
+			event.Event = types.ProgressEventRetry
+		}
+		t.started[d] = t.attempt
+	case imageTypes.ProgressEventRead:
+		event.Event = types.ProgressEventProgress
+		event.Current = p.Offset
+	case imageTypes.ProgressEventDone:
+		event.Event = types.ProgressEventDone
+		event.Current = p.Offset
+		delete(t.started, d)
+	case imageTypes.ProgressEventSkipped:
+		event.Event = types.ProgressEventSkipped
+		delete(t.started, d)
+	default:
+		logrus.Debugf("Ignoring unknown progress event %d", p.Event)
+		return
+	}
+	t.emit(event)
+}
+
+// Run runs op, a copy operation which does not retry by itself, and
+// retries it according to options like containers/common/pkg/retry.  Each
+// retry is recorded by the tracker.
+func (t *Tracker) Run(ctx context.Context, options *retry.Options, op func() error) error {
+	first := true
+	return retry.IfNecessary(ctx, func() error {
+		if !first {
+			t.Retry()
+		}
+		first = false
+		return op()
+	}, options)
+}
+
+// Forward returns a channel to be used as the progress channel of a
+// containers/image copy operation.  All properties sent to the channel are
+// reported to tracker.  The returned function must be called once the copy
+// operation finished; it closes the channel and waits until all events were
+// emitted.
+func Forward(tracker *Tracker) (chan imageTypes.ProgressProperties, func()) {
+	progress := make(chan imageTypes.ProgressProperties)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for p := range progress {
+			tracker.Report(p)
+		}
+	}()
+	return progress, func() {
+		close(progress)
+		<-done
+	}
+}
+
+// The retry defaults of containers/common/libimage.
+const (
+	defaultMaxRetries = 3
+	defaultRetryDelay = time.Second
+)
+
+// RetryOptions returns the options to retry a copy operation with
+// maxRetries and delay, or the libimage defaults if unset.  Callers disable
+// the retries of libimage and use Tracker.Run instead, so that retries are
+// reported.
+func RetryOptions(maxRetries *uint, delay *time.Duration) *retry.Options {
+	options := &retry.Options{MaxRetry: defaultMaxRetries, Delay: defaultRetryDelay}
+	if maxRetries != nil {
+		options.MaxRetry = int(*maxRetries)
+	}
+	if delay != nil {
+		options.Delay = *delay
+	}
+	return options
+}
+
+// Result returns the final event of an operation which failed with err or,
+// if err is nil, succeeded.
+func Result(err error) *types.ProgressEvent {
+	event := &types.ProgressEvent{
+		Event: types.ProgressEventSuccess,
+		Time:  time.Now(),
+	}
+	if err != nil {
+		event.Event = types.ProgressEventError
+		event.Error = err.Error()
+	}
+	return event
+}
+
+// reportWriter parses the human-readable report of containers/image.
+type reportWriter struct {
+	emit EmitFunc
+	buf  bytes.Buffer
+	// pulling is set while an image is pulled.
+	pulling bool
+	// copying are the blobs of the image being pulled.
+	copying []digest.Digest
+}
+
+// NewPullReportWriter returns an io.Writer which parses the report written
+// by libimage when pulling without progress bars.  It is meant for callers
+// like buildah that take a report writer but no progress channel.  A start
+// event is emitted for every blob being copied and a done event for all of
+// them once the manifest of the image is written, that is once all blobs
+// were committed.  Reports of operations other than pulls are ignored.
+func NewPullReportWriter(emit EmitFunc) io.Writer {
+	return &reportWriter{emit: emit}
+}
+
+func (r *reportWriter) Write(p []byte) (int, error) {
+	r.buf.Write(p)
+	for {
+		line, err := r.buf.ReadString('\n')
+		if err != nil {
+			// Keep the incomplete line for the next write.
+			r.buf.Reset()
+			r.buf.WriteString(line)
+			return len(p), nil
+		}
+		r.parseLine(strings.TrimSpace(line))
+	}
+}
+
+func (r *reportWriter) parseLine(line string) {
+	words := strings.Fields(line)
+	switch {
+	case len(words) >= 3 && words[0] == "Trying" && words[1] == "to" && words[2] == "pull":
+		r.pulling = true
+		r.copying = nil
+	case !r.pulling:
+	case len(words) == 3 && words[0] == "Copying":
+		d, err := digest.Parse(words[2])
+		if err != nil {
+			return
+		}
+		r.copying = append(r.copying, d)
+		r.emit(&types.ProgressEvent{
+			Event:  types.ProgressEventStart,
+			Time:   time.Now(),
+			Digest: d.String(),
+			Total:  -1,
+		})
+	case line == "Writing manifest to image destination":
+		for _, d := range r.copying {
+			r.emit(&types.ProgressEvent{
+				Event:  types.ProgressEventDone,
+				Time:   time.Now(),
+				Digest: d.String(),
+				Total:  -1,
+			})
+		}
+		r.pulling = false
+		r.copying = nil
+	}
+}
@@ -0,0 +1,139 @@
+package progress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	imageTypes "github.com/containers/image/v5/types"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	var events []*types.ProgressEvent
+	tracker := NewTracker(func(event *types.ProgressEvent) {
+		events = append(events, event)
+	})
+
+	blob := imageTypes.BlobInfo{Digest: digest.FromString("blob"), Size: 42}
+	report := func(properties ...imageTypes.ProgressProperties) {
+		for _, p := range properties {
+			tracker.Report(p)
+		}
+	}
+	report(
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventNewArtifact, Artifact: blob},
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventRead, Artifact: blob, Offset: 10, OffsetUpdate: 10},
+		// Another instance of an image index sharing the blob.
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventNewArtifact, Artifact: blob},
+	)
+	tracker.Retry()
+	report(
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventNewArtifact, Artifact: blob},
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventRead, Artifact: blob, Offset: 42, OffsetUpdate: 42},
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventDone, Artifact: blob, Offset: 42},
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventSkipped, Artifact: imageTypes.BlobInfo{Digest: digest.FromString("other"), Size: 1}},
+		// Blobs which were not started before are not retried.
+		imageTypes.ProgressProperties{Event: imageTypes.ProgressEventNewArtifact, Artifact: imageTypes.BlobInfo{Digest: digest.FromString("new"), Size: 1}},
+	)
+
+	expected := []struct {
+		event   string
+		current uint64
+		attempt int
+	}{
+		{types.ProgressEventStart, 0, 0},
+		{types.ProgressEventProgress, 10, 0},
+		{types.ProgressEventStart, 0, 0},
+		{types.ProgressEventRetry, 0, 1},
+		{types.ProgressEventProgress, 42, 1},
+		{types.ProgressEventDone, 42, 1},
+		{types.ProgressEventSkipped, 0, 1},
+		{types.ProgressEventStart, 0, 1},
+	}
+	assert.Len(t, events, len(expected))
+	for i, e := range expected {
+		assert.Equal(t, e.event, events[i].Event, "event %d", i)
+		assert.Equal(t, e.current, events[i].Current, "current of event %d", i)
+		assert.Equal(t, e.attempt, events[i].Attempt, "attempt of event %d", i)
+	}
+	assert.Equal(t, blob.Digest.String(), events[0].Digest)
+	assert.Equal(t, int64(42), events[0].Total)
+}
+
+func TestTrackerRun(t *testing.T) {
+	var events []*types.ProgressEvent
+	tracker := NewTracker(func(event *types.ProgressEvent) {
+		events = append(events, event)
+	})
+
+	blob := imageTypes.BlobInfo{Digest: digest.FromString("blob"), Size: 42}
+	runs := 0
+	maxRetries := uint(2)
+	delay := time.Duration(0)
+	err := tracker.Run(context.Background(), RetryOptions(&maxRetries, &delay), func() error {
+		runs++
+		tracker.Report(imageTypes.ProgressProperties{Event: imageTypes.ProgressEventNewArtifact, Artifact: blob})
+		if runs == 1 {
+			return &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, runs)
+	assert.Len(t, events, 2)
+	assert.Equal(t, types.ProgressEventStart, events[0].Event)
+	assert.Equal(t, types.ProgressEventRetry, events[1].Event)
+	assert.Equal(t, 1, events[1].Attempt)
+
+	// Errors which are not retried end the operation.
+	runs = 0
+	err = tracker.Run(context.Background(), RetryOptions(&maxRetries, &delay), func() error {
+		runs++
+		return errors.New("no such image")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, runs)
+}
+
+func TestPullReportWriter(t *testing.T) {
+	var events []*types.ProgressEvent
+	w := NewPullReportWriter(func(event *types.ProgressEvent) {
+		events = append(events, event)
+	})
+
+	blob := digest.FromString("blob")
+	config := digest.FromString("config")
+	// Commits are not reported.
+	fmt.Fprintf(w, "Copying blob %s\nWriting manifest to image destination\n", digest.FromString("commit"))
+	// Lines may be split across writes.
+	fmt.Fprintf(w, "Trying to pull quay.io/libpod/alpine:latest...\nGetting image source signatures\nCopying blob %s\nCopy", blob)
+	fmt.Fprintf(w, "ing config %s\nWriting manifest to image destination\n", config)
+
+	expected := []struct {
+		event  string
+		digest digest.Digest
+	}{
+		{types.ProgressEventStart, blob},
+		{types.ProgressEventStart, config},
+		{types.ProgressEventDone, blob},
+		{types.ProgressEventDone, config},
+	}
+	assert.Len(t, events, len(expected))
+	for i, e := range expected {
+		assert.Equal(t, e.event, events[i].Event, "event %d", i)
+		assert.Equal(t, e.digest.String(), events[i].Digest, "digest of event %d", i)
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	assert.NoError(t, ValidateFormat(FormatText))
+	assert.NoError(t, ValidateFormat(FormatJSON))
+	assert.Error(t, ValidateFormat("yaml"))
+}
@@ -53,14 +53,22 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 		filterFuncs = append(filterFuncs, runningOnly)
 	}
 
+	// Let the database skip containers which cannot match the filters
+	// instead of loading all of them.
+	query, err := filters.GenerateContainerQuery(options.Filters, runtime)
+	if err != nil {
+		return nil, err
+	}
+
 	// Load the containers with their states populated.  This speeds things
-	// up considerably as we use a signel DB connection to load the
-	// containers' states instead of one per container.
+	// up considerably as we use a single query to load the containers'
+	// states instead of one per container, both for the filters and for
+	// the listing below.
 	//
 	// This may return slightly outdated states but that's acceptable for
 	// listing containers; any state is outdated the point a container lock
 	// gets released.
-	cons, err := runtime.GetContainers(true, filterFuncs...)
+	cons, err := runtime.QueryContainers(true, query, filterFuncs...)
 	if err != nil {
 		return nil, err
 	}
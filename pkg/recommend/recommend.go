@@ -0,0 +1,317 @@
+// Package recommend computes resource limit recommendations for containers
+// from samples of their resource usage.
+package recommend
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// FormatUpdate outputs a podman update command.
+	FormatUpdate = "update"
+	// FormatQuadlet outputs the keys of a Quadlet .container file.
+	FormatQuadlet = "quadlet"
+	// FormatKube outputs a patch of the resources of a Kubernetes pod.
+	FormatKube = "kube"
+	// FormatJSON outputs the usage and the recommendation as JSON.
+	FormatJSON = "json"
+)
+
+const (
+	mebibyte = 1024 * 1024
+	// minMemoryLimit is the smallest memory limit accepted by Podman.
+	minMemoryLimit = 6 * mebibyte
+	// minCPUs is the smallest CPU limit recommended.
+	minCPUs = 0.01
+	// minIORate is the smallest block I/O rate limit recommended, in
+	// bytes per second.
+	minIORate = mebibyte
+)
+
+// Sample is a single sample of the cumulative resource usage of a container.
+type Sample struct {
+	Time        time.Time `json:"time"`
+	CPUNano     uint64    `json:"cpu_nano"`
+	MemUsage    uint64    `json:"mem_usage"`
+	PIDs        uint64    `json:"pids"`
+	BlockInput  uint64    `json:"block_input"`
+	BlockOutput uint64    `json:"block_output"`
+}
+
+// SampleFromStats returns the sample of the given container stats.
+func SampleFromStats(stats *define.ContainerStats) Sample {
+	return Sample{
+		Time:        time.Unix(0, int64(stats.SystemNano)),
+		CPUNano:     stats.CPUNano,
+		MemUsage:    stats.MemUsage,
+		PIDs:        stats.PIDs,
+		BlockInput:  stats.BlockInput,
+		BlockOutput: stats.BlockOutput,
+	}
+}
+
+// ReadSamples reads samples written by WriteSample from r.
+func ReadSamples(r io.Reader) ([]Sample, error) {
+	var samples []Sample
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var sample Sample
+		if err := json.Unmarshal([]byte(line), &sample); err != nil {
+			return nil, fmt.Errorf("decoding sample %q: %w", line, err)
+		}
+		samples = append(samples, sample)
+	}
+	return samples, scanner.Err()
+}
+
+// WriteSample writes sample as a single line of JSON to w.
+func WriteSample(w io.Writer, sample Sample) error {
+	b, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// Percentiles summarizes the distribution of a resource usage.
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// Usage is the resource usage of a container over a window.
+type Usage struct {
+	// Samples is the number of samples the usage is computed from.
+	Samples int `json:"samples"`
+	// Window is the time between the first and the last sample.
+	Window time.Duration `json:"window"`
+	// CPU is the CPU usage in cores.
+	CPU Percentiles `json:"cpu"`
+	// Memory is the memory usage in bytes.
+	Memory Percentiles `json:"memory"`
+	// PIDs is the number of processes.
+	PIDs Percentiles `json:"pids"`
+	// BlockRead is the block I/O read throughput in bytes per second.
+	BlockRead Percentiles `json:"block_read"`
+	// BlockWrite is the block I/O write throughput in bytes per second.
+	BlockWrite Percentiles `json:"block_write"`
+}
+
+// Analyze computes the resource usage from samples.  CPU and block I/O are
+// computed from the differences of consecutive samples, so at least two
+// samples are required.
+func Analyze(samples []Sample) (*Usage, error) {
+	if len(samples) < 2 {
+		return nil, errors.New("at least two samples are required to compute a recommendation")
+	}
+	samples = append([]Sample(nil), samples...)
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+
+	var cpu, memory, pids, blockRead, blockWrite []float64
+	for i, sample := range samples {
+		memory = append(memory, float64(sample.MemUsage))
+		pids = append(pids, float64(sample.PIDs))
+		if i == 0 {
+			continue
+		}
+		prev := samples[i-1]
+		elapsed := sample.Time.Sub(prev.Time).Seconds()
+		// Counters are reset when the container is restarted.
+		if elapsed <= 0 || sample.CPUNano < prev.CPUNano || sample.BlockInput < prev.BlockInput || sample.BlockOutput < prev.BlockOutput {
+			continue
+		}
+		cpu = append(cpu, float64(sample.CPUNano-prev.CPUNano)/float64(time.Second)/elapsed)
+		blockRead = append(blockRead, float64(sample.BlockInput-prev.BlockInput)/elapsed)
+		blockWrite = append(blockWrite, float64(sample.BlockOutput-prev.BlockOutput)/elapsed)
+	}
+	if len(cpu) == 0 {
+		return nil, errors.New("samples do not cover a continuous run of the container")
+	}
+
+	return &Usage{
+		Samples:    len(samples),
+		Window:     samples[len(samples)-1].Time.Sub(samples[0].Time),
+		CPU:        percentiles(cpu),
+		Memory:     percentiles(memory),
+		PIDs:       percentiles(pids),
+		BlockRead:  percentiles(blockRead),
+		BlockWrite: percentiles(blockWrite),
+	}, nil
+}
+
+// percentiles computes the nearest-rank percentiles of values.
+func percentiles(values []float64) Percentiles {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := func(p float64) float64 {
+		i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return sorted[max(i, 0)]
+	}
+	return Percentiles{
+		P50: rank(50),
+		P90: rank(90),
+		P95: rank(95),
+		P99: rank(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// Recommendation are the recommended resource requests and limits of a
+// container.
+type Recommendation struct {
+	// Headroom is the fraction added on top of the observed usage.
+	Headroom float64 `json:"headroom"`
+	// CPURequest and CPULimit are in cores.
+	CPURequest float64 `json:"cpu_request"`
+	CPULimit   float64 `json:"cpu_limit"`
+	// MemoryRequest and MemoryLimit are in bytes.
+	MemoryRequest uint64 `json:"memory_request"`
+	MemoryLimit   uint64 `json:"memory_limit"`
+	PidsLimit     int64  `json:"pids_limit"`
+	// Device is the block device of the I/O limits, which are only
+	// recommended if it is set.
+	Device string `json:"device,omitempty"`
+	// DeviceReadBps and DeviceWriteBps are in bytes per second.
+	DeviceReadBps  uint64 `json:"device_read_bps,omitempty"`
+	DeviceWriteBps uint64 `json:"device_write_bps,omitempty"`
+}
+
+// Recommend computes the recommendation for usage with the given headroom,
+// e.g. 0.2 for 20%.  Requests are based on the 90th percentile of the usage.
+// Limits are based on the 99th percentile of the CPU and block I/O usage and
+// on the maximum of the memory and PIDs usage, which cannot be throttled,
+// plus the headroom.  Block I/O limits are only recommended for device, if
+// set, as the usage is not known per device.
+func Recommend(usage *Usage, headroom float64, device string) *Recommendation {
+	factor := 1 + headroom
+	r := &Recommendation{
+		Headroom:      headroom,
+		CPURequest:    roundCPUs(usage.CPU.P90),
+		CPULimit:      roundCPUs(usage.CPU.P99 * factor),
+		MemoryRequest: roundMemory(usage.Memory.P90),
+		MemoryLimit:   roundMemory(usage.Memory.Max * factor),
+		PidsLimit:     max(int64(math.Ceil(usage.PIDs.Max*factor)), 1),
+	}
+	if device != "" {
+		r.Device = device
+		r.DeviceReadBps = roundIORate(usage.BlockRead.P99 * factor)
+		r.DeviceWriteBps = roundIORate(usage.BlockWrite.P99 * factor)
+	}
+	return r
+}
+
+// roundCPUs rounds cpus up to a hundredth of a core.
+func roundCPUs(cpus float64) float64 {
+	return math.Max(math.Ceil(cpus*100)/100, minCPUs)
+}
+
+// roundMemory rounds bytes up to a mebibyte.
+func roundMemory(bytes float64) uint64 {
+	return max(uint64(math.Ceil(bytes/mebibyte))*mebibyte, minMemoryLimit)
+}
+
+// roundIORate rounds bytes per second up to a mebibyte per second.
+func roundIORate(rate float64) uint64 {
+	return max(uint64(math.Ceil(rate/mebibyte))*mebibyte, minIORate)
+}
+
+func formatCPUs(cpus float64) string {
+	return strconv.FormatFloat(cpus, 'f', -1, 64)
+}
+
+func formatMemory(bytes uint64) string {
+	return fmt.Sprintf("%dm", bytes/mebibyte)
+}
+
+// UpdateFlags returns the podman update flags applying the recommendation.
+func (r *Recommendation) UpdateFlags() []string {
+	return append(r.podmanArgs(), "--pids-limit="+strconv.FormatInt(r.PidsLimit, 10))
+}
+
+// podmanArgs returns the flags applying the limits without a Quadlet key.
+func (r *Recommendation) podmanArgs() []string {
+	flags := []string{
+		"--cpus=" + formatCPUs(r.CPULimit),
+		"--memory=" + formatMemory(r.MemoryLimit),
+		"--memory-reservation=" + formatMemory(r.MemoryRequest),
+	}
+	if r.Device != "" {
+		flags = append(flags,
+			"--device-read-bps="+r.Device+":"+formatMemory(r.DeviceReadBps),
+			"--device-write-bps="+r.Device+":"+formatMemory(r.DeviceWriteBps))
+	}
+	return flags
+}
+
+// QuadletKeys returns the keys of the [Container] section of a Quadlet
+// .container file applying the recommendation.
+func (r *Recommendation) QuadletKeys() []string {
+	return []string{
+		"PidsLimit=" + strconv.FormatInt(r.PidsLimit, 10),
+		"PodmanArgs=" + strings.Join(r.podmanArgs(), " "),
+	}
+}
+
+type kubeResources struct {
+	Requests map[string]string `json:"requests"`
+	Limits   map[string]string `json:"limits"`
+}
+
+type kubeContainer struct {
+	Name      string        `json:"name"`
+	Resources kubeResources `json:"resources"`
+}
+
+type kubePatch struct {
+	Spec struct {
+		Containers []kubeContainer `json:"containers"`
+	} `json:"spec"`
+}
+
+// KubePatch returns a strategic merge patch setting the resources of the
+// container name in a Kubernetes pod spec.
+func (r *Recommendation) KubePatch(name string) ([]byte, error) {
+	var patch kubePatch
+	patch.Spec.Containers = []kubeContainer{{
+		Name: name,
+		Resources: kubeResources{
+			Requests: map[string]string{
+				"cpu":    formatMilliCPUs(r.CPURequest),
+				"memory": formatMebibytes(r.MemoryRequest),
+			},
+			Limits: map[string]string{
+				"cpu":    formatMilliCPUs(r.CPULimit),
+				"memory": formatMebibytes(r.MemoryLimit),
+			},
+		},
+	}}
+	return yaml.Marshal(patch)
+}
+
+func formatMilliCPUs(cpus float64) string {
+	return fmt.Sprintf("%dm", int64(math.Round(cpus*1000)))
+}
+
+func formatMebibytes(bytes uint64) string {
+	return fmt.Sprintf("%dMi", bytes/mebibyte)
+}
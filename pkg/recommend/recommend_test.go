@@ -0,0 +1,130 @@
+package recommend
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSamples returns one sample per second using half a core, 100 MiB of
+// memory growing by 1 MiB per sample, 4 to 13 PIDs and reading 1 KiB/s.
+func testSamples() []Sample {
+	start := time.Unix(1700000000, 0)
+	samples := make([]Sample, 0, 11)
+	for i := range 11 {
+		samples = append(samples, Sample{
+			Time:       start.Add(time.Duration(i) * time.Second),
+			CPUNano:    uint64(i) * uint64(time.Second/2),
+			MemUsage:   uint64(100+i) * mebibyte,
+			PIDs:       uint64(4 + min(i, 9)),
+			BlockInput: uint64(i) * 1024,
+		})
+	}
+	return samples
+}
+
+func TestAnalyze(t *testing.T) {
+	usage, err := Analyze(testSamples())
+	require.NoError(t, err)
+	assert.Equal(t, 11, usage.Samples)
+	assert.Equal(t, 10*time.Second, usage.Window)
+	assert.InDelta(t, 0.5, usage.CPU.P50, 1e-9)
+	assert.InDelta(t, 0.5, usage.CPU.Max, 1e-9)
+	assert.Equal(t, float64(105*mebibyte), usage.Memory.P50)
+	assert.Equal(t, float64(110*mebibyte), usage.Memory.Max)
+	assert.Equal(t, float64(13), usage.PIDs.Max)
+	assert.Equal(t, float64(1024), usage.BlockRead.P99)
+	assert.Equal(t, float64(0), usage.BlockWrite.Max)
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	_, err := Analyze(testSamples()[:1])
+	assert.ErrorContains(t, err, "at least two samples are required")
+
+	samples := testSamples()[:2]
+	samples[0].CPUNano = samples[1].CPUNano + 1
+	_, err = Analyze(samples)
+	assert.ErrorContains(t, err, "continuous run")
+}
+
+func TestPercentiles(t *testing.T) {
+	values := make([]float64, 0, 100)
+	for i := 100; i > 0; i-- {
+		values = append(values, float64(i))
+	}
+	assert.Equal(t, Percentiles{P50: 50, P90: 90, P95: 95, P99: 99, Max: 100}, percentiles(values))
+	assert.Equal(t, Percentiles{P50: 7, P90: 7, P95: 7, P99: 7, Max: 7}, percentiles([]float64{7}))
+}
+
+func TestRecommend(t *testing.T) {
+	usage, err := Analyze(testSamples())
+	require.NoError(t, err)
+	r := Recommend(usage, 0.2, "")
+
+	assert.Equal(t, 0.5, r.CPURequest)
+	assert.Equal(t, 0.6, r.CPULimit)
+	assert.Equal(t, uint64(109*mebibyte), r.MemoryRequest)
+	assert.Equal(t, uint64(132*mebibyte), r.MemoryLimit)
+	assert.Equal(t, int64(16), r.PidsLimit)
+
+	assert.Equal(t, []string{"--cpus=0.6", "--memory=132m", "--memory-reservation=109m", "--pids-limit=16"}, r.UpdateFlags())
+	assert.Equal(t, []string{"PidsLimit=16", "PodmanArgs=--cpus=0.6 --memory=132m --memory-reservation=109m"}, r.QuadletKeys())
+
+	patch, err := r.KubePatch("web")
+	require.NoError(t, err)
+	assert.Equal(t, `spec:
+  containers:
+  - name: web
+    resources:
+      limits:
+        cpu: 600m
+        memory: 132Mi
+      requests:
+        cpu: 500m
+        memory: 109Mi
+`, string(patch))
+}
+
+func TestRecommendIO(t *testing.T) {
+	samples := testSamples()
+	for i := range samples {
+		samples[i].BlockOutput = uint64(i) * 10 * mebibyte
+	}
+	usage, err := Analyze(samples)
+	require.NoError(t, err)
+	r := Recommend(usage, 0.2, "/dev/sda")
+
+	assert.Equal(t, "/dev/sda", r.Device)
+	assert.Equal(t, uint64(minIORate), r.DeviceReadBps)
+	assert.Equal(t, uint64(12*mebibyte), r.DeviceWriteBps)
+
+	assert.Equal(t, []string{"--cpus=0.6", "--memory=132m", "--memory-reservation=109m",
+		"--device-read-bps=/dev/sda:1m", "--device-write-bps=/dev/sda:12m", "--pids-limit=16"}, r.UpdateFlags())
+	assert.Equal(t, []string{"PidsLimit=16",
+		"PodmanArgs=--cpus=0.6 --memory=132m --memory-reservation=109m --device-read-bps=/dev/sda:1m --device-write-bps=/dev/sda:12m"}, r.QuadletKeys())
+}
+
+func TestRecommendMinimums(t *testing.T) {
+	r := Recommend(&Usage{}, 0.2, "/dev/sda")
+	assert.Equal(t, minCPUs, r.CPULimit)
+	assert.Equal(t, uint64(minMemoryLimit), r.MemoryLimit)
+	assert.Equal(t, int64(1), r.PidsLimit)
+	assert.Equal(t, uint64(minIORate), r.DeviceWriteBps)
+}
+
+func TestSamplesRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	for _, sample := range testSamples() {
+		require.NoError(t, WriteSample(&buf, sample))
+	}
+	samples, err := ReadSamples(&buf)
+	require.NoError(t, err)
+	require.Len(t, samples, 11)
+	for i, sample := range testSamples() {
+		assert.True(t, sample.Time.Equal(samples[i].Time))
+		assert.Equal(t, sample.CPUNano, samples[i].CPUNano)
+	}
+}
@@ -0,0 +1,80 @@
+package seccompnotify
+
+import (
+	"fmt"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Apply changes the seccomp filter of a container to enforce the policy.
+// The syscalls of rules with a handler are passed to the listener, the
+// others get the action of their first rule.  A nil filter is replaced by
+// one that allows all other syscalls.  Policies for syscalls with argument
+// filtered rules in the filter are refused: the rules of the policy would
+// replace the conditions of the filter, e.g. the clone flags of the default
+// profile.
+func (p *Policy) Apply(filter *spec.LinuxSeccomp) (*spec.LinuxSeccomp, error) {
+	if filter == nil {
+		filter = &spec.LinuxSeccomp{DefaultAction: spec.ActAllow}
+	}
+
+	var names []string
+	actions := make(map[string]*spec.LinuxSyscall)
+	for _, rule := range p.Rules {
+		for _, name := range rule.Names {
+			if _, ok := actions[name]; !ok {
+				names = append(names, name)
+				actions[name] = nil
+			}
+			switch current := actions[name]; {
+			case rule.Handler != "":
+				// The listener evaluates all rules of the syscall.
+				actions[name] = &spec.LinuxSyscall{Action: spec.ActNotify}
+			case current != nil:
+				// An earlier rule already decides.
+			case rule.Action == ActionAllow:
+				actions[name] = &spec.LinuxSyscall{Action: spec.ActAllow}
+			default:
+				errno, err := errnoValue(rule.errno())
+				if err != nil {
+					return nil, err
+				}
+				ret := uint(errno)
+				actions[name] = &spec.LinuxSyscall{Action: spec.ActErrno, ErrnoRet: &ret}
+			}
+		}
+	}
+
+	// Drop the existing rules of the syscalls of the policy, the filter
+	// would otherwise have conflicting actions for them.
+	syscalls := make([]spec.LinuxSyscall, 0, len(filter.Syscalls)+len(names))
+	for _, syscall := range filter.Syscalls {
+		if len(syscall.Args) > 0 {
+			for _, name := range syscall.Names {
+				if _, ok := actions[name]; ok {
+					return nil, fmt.Errorf("syscall %s has argument filtered rules in the seccomp profile, which a seccomp notify policy cannot replace", name)
+				}
+			}
+		}
+		kept := make([]string, 0, len(syscall.Names))
+		for _, name := range syscall.Names {
+			if _, ok := actions[name]; !ok {
+				kept = append(kept, name)
+			}
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		syscall.Names = kept
+		syscalls = append(syscalls, syscall)
+	}
+	for _, name := range names {
+		syscall := *actions[name]
+		syscall.Names = []string{name}
+		syscalls = append(syscalls, syscall)
+	}
+
+	filtered := *filter
+	filtered.Syscalls = syscalls
+	return &filtered, nil
+}
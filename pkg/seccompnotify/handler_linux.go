@@ -0,0 +1,180 @@
+package seccompnotify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Handler matches and emulates the intercepted syscalls of a rule.
+type Handler interface {
+	// Match reports whether the rule of the handler applies to the call.
+	Match(req *Request) (bool, error)
+	// Emulate performs the call on behalf of the caller and returns the
+	// return value of the syscall.  A returned unix.Errno is the error
+	// of the syscall, any other error fails it with EPERM.
+	Emulate(req *Request) (int64, error)
+}
+
+// HandlerFactory creates a handler from the args of a rule.
+type HandlerFactory func(args json.RawMessage) (Handler, error)
+
+type handlerInfo struct {
+	// syscalls are the syscalls the handler supports.
+	syscalls map[string]bool
+	factory  HandlerFactory
+}
+
+var (
+	handlersLock sync.Mutex
+	handlers     = make(map[string]handlerInfo)
+	// syscallNames are the names of the native syscall numbers of all
+	// handlers.
+	syscallNames = make(map[int32]string)
+)
+
+// RegisterHandler registers the handler name for the given syscalls, by
+// name and native syscall number.
+func RegisterHandler(name string, syscalls map[string]int, factory HandlerFactory) {
+	handlersLock.Lock()
+	defer handlersLock.Unlock()
+	if _, ok := handlers[name]; ok {
+		panic(fmt.Sprintf("seccomp notify handler %q registered twice", name))
+	}
+	info := handlerInfo{syscalls: make(map[string]bool), factory: factory}
+	for syscall, nr := range syscalls {
+		info.syscalls[syscall] = true
+		syscallNames[int32(nr)] = syscall
+	}
+	handlers[name] = info
+}
+
+// newHandler creates the handler of a rule.
+func newHandler(r *Rule) (Handler, error) {
+	handlersLock.Lock()
+	info, ok := handlers[r.Handler]
+	handlersLock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown handler %q", r.Handler)
+	}
+	for _, name := range r.Names {
+		if !info.syscalls[name] {
+			return nil, fmt.Errorf("handler %q does not support syscall %q", r.Handler, name)
+		}
+	}
+	h, err := info.factory(r.Args)
+	if err != nil {
+		return nil, fmt.Errorf("handler %q: %w", r.Handler, err)
+	}
+	return h, nil
+}
+
+func validateRule(r *Rule) error {
+	if _, err := errnoValue(r.errno()); err != nil {
+		return err
+	}
+	if r.Handler == "" {
+		if len(r.Args) > 0 {
+			return errors.New("args require a handler")
+		}
+		return nil
+	}
+	_, err := newHandler(r)
+	return err
+}
+
+var errnoValues = func() map[string]unix.Errno {
+	values := make(map[string]unix.Errno)
+	for e := unix.Errno(1); e < 4096; e++ {
+		if name := unix.ErrnoName(e); name != "" {
+			values[name] = e
+		}
+	}
+	return values
+}()
+
+// errnoValue returns the errno named name, like EPERM.
+func errnoValue(name string) (unix.Errno, error) {
+	e, ok := errnoValues[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown errno %q", name)
+	}
+	return e, nil
+}
+
+// Request is an intercepted syscall.
+type Request struct {
+	// ID identifies the request for the kernel.
+	ID uint64
+	// Pid is the process that made the syscall, in the PID namespace of
+	// the listener.
+	Pid int
+	// Syscall is the name of the syscall.
+	Syscall string
+	// Args are the raw arguments of the syscall.
+	Args [6]uint64
+	// HandlerData is set by the Match method of a handler to pass what
+	// it read from the caller to Emulate, which must not read it again.
+	HandlerData interface{}
+
+	// fd is the notify file descriptor the request was received on.
+	fd int
+}
+
+// Valid reports whether the request is still pending.  It is false once
+// the calling process died, the PID may already belong to another
+// process then.
+func (r *Request) Valid() bool {
+	id := r.ID
+	return ioctl(r.fd, unix.SECCOMP_IOCTL_NOTIF_ID_VALID, unsafe.Pointer(&id)) == nil
+}
+
+// ProcPath returns the path of a file in the /proc directory of the caller.
+func (r *Request) ProcPath(name string) string {
+	return "/proc/" + strconv.Itoa(r.Pid) + "/" + name
+}
+
+// ReadString reads a NUL terminated string, like a path, at addr in the
+// memory of the caller.
+func (r *Request) ReadString(addr uint64) (string, error) {
+	mem, err := os.Open(r.ProcPath("mem"))
+	if err != nil {
+		return "", err
+	}
+	defer mem.Close()
+
+	pageSize := uint64(os.Getpagesize())
+	buf := make([]byte, 0, unix.PathMax)
+	chunk := make([]byte, pageSize)
+	for len(buf) < unix.PathMax {
+		// Do not read past the page of addr, the next one might not
+		// be mapped.
+		n := pageSize - addr%pageSize
+		if remaining := uint64(unix.PathMax - len(buf)); n > remaining {
+			n = remaining
+		}
+		read, err := mem.ReadAt(chunk[:n], int64(addr))
+		if read == 0 && err != nil {
+			return "", unix.EFAULT
+		}
+		if i := bytes.IndexByte(chunk[:read], 0); i >= 0 {
+			buf = append(buf, chunk[:i]...)
+			// The memory may belong to another process if the
+			// caller died in the meantime.
+			if !r.Valid() {
+				return "", unix.ENOENT
+			}
+			return string(buf), nil
+		}
+		buf = append(buf, chunk[:read]...)
+		addr += uint64(read)
+	}
+	return "", unix.ENAMETOOLONG
+}
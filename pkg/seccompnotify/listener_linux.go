@@ -0,0 +1,333 @@
+package seccompnotify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// seccompData is struct seccomp_data of linux/seccomp.h.
+type seccompData struct {
+	Nr                 int32
+	Arch               uint32
+	InstructionPointer uint64
+	Args               [6]uint64
+}
+
+// seccompNotif is struct seccomp_notif of linux/seccomp.h.
+type seccompNotif struct {
+	ID    uint64
+	Pid   uint32
+	Flags uint32
+	Data  seccompData
+}
+
+// seccompNotifResp is struct seccomp_notif_resp of linux/seccomp.h.
+type seccompNotifResp struct {
+	ID    uint64
+	Val   int64
+	Error int32
+	Flags uint32
+}
+
+// nativeArches are the audit architectures of the syscall numbers of the
+// handlers, by GOARCH.
+var nativeArches = map[string]uint32{
+	"386":      unix.AUDIT_ARCH_I386,
+	"amd64":    unix.AUDIT_ARCH_X86_64,
+	"arm":      unix.AUDIT_ARCH_ARM,
+	"arm64":    unix.AUDIT_ARCH_AARCH64,
+	"loong64":  unix.AUDIT_ARCH_LOONGARCH64,
+	"mips64le": unix.AUDIT_ARCH_MIPSEL64,
+	"ppc64le":  unix.AUDIT_ARCH_PPC64LE,
+	"riscv64":  unix.AUDIT_ARCH_RISCV64,
+	"s390x":    unix.AUDIT_ARCH_S390X,
+}
+
+// maxStateFds is the maximum number of file descriptors the runtime passes
+// with the state of a container process.
+const maxStateFds = 16
+
+type listenerRule struct {
+	Rule
+	names   map[string]bool
+	handler Handler
+	errno   unix.Errno
+}
+
+// Listener receives the seccomp notify file descriptors of the processes
+// of a container from the OCI runtime and handles their intercepted
+// syscalls according to a policy.
+type Listener struct {
+	socket *net.UnixListener
+	rules  []listenerRule
+	arch   uint32
+
+	lock sync.Mutex
+	// active is the number of notify file descriptors being served.
+	active int
+	// received is set once the first file descriptor was received.
+	received bool
+}
+
+// Listen creates the listener of policy on the unix socket at path, the
+// listenerPath of the seccomp filter of the container.
+func Listen(path string, policy *Policy) (*Listener, error) {
+	arch, ok := nativeArches[runtime.GOARCH]
+	if !ok {
+		return nil, fmt.Errorf("seccomp notify is not supported on %s", runtime.GOARCH)
+	}
+	l, err := newListener(policy, arch)
+	if err != nil {
+		return nil, err
+	}
+	socket, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	l.socket = socket
+	return l, nil
+}
+
+// newListener creates the handlers of the rules of policy.
+func newListener(policy *Policy, arch uint32) (*Listener, error) {
+	l := &Listener{arch: arch}
+	for _, rule := range policy.Rules {
+		lr := listenerRule{Rule: rule, names: make(map[string]bool)}
+		for _, name := range rule.Names {
+			lr.names[name] = true
+		}
+		errno, err := errnoValue(rule.errno())
+		if err != nil {
+			return nil, err
+		}
+		lr.errno = errno
+		if rule.Handler != "" {
+			if lr.handler, err = newHandler(&rule); err != nil {
+				return nil, err
+			}
+		}
+		l.rules = append(l.rules, lr)
+	}
+	return l, nil
+}
+
+// Serve accepts the file descriptors of the runtime and handles their
+// syscalls.  It returns once all received file descriptors are closed,
+// that is once all processes of the container exited.
+func (l *Listener) Serve() error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := l.socket.AcceptUnix()
+		if err != nil {
+			l.lock.Lock()
+			done := l.received && l.active == 0
+			l.lock.Unlock()
+			if done {
+				return nil
+			}
+			return err
+		}
+		fd, err := receiveFd(conn)
+		conn.Close()
+		if err != nil {
+			logrus.Errorf("Receiving seccomp notify file descriptor: %v", err)
+			continue
+		}
+
+		l.lock.Lock()
+		l.received = true
+		l.active++
+		l.lock.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.serveFd(fd); err != nil {
+				logrus.Errorf("Handling seccomp notifications: %v", err)
+			}
+			unix.Close(fd)
+
+			l.lock.Lock()
+			l.active--
+			if l.active == 0 {
+				// Stops the accept loop.
+				l.socket.Close()
+			}
+			l.lock.Unlock()
+		}()
+	}
+}
+
+// Close stops the listener.
+func (l *Listener) Close() error {
+	return l.socket.Close()
+}
+
+// receiveFd receives the state of a container process from the runtime
+// and returns its seccomp notify file descriptor.
+func receiveFd(conn *net.UnixConn) (int, error) {
+	buf := make([]byte, 64*1024)
+	oob := make([]byte, unix.CmsgSpace(maxStateFds*4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return -1, err
+	}
+	var fds []int
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return -1, err
+	}
+	for i := range msgs {
+		rights, err := unix.ParseUnixRights(&msgs[i])
+		if err != nil {
+			continue
+		}
+		fds = append(fds, rights...)
+	}
+
+	// The state may not fit in a single message.
+	data := buf[:n]
+	rest, err := io.ReadAll(conn)
+	if err == nil {
+		data = append(data, rest...)
+	}
+	var state spec.ContainerProcessState
+	if err != nil {
+		err = fmt.Errorf("reading container process state: %w", err)
+	} else if err = json.Unmarshal(data, &state); err != nil {
+		err = fmt.Errorf("parsing container process state: %w", err)
+	}
+
+	seccompFd := -1
+	for i, fd := range fds {
+		if err == nil && seccompFd < 0 && i < len(state.Fds) && state.Fds[i] == spec.SeccompFdName {
+			seccompFd = fd
+			continue
+		}
+		unix.Close(fd)
+	}
+	if err != nil {
+		return -1, err
+	}
+	if seccompFd < 0 {
+		return -1, errors.New("no seccomp file descriptor in the container process state")
+	}
+	return seccompFd, nil
+}
+
+// serveFd handles the syscalls of fd until all processes using its filter
+// exited.
+func (l *Listener) serveFd(fd int) error {
+	for {
+		fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+		if _, err := unix.Poll(fds, -1); err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			return err
+		}
+		if fds[0].Revents&unix.POLLIN == 0 {
+			// POLLHUP, the filter has no users anymore.
+			return nil
+		}
+
+		var req seccompNotif
+		if err := ioctl(fd, unix.SECCOMP_IOCTL_NOTIF_RECV, unsafe.Pointer(&req)); err != nil {
+			// ENOENT: the caller died before the request was
+			// received.
+			if errors.Is(err, unix.EINTR) || errors.Is(err, unix.ENOENT) {
+				continue
+			}
+			return fmt.Errorf("receiving seccomp notification: %w", err)
+		}
+		resp := l.respond(fd, &req)
+		if err := ioctl(fd, unix.SECCOMP_IOCTL_NOTIF_SEND, unsafe.Pointer(&resp)); err != nil && !errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("sending seccomp notification response: %w", err)
+		}
+	}
+}
+
+// respond evaluates the rules of the policy for a syscall.  Syscalls that
+// match no rule are denied with EPERM.  Syscalls of other architectures,
+// e.g. of 32-bit programs, and unknown syscalls continue: the rules only
+// know the syscall numbers of the native architecture.
+func (l *Listener) respond(fd int, notif *seccompNotif) seccompNotifResp {
+	if notif.Data.Arch != l.arch {
+		return seccompNotifResp{ID: notif.ID, Flags: unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE}
+	}
+	name, ok := syscallNames[notif.Data.Nr]
+	if !ok {
+		return seccompNotifResp{ID: notif.ID, Flags: unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE}
+	}
+	resp := seccompNotifResp{ID: notif.ID, Error: -int32(unix.EPERM)}
+	req := &Request{
+		ID:      notif.ID,
+		Pid:     int(notif.Pid),
+		Syscall: name,
+		Args:    notif.Data.Args,
+		fd:      fd,
+	}
+
+	for i := range l.rules {
+		rule := &l.rules[i]
+		if !rule.names[name] {
+			continue
+		}
+		if rule.handler != nil {
+			match, err := rule.handler.Match(req)
+			if err != nil {
+				resp.Error = -int32(toErrno(err))
+				return resp
+			}
+			if !match {
+				continue
+			}
+		}
+		switch rule.Action {
+		case ActionAllow:
+			// The kernel reads the arguments again, another thread
+			// of the caller may have changed them since the match.
+			resp.Error = 0
+			resp.Flags = unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE
+		case ActionDeny:
+			resp.Error = -int32(rule.errno)
+		case ActionEmulate:
+			val, err := rule.handler.Emulate(req)
+			if err != nil {
+				resp.Error = -int32(toErrno(err))
+				return resp
+			}
+			resp.Error = 0
+			resp.Val = val
+		}
+		return resp
+	}
+	return resp
+}
+
+// toErrno returns the errno a failed handler fails the syscall with.
+func toErrno(err error) unix.Errno {
+	var errno unix.Errno
+	if errors.As(err, &errno) {
+		return errno
+	}
+	logrus.Warnf("Seccomp notify handler: %v", err)
+	return unix.EPERM
+}
+
+func ioctl(fd int, request uint, arg unsafe.Pointer) error {
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), uintptr(request), uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build linux && !arm64 && !riscv64 && !loong64
+
+package seccompnotify
+
+import "golang.org/x/sys/unix"
+
+// legacyMknodSyscalls are the syscalls of the mknod handler besides
+// mknodat on architectures that still have mknod.
+var legacyMknodSyscalls = map[string]int{"mknod": unix.SYS_MKNOD}
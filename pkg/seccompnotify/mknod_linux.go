@@ -0,0 +1,261 @@
+package seccompnotify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// MknodHandler is the name of the handler creating device nodes.
+const MknodHandler = "mknod"
+
+func init() {
+	syscalls := map[string]int{"mknodat": unix.SYS_MKNODAT}
+	for name, nr := range legacyMknodSyscalls {
+		syscalls[name] = nr
+	}
+	RegisterHandler(MknodHandler, syscalls, newMknodHandler)
+}
+
+// mknodDevice is a device node a container may create.
+type mknodDevice struct {
+	// Type is c for character devices, b for block devices and p for
+	// FIFOs.
+	Type string `json:"type"`
+	// Major and Minor are the device numbers, any if unset.
+	Major *uint32 `json:"major,omitempty"`
+	Minor *uint32 `json:"minor,omitempty"`
+	// Path is a glob the absolute path of the node must match, any path
+	// if empty.
+	Path string `json:"path,omitempty"`
+}
+
+type mknodArgs struct {
+	Devices []mknodDevice `json:"devices"`
+}
+
+// mknodCall is the decoded mknod or mknodat call.
+type mknodCall struct {
+	dirfd int
+	path  string
+	mode  uint32
+	major uint32
+	minor uint32
+}
+
+type mknodHandler struct {
+	devices []mknodDevice
+}
+
+func newMknodHandler(raw json.RawMessage) (Handler, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("no devices")
+	}
+	var args mknodArgs
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&args); err != nil {
+		return nil, err
+	}
+	if len(args.Devices) == 0 {
+		return nil, errors.New("no devices")
+	}
+	for _, dev := range args.Devices {
+		switch dev.Type {
+		case "c", "b", "p":
+		default:
+			return nil, fmt.Errorf("invalid device type %q: must be c, b or p", dev.Type)
+		}
+		if dev.Path != "" {
+			if !filepath.IsAbs(dev.Path) {
+				return nil, fmt.Errorf("device path %q is not absolute", dev.Path)
+			}
+			if _, err := filepath.Match(dev.Path, "/"); err != nil {
+				return nil, fmt.Errorf("invalid device path %q: %w", dev.Path, err)
+			}
+		}
+	}
+	return &mknodHandler{devices: args.Devices}, nil
+}
+
+func (h *mknodHandler) Match(req *Request) (bool, error) {
+	call := &mknodCall{dirfd: unix.AT_FDCWD}
+	args := req.Args[:]
+	if req.Syscall == "mknodat" {
+		call.dirfd = int(int32(args[0]))
+		args = args[1:]
+	}
+	path, err := req.ReadString(args[0])
+	if err != nil {
+		return false, err
+	}
+	call.path = path
+	call.mode = uint32(args[1])
+	call.major = unix.Major(args[2])
+	call.minor = unix.Minor(args[2])
+
+	if !h.matches(call) {
+		return false, nil
+	}
+	req.HandlerData = call
+	return true, nil
+}
+
+// matches reports whether one of the devices of the handler matches call.
+func (h *mknodHandler) matches(call *mknodCall) bool {
+	var kind string
+	switch call.mode & unix.S_IFMT {
+	case unix.S_IFCHR:
+		kind = "c"
+	case unix.S_IFBLK:
+		kind = "b"
+	case unix.S_IFIFO:
+		kind = "p"
+	default:
+		return false
+	}
+	for _, dev := range h.devices {
+		if dev.Type != kind ||
+			(kind != "p" && dev.Major != nil && *dev.Major != call.major) ||
+			(kind != "p" && dev.Minor != nil && *dev.Minor != call.minor) {
+			continue
+		}
+		if dev.Path != "" {
+			// Relative paths depend on the working directory of the
+			// caller and never match.
+			if !filepath.IsAbs(call.path) {
+				continue
+			}
+			if ok, _ := filepath.Match(dev.Path, filepath.Clean(call.path)); !ok {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Emulate creates the node in the mount namespace of the caller, with its
+// umask and owned by its filesystem user and group.
+func (h *mknodHandler) Emulate(req *Request) (int64, error) {
+	call, ok := req.HandlerData.(*mknodCall)
+	if !ok {
+		return 0, errors.New("mknod emulated without a match")
+	}
+	dir, base := filepath.Split(call.path)
+	if base == "" || base == "." || base == ".." {
+		return 0, unix.EEXIST
+	}
+	if dir == "" {
+		dir = "."
+	}
+
+	// Resolve the parent directory like the caller would, without
+	// leaving its root directory.
+	start := req.ProcPath("root")
+	resolve := uint64(unix.RESOLVE_IN_ROOT)
+	if !filepath.IsAbs(call.path) {
+		start = req.ProcPath("cwd")
+		if call.dirfd != unix.AT_FDCWD {
+			start = req.ProcPath("fd/" + strconv.Itoa(call.dirfd))
+		}
+		resolve = unix.RESOLVE_BENEATH
+	}
+	startFd, err := unix.Open(start, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(startFd)
+	parent, err := unix.Openat2(startFd, dir, &unix.OpenHow{
+		Flags:   unix.O_PATH | unix.O_DIRECTORY | unix.O_CLOEXEC,
+		Resolve: resolve | unix.RESOLVE_NO_MAGICLINKS,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(parent)
+
+	creds, err := readCredentials(req)
+	if err != nil {
+		return 0, err
+	}
+	// The PID may be reused once the caller died.
+	if !req.Valid() {
+		return 0, unix.ENOENT
+	}
+
+	dev := int(unix.Mkdev(call.major, call.minor))
+	if err := unix.Mknodat(parent, base, call.mode&^creds.umask, dev); err != nil {
+		return 0, err
+	}
+	if err := unix.Fchownat(parent, base, creds.uid, creds.gid, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		_ = unix.Unlinkat(parent, base, 0)
+		return 0, err
+	}
+	return 0, nil
+}
+
+type credentials struct {
+	umask uint32
+	uid   int
+	gid   int
+}
+
+// readCredentials reads the umask and the filesystem user and group of the
+// caller, in the user namespace of the listener.
+func readCredentials(req *Request) (*credentials, error) {
+	f, err := os.Open(req.ProcPath("status"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	creds := &credentials{umask: 0o022, uid: -1, gid: -1}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(value)
+		switch key {
+		case "Umask":
+			if len(fields) == 1 {
+				umask, err := strconv.ParseUint(fields[0], 8, 32)
+				if err != nil {
+					return nil, fmt.Errorf("parsing umask: %w", err)
+				}
+				creds.umask = uint32(umask)
+			}
+		case "Uid", "Gid":
+			// Real, effective, saved set and filesystem ID.
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("parsing %s of process %d: %q", key, req.Pid, value)
+			}
+			id, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s of process %d: %w", key, req.Pid, err)
+			}
+			if key == "Uid" {
+				creds.uid = id
+			} else {
+				creds.gid = id
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if creds.uid < 0 || creds.gid < 0 {
+		return nil, fmt.Errorf("no credentials in the status of process %d", req.Pid)
+	}
+	return creds, nil
+}
@@ -0,0 +1,6 @@
+//go:build linux && (arm64 || riscv64 || loong64)
+
+package seccompnotify
+
+// legacyMknodSyscalls is empty, the architecture only has mknodat.
+var legacyMknodSyscalls = map[string]int{}
@@ -0,0 +1,270 @@
+package seccompnotify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// MountHandler is the name of the handler mounting filesystems.
+const MountHandler = "mount"
+
+func init() {
+	RegisterHandler(MountHandler, map[string]int{"mount": unix.SYS_MOUNT}, newMountHandler)
+}
+
+// mountAttributes are the flags of the mount calls the handler emulates,
+// with their mount attributes.  Calls with other flags, like bind mounts,
+// remounts or propagation changes, never match.
+var mountAttributes = map[uint64]uint64{
+	unix.MS_RDONLY:      unix.MOUNT_ATTR_RDONLY,
+	unix.MS_NOSUID:      unix.MOUNT_ATTR_NOSUID,
+	unix.MS_NODEV:       unix.MOUNT_ATTR_NODEV,
+	unix.MS_NOEXEC:      unix.MOUNT_ATTR_NOEXEC,
+	unix.MS_NOATIME:     unix.MOUNT_ATTR_NOATIME,
+	unix.MS_NODIRATIME:  unix.MOUNT_ATTR_NODIRATIME,
+	unix.MS_RELATIME:    unix.MOUNT_ATTR_RELATIME,
+	unix.MS_STRICTATIME: unix.MOUNT_ATTR_STRICTATIME,
+	unix.MS_SILENT:      0,
+}
+
+// mountFilesystem is a filesystem a container may mount.
+type mountFilesystem struct {
+	// Type is the filesystem type, like tmpfs.
+	Type string `json:"type"`
+	// Source is a glob the source must match, any source if empty.
+	Source string `json:"source,omitempty"`
+	// Target is a glob the absolute path of the mount point must match,
+	// any path if empty.
+	Target string `json:"target,omitempty"`
+}
+
+type mountArgs struct {
+	Filesystems []mountFilesystem `json:"filesystems"`
+}
+
+// mountCall is the decoded mount call.
+type mountCall struct {
+	source string
+	target string
+	fstype string
+	flags  uint64
+	data   string
+}
+
+type mountHandler struct {
+	filesystems []mountFilesystem
+}
+
+func newMountHandler(raw json.RawMessage) (Handler, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("no filesystems")
+	}
+	var args mountArgs
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&args); err != nil {
+		return nil, err
+	}
+	if len(args.Filesystems) == 0 {
+		return nil, errors.New("no filesystems")
+	}
+	for _, fs := range args.Filesystems {
+		if fs.Type == "" {
+			return nil, errors.New("filesystem without type")
+		}
+		if _, err := filepath.Match(fs.Source, ""); err != nil {
+			return nil, fmt.Errorf("invalid filesystem source %q: %w", fs.Source, err)
+		}
+		if fs.Target != "" {
+			if !filepath.IsAbs(fs.Target) {
+				return nil, fmt.Errorf("filesystem target %q is not absolute", fs.Target)
+			}
+			if _, err := filepath.Match(fs.Target, "/"); err != nil {
+				return nil, fmt.Errorf("invalid filesystem target %q: %w", fs.Target, err)
+			}
+		}
+	}
+	return &mountHandler{filesystems: args.Filesystems}, nil
+}
+
+func (h *mountHandler) Match(req *Request) (bool, error) {
+	// Calls without a filesystem type or target are invalid or change
+	// existing mounts, and never match.
+	if req.Args[1] == 0 || req.Args[2] == 0 {
+		return false, nil
+	}
+	call := &mountCall{flags: req.Args[3]}
+	// Like the kernel, ignore the magic number of old callers.
+	if call.flags&unix.MS_MGC_MSK == unix.MS_MGC_VAL {
+		call.flags &^= unix.MS_MGC_MSK
+	}
+	var err error
+	if call.target, err = req.ReadString(req.Args[1]); err != nil {
+		return false, err
+	}
+	if call.fstype, err = req.ReadString(req.Args[2]); err != nil {
+		return false, err
+	}
+	if req.Args[0] != 0 {
+		if call.source, err = req.ReadString(req.Args[0]); err != nil {
+			return false, err
+		}
+	}
+	if req.Args[4] != 0 {
+		if call.data, err = req.ReadString(req.Args[4]); err != nil {
+			return false, err
+		}
+	}
+
+	if !h.matches(call) {
+		return false, nil
+	}
+	req.HandlerData = call
+	return true, nil
+}
+
+// matches reports whether one of the filesystems of the handler matches
+// call.
+func (h *mountHandler) matches(call *mountCall) bool {
+	for flag := uint64(1); flag != 0; flag <<= 1 {
+		if _, ok := mountAttributes[flag]; call.flags&flag != 0 && !ok {
+			return false
+		}
+	}
+	// Relative targets depend on the working directory of the caller and
+	// never match.
+	if !filepath.IsAbs(call.target) {
+		return false
+	}
+	for _, fs := range h.filesystems {
+		if fs.Type != call.fstype {
+			continue
+		}
+		if fs.Source != "" {
+			if ok, _ := filepath.Match(fs.Source, call.source); !ok {
+				continue
+			}
+		}
+		if fs.Target != "" {
+			if ok, _ := filepath.Match(fs.Target, filepath.Clean(call.target)); !ok {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Emulate creates the filesystem in the listener and attaches it to the
+// target in the mount namespace of the caller.
+func (h *mountHandler) Emulate(req *Request) (int64, error) {
+	call, ok := req.HandlerData.(*mountCall)
+	if !ok {
+		return 0, errors.New("mount emulated without a match")
+	}
+
+	// Resolve the target like the caller would, without leaving its root
+	// directory.
+	root, err := unix.Open(req.ProcPath("root"), unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(root)
+	target, err := unix.Openat2(root, call.target, &unix.OpenHow{
+		Flags:   unix.O_PATH | unix.O_CLOEXEC,
+		Resolve: unix.RESOLVE_IN_ROOT | unix.RESOLVE_NO_MAGICLINKS,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(target)
+	mntns, err := unix.Open(req.ProcPath("ns/mnt"), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(mntns)
+
+	mnt, err := createMount(call)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(mnt)
+
+	// The PID may be reused once the caller died.
+	if !req.Valid() {
+		return 0, unix.ENOENT
+	}
+
+	return 0, inMountNamespace(mntns, func() error {
+		return unix.MoveMount(mnt, "", target, "", unix.MOVE_MOUNT_F_EMPTY_PATH|unix.MOVE_MOUNT_T_EMPTY_PATH)
+	})
+}
+
+// createMount creates the filesystem of call and returns a detached mount
+// of it.  The options of the data argument are comma separated, like the
+// options of most filesystems.
+func createMount(call *mountCall) (int, error) {
+	fsfd, err := unix.Fsopen(call.fstype, unix.FSOPEN_CLOEXEC)
+	if err != nil {
+		return -1, err
+	}
+	defer unix.Close(fsfd)
+	if call.source != "" {
+		if err := unix.FsconfigSetString(fsfd, "source", call.source); err != nil {
+			return -1, err
+		}
+	}
+	for _, option := range strings.Split(call.data, ",") {
+		if option == "" {
+			continue
+		}
+		if key, value, ok := strings.Cut(option, "="); ok {
+			err = unix.FsconfigSetString(fsfd, key, value)
+		} else {
+			err = unix.FsconfigSetFlag(fsfd, key)
+		}
+		if err != nil {
+			return -1, err
+		}
+	}
+	if err := unix.FsconfigCreate(fsfd); err != nil {
+		return -1, err
+	}
+
+	var attrs uint64
+	for flag, attr := range mountAttributes {
+		if call.flags&flag != 0 {
+			attrs |= attr
+		}
+	}
+	return unix.Fsmount(fsfd, unix.FSMOUNT_CLOEXEC, int(attrs))
+}
+
+// inMountNamespace runs fn on a thread in the mount namespace mntns.
+func inMountNamespace(mntns int, fn func() error) error {
+	errCh := make(chan error, 1)
+	go func() {
+		// The thread is never unlocked, so it exits with the goroutine
+		// instead of running other goroutines in the namespace.
+		runtime.LockOSThread()
+		// Threads share their filesystem information, which prevents
+		// joining a mount namespace.
+		if err := unix.Unshare(unix.CLONE_FS); err != nil {
+			errCh <- fmt.Errorf("unsharing filesystem information: %w", err)
+			return
+		}
+		if err := unix.Setns(mntns, unix.CLONE_NEWNS); err != nil {
+			errCh <- fmt.Errorf("joining mount namespace: %w", err)
+			return
+		}
+		errCh <- fn()
+	}()
+	return <-errCh
+}
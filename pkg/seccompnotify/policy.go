@@ -0,0 +1,117 @@
+// Package seccompnotify implements the seccomp user-notify listener of a
+// container.  The seccomp filter of the container passes the syscalls of a
+// policy to the listener, whose handlers allow, deny or emulate them.
+package seccompnotify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Action is what happens to a syscall matched by a rule.
+type Action string
+
+const (
+	// ActionAllow lets the kernel perform the syscall.
+	ActionAllow Action = "allow"
+	// ActionDeny fails the syscall with the errno of the rule.
+	ActionDeny Action = "deny"
+	// ActionEmulate performs the syscall in the listener on behalf of the
+	// container.  It requires a handler.
+	ActionEmulate Action = "emulate"
+)
+
+// DefaultErrno is the errno of denied syscalls unless a rule sets another
+// one.  Syscalls of the policy that match no rule are denied with it too.
+const DefaultErrno = "EPERM"
+
+// Policy is the syscall policy of a seccomp-notify listener.
+type Policy struct {
+	// Rules are evaluated in order, the first rule matching a syscall
+	// decides what happens to it.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule matches syscalls by name and, with a handler, by their arguments.
+type Rule struct {
+	// Names are the syscalls the rule applies to.
+	Names []string `json:"names"`
+	// Action is allow, deny or emulate.
+	Action Action `json:"action"`
+	// Errno is the error of denied syscalls, EPERM by default.
+	Errno string `json:"errno,omitempty"`
+	// Handler is the name of the handler matching and emulating the
+	// syscalls.  Rules without a handler match all calls of their syscalls
+	// and are enforced by the seccomp filter itself.
+	Handler string `json:"handler,omitempty"`
+	// Args configures the handler.
+	Args json.RawMessage `json:"args,omitempty"`
+}
+
+// LoadPolicy reads and validates the policy in the JSON file at path.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading seccomp notify policy: %w", err)
+	}
+	p, err := ParsePolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("seccomp notify policy %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// ParsePolicy parses and validates a policy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	p := new(Policy)
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks the rules of the policy and the arguments of their
+// handlers.
+func (p *Policy) Validate() error {
+	if len(p.Rules) == 0 {
+		return errors.New("no rules")
+	}
+	for i, rule := range p.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if len(r.Names) == 0 {
+		return errors.New("no syscall names")
+	}
+	switch r.Action {
+	case ActionAllow, ActionDeny:
+	case ActionEmulate:
+		if r.Handler == "" {
+			return errors.New("the emulate action requires a handler")
+		}
+	default:
+		return fmt.Errorf("invalid action %q: must be allow, deny or emulate", r.Action)
+	}
+	if r.Errno != "" && r.Action != ActionDeny {
+		return errors.New("errno is only valid with the deny action")
+	}
+	return validateRule(r)
+}
+
+// errno returns the errno name of denied syscalls of the rule.
+func (r *Rule) errno() string {
+	if r.Errno != "" {
+		return r.Errno
+	}
+	return DefaultErrno
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package seccompnotify
+
+import "errors"
+
+func validateRule(r *Rule) error {
+	return errors.New("seccomp notify is only supported on Linux")
+}
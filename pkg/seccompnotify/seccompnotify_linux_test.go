@@ -0,0 +1,210 @@
+package seccompnotify
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{"rules": [
+		{"names": ["mknod", "mknodat"], "action": "emulate", "handler": "mknod",
+		 "args": {"devices": [{"type": "c", "major": 1, "minor": 3, "path": "/dev/null"}]}},
+		{"names": ["mount"], "action": "deny", "errno": "EACCES"}
+	]}`))
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 2)
+	assert.Equal(t, ActionEmulate, policy.Rules[0].Action)
+	assert.Equal(t, "EACCES", policy.Rules[1].errno())
+
+	tests := []struct {
+		name   string
+		policy string
+		err    string
+	}{
+		{"no rules", `{"rules": []}`, "no rules"},
+		{"no names", `{"rules": [{"action": "allow"}]}`, "rule 1: no syscall names"},
+		{"invalid action", `{"rules": [{"names": ["mount"], "action": "trap"}]}`, `invalid action "trap"`},
+		{"emulate without handler", `{"rules": [{"names": ["mount"], "action": "emulate"}]}`, "requires a handler"},
+		{"errno with allow", `{"rules": [{"names": ["mount"], "action": "allow", "errno": "EPERM"}]}`, "only valid with the deny action"},
+		{"unknown errno", `{"rules": [{"names": ["mount"], "action": "deny", "errno": "EWHATEVER"}]}`, `unknown errno "EWHATEVER"`},
+		{"args without handler", `{"rules": [{"names": ["mount"], "action": "allow", "args": {}}]}`, "args require a handler"},
+		{"unknown handler", `{"rules": [{"names": ["umount2"], "action": "emulate", "handler": "umount"}]}`, `unknown handler "umount"`},
+		{"unsupported syscall", `{"rules": [{"names": ["mount"], "action": "emulate", "handler": "mknod", "args": {"devices": [{"type": "c"}]}}]}`, `does not support syscall "mount"`},
+		{"no devices", `{"rules": [{"names": ["mknodat"], "action": "emulate", "handler": "mknod"}]}`, "no devices"},
+		{"invalid device type", `{"rules": [{"names": ["mknodat"], "action": "emulate", "handler": "mknod", "args": {"devices": [{"type": "s"}]}}]}`, `invalid device type "s"`},
+		{"relative device path", `{"rules": [{"names": ["mknodat"], "action": "emulate", "handler": "mknod", "args": {"devices": [{"type": "c", "path": "dev/null"}]}}]}`, "is not absolute"},
+		{"unknown device field", `{"rules": [{"names": ["mknodat"], "action": "emulate", "handler": "mknod", "args": {"devices": [{"type": "c", "name": "null"}]}}]}`, `unknown field "name"`},
+		{"no filesystems", `{"rules": [{"names": ["mount"], "action": "emulate", "handler": "mount", "args": {"filesystems": []}}]}`, "no filesystems"},
+		{"filesystem without type", `{"rules": [{"names": ["mount"], "action": "emulate", "handler": "mount", "args": {"filesystems": [{"target": "/mnt"}]}}]}`, "filesystem without type"},
+		{"relative filesystem target", `{"rules": [{"names": ["mount"], "action": "emulate", "handler": "mount", "args": {"filesystems": [{"type": "tmpfs", "target": "mnt"}]}}]}`, "is not absolute"},
+		{"invalid filesystem source", `{"rules": [{"names": ["mount"], "action": "emulate", "handler": "mount", "args": {"filesystems": [{"type": "tmpfs", "source": "[tmpfs"}]}}]}`, "invalid filesystem source"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(tt.policy))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{"rules": [
+		{"names": ["mknodat"], "action": "emulate", "handler": "mknod", "args": {"devices": [{"type": "c"}]}},
+		{"names": ["mknodat", "mount"], "action": "allow"},
+		{"names": ["mount", "umount2"], "action": "deny", "errno": "EACCES"}
+	]}`))
+	require.NoError(t, err)
+
+	eperm := uint(unix.EPERM)
+	filter := &spec.LinuxSeccomp{
+		DefaultAction: spec.ActErrno,
+		Syscalls: []spec.LinuxSyscall{
+			{Names: []string{"mknodat", "read"}, Action: spec.ActAllow},
+			{Names: []string{"mount"}, Action: spec.ActErrno, ErrnoRet: &eperm},
+			{Names: []string{"clone"}, Action: spec.ActAllow, Args: []spec.LinuxSeccompArg{{Index: 0, Value: 1, Op: spec.OpMaskedEqual}}},
+		},
+	}
+	applied, err := policy.Apply(filter)
+	require.NoError(t, err)
+
+	eacces := uint(unix.EACCES)
+	assert.Equal(t, spec.ActErrno, applied.DefaultAction)
+	assert.Equal(t, []spec.LinuxSyscall{
+		{Names: []string{"read"}, Action: spec.ActAllow},
+		{Names: []string{"clone"}, Action: spec.ActAllow, Args: []spec.LinuxSeccompArg{{Index: 0, Value: 1, Op: spec.OpMaskedEqual}}},
+		{Names: []string{"mknodat"}, Action: spec.ActNotify},
+		{Names: []string{"mount"}, Action: spec.ActAllow},
+		{Names: []string{"umount2"}, Action: spec.ActErrno, ErrnoRet: &eacces},
+	}, applied.Syscalls)
+	assert.Len(t, filter.Syscalls, 3, "the original filter is unchanged")
+
+	filter.Syscalls = append(filter.Syscalls, spec.LinuxSyscall{
+		Names:  []string{"umount2"},
+		Action: spec.ActAllow,
+		Args:   []spec.LinuxSeccompArg{{Index: 1, Value: 0, Op: spec.OpEqualTo}},
+	})
+	_, err = policy.Apply(filter)
+	assert.ErrorContains(t, err, "syscall umount2 has argument filtered rules")
+
+	applied, err = policy.Apply(nil)
+	require.NoError(t, err)
+	assert.Equal(t, spec.ActAllow, applied.DefaultAction)
+	assert.Len(t, applied.Syscalls, 3)
+}
+
+func TestMknodMatch(t *testing.T) {
+	handler, err := newMknodHandler(json.RawMessage(`{"devices": [
+		{"type": "c", "major": 1, "minor": 3, "path": "/dev/null"},
+		{"type": "b", "major": 7, "path": "/dev/loop*"},
+		{"type": "p"}
+	]}`))
+	require.NoError(t, err)
+	h := handler.(*mknodHandler)
+
+	tests := []struct {
+		name  string
+		call  mknodCall
+		match bool
+	}{
+		{"char device", mknodCall{path: "/dev/null", mode: unix.S_IFCHR | 0o666, major: 1, minor: 3}, true},
+		{"unclean path", mknodCall{path: "/dev/../dev//null", mode: unix.S_IFCHR, major: 1, minor: 3}, true},
+		{"wrong minor", mknodCall{path: "/dev/null", mode: unix.S_IFCHR, major: 1, minor: 5}, false},
+		{"wrong path", mknodCall{path: "/tmp/null", mode: unix.S_IFCHR, major: 1, minor: 3}, false},
+		{"relative path", mknodCall{path: "null", mode: unix.S_IFCHR, major: 1, minor: 3}, false},
+		{"wrong type", mknodCall{path: "/dev/null", mode: unix.S_IFBLK, major: 1, minor: 3}, false},
+		{"any minor", mknodCall{path: "/dev/loop4", mode: unix.S_IFBLK, major: 7, minor: 4}, true},
+		{"fifo", mknodCall{path: "fifo", mode: unix.S_IFIFO | 0o600}, true},
+		{"regular file", mknodCall{path: "/dev/null", mode: unix.S_IFREG}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, h.matches(&tt.call))
+		})
+	}
+}
+
+func TestMountMatch(t *testing.T) {
+	handler, err := newMountHandler(json.RawMessage(`{"filesystems": [
+		{"type": "tmpfs", "target": "/tmp/*"},
+		{"type": "fuse.sshfs", "source": "*@backup:/srv/*", "target": "/mnt"}
+	]}`))
+	require.NoError(t, err)
+	h := handler.(*mountHandler)
+
+	tests := []struct {
+		name  string
+		call  mountCall
+		match bool
+	}{
+		{"tmpfs", mountCall{source: "tmpfs", target: "/tmp/cache", fstype: "tmpfs", data: "size=64m"}, true},
+		{"unclean target", mountCall{target: "/tmp//cache/", fstype: "tmpfs"}, true},
+		{"mount flags", mountCall{target: "/tmp/cache", fstype: "tmpfs", flags: unix.MS_NOSUID | unix.MS_NODEV | unix.MS_RDONLY}, true},
+		{"wrong target", mountCall{target: "/etc", fstype: "tmpfs"}, false},
+		{"relative target", mountCall{target: "tmp/cache", fstype: "tmpfs"}, false},
+		{"wrong type", mountCall{target: "/tmp/cache", fstype: "proc"}, false},
+		{"bind mount", mountCall{source: "/etc", target: "/tmp/etc", fstype: "tmpfs", flags: unix.MS_BIND}, false},
+		{"remount", mountCall{target: "/tmp/cache", fstype: "tmpfs", flags: unix.MS_REMOUNT}, false},
+		{"source", mountCall{source: "user@backup:/srv/data", target: "/mnt", fstype: "fuse.sshfs"}, true},
+		{"wrong source", mountCall{source: "/srv", target: "/mnt", fstype: "fuse.sshfs"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, h.matches(&tt.call))
+		})
+	}
+}
+
+// testHandler matches calls whose first argument is not zero and emulates
+// them by returning it.
+type testHandler struct{}
+
+func (testHandler) Match(req *Request) (bool, error) {
+	if req.Args[0] == 2 {
+		return false, unix.EBADF
+	}
+	return req.Args[0] != 0, nil
+}
+
+func (testHandler) Emulate(req *Request) (int64, error) {
+	return int64(req.Args[0]), nil
+}
+
+func TestRespond(t *testing.T) {
+	RegisterHandler("test", map[string]int{"mknodat": unix.SYS_MKNODAT}, func(json.RawMessage) (Handler, error) {
+		return testHandler{}, nil
+	})
+
+	listener := func(rules string) *Listener {
+		policy, err := ParsePolicy([]byte(rules))
+		require.NoError(t, err)
+		l, err := newListener(policy, unix.AUDIT_ARCH_X86_64)
+		require.NoError(t, err)
+		return l
+	}
+	notif := func(arg uint64) *seccompNotif {
+		return &seccompNotif{ID: 1, Data: seccompData{Nr: unix.SYS_MKNODAT, Arch: unix.AUDIT_ARCH_X86_64, Args: [6]uint64{arg}}}
+	}
+
+	emulate := listener(`{"rules": [
+		{"names": ["mknodat"], "action": "emulate", "handler": "test"},
+		{"names": ["mknodat"], "action": "deny", "errno": "EACCES"}
+	]}`)
+	assert.Equal(t, seccompNotifResp{ID: 1, Val: 5}, emulate.respond(-1, notif(5)))
+	assert.Equal(t, seccompNotifResp{ID: 1, Error: -int32(unix.EACCES)}, emulate.respond(-1, notif(0)))
+	assert.Equal(t, seccompNotifResp{ID: 1, Error: -int32(unix.EBADF)}, emulate.respond(-1, notif(2)))
+
+	allow := listener(`{"rules": [
+		{"names": ["mknodat"], "action": "allow", "handler": "test"}
+	]}`)
+	assert.Equal(t, seccompNotifResp{ID: 1, Flags: unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE}, allow.respond(-1, notif(1)))
+	assert.Equal(t, seccompNotifResp{ID: 1, Error: -int32(unix.EPERM)}, allow.respond(-1, notif(0)), "no rule matches")
+
+	foreign := notif(1)
+	foreign.Data.Arch = unix.AUDIT_ARCH_I386
+	assert.Equal(t, seccompNotifResp{ID: 1, Flags: unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE}, allow.respond(-1, foreign), "foreign architecture")
+}
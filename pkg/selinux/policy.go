@@ -0,0 +1,383 @@
+package selinux
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/opencontainers/selinux/go-selinux"
+	"github.com/sirupsen/logrus"
+)
+
+// TemplatesDir is the directory of the udica CIL templates the generated
+// policies inherit from.
+const TemplatesDir = "/usr/share/udica/templates"
+
+// Blocks of the udica templates.
+const (
+	templateContainer     = "container"
+	templateNetContainer  = "net_container"
+	templateRestrictedNet = "restricted_net_container"
+	templateHomeContainer = "home_container"
+	templateLogContainer  = "log_container"
+	templateTTYContainer  = "tty_container"
+	templateVirtContainer = "virt_container"
+	templateXContainer    = "x_container"
+)
+
+// Permissions granted on bind mounts and devices.
+const (
+	permsDirReadWrite    = "open read getattr lock search ioctl add_name remove_name write"
+	permsDirReadOnly     = "getattr search open read lock ioctl"
+	permsFileReadWrite   = "getattr read write append ioctl lock map open create"
+	permsFileReadOnly    = "getattr read ioctl lock open"
+	permsFifoReadWrite   = "getattr read write append ioctl lock open"
+	permsFifoReadOnly    = "getattr open read lock ioctl"
+	permsSockReadWrite   = "append getattr open read write"
+	permsSockReadOnly    = "getattr open read"
+	permsDeviceReadWrite = "getattr read write append ioctl lock open"
+)
+
+const (
+	reservedPortType    = "reserved_port_t"
+	unreservedPortType  = "unreserved_port_t"
+	firstUnreservedPort = 1024
+)
+
+// templateFiles maps the template blocks to the files defining them.
+var templateFiles = map[string]string{
+	templateContainer:     "base_container.cil",
+	templateNetContainer:  "net_container.cil",
+	templateRestrictedNet: "net_container.cil",
+	templateHomeContainer: "home_container.cil",
+	templateLogContainer:  "log_container.cil",
+	templateTTYContainer:  "tty_container.cil",
+	templateVirtContainer: "virt_container.cil",
+	templateXContainer:    "x_container.cil",
+}
+
+// capability2 lists the capabilities of the capability2 SELinux class.
+var capability2 = []string{"mac_override", "mac_admin", "syslog", "wake_alarm", "block_suspend", "audit_read", "perfmon", "bpf", "checkpoint_restore"}
+
+var policyNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// fileLabel and semanagePorts are variables so they can be replaced in
+// tests.
+var (
+	fileLabel     = selinux.FileLabel
+	semanagePorts = func() ([]byte, error) {
+		return exec.Command("semanage", "port", "-l").Output()
+	}
+)
+
+// PathRule allows access to files of an SELinux type.
+type PathRule struct {
+	Type      string
+	ReadWrite bool
+}
+
+// PortRule allows binding to ports of an SELinux type.
+type PortRule struct {
+	Type     string
+	Protocol string
+}
+
+// Policy is an SELinux policy module for a container.
+type Policy struct {
+	// Name of the policy.  The process type of the container is
+	// Name.process.
+	Name         string
+	Templates    []string
+	Capabilities []string
+	Ports        []PortRule
+	Paths        []PathRule
+	Devices      []string
+}
+
+// PolicyName returns a valid policy name derived from name.
+func PolicyName(name string) string {
+	return policyNameRegexp.ReplaceAllString(name, "_")
+}
+
+// GeneratePolicy generates a policy for the container described by the
+// inspect data ctr, allowing the access to its bind mounts, published ports
+// and devices and its capabilities.  The SELinux types of bind mounts and
+// devices are looked up on the host, so the container must run on this host.
+func GeneratePolicy(name string, ctr *define.InspectContainerData) (*Policy, error) {
+	if ctr.HostConfig != nil && ctr.HostConfig.Privileged {
+		return nil, fmt.Errorf("container %s is privileged and runs without SELinux separation", ctr.Name)
+	}
+
+	policy := &Policy{Name: name}
+	templates := map[string]bool{templateContainer: true}
+
+	for _, c := range ctr.EffectiveCaps {
+		policy.Capabilities = append(policy.Capabilities, strings.ToLower(strings.TrimPrefix(c, "CAP_")))
+	}
+	sort.Strings(policy.Capabilities)
+
+	if ctr.HostConfig != nil {
+		if ctr.HostConfig.NetworkMode == "host" {
+			templates[templateNetContainer] = true
+		}
+		ports, err := policyPorts(ctr.HostConfig.PortBindings)
+		if err != nil {
+			return nil, err
+		}
+		if len(ports) > 0 && !templates[templateNetContainer] {
+			templates[templateRestrictedNet] = true
+		}
+		policy.Ports = ports
+
+		for _, device := range ctr.HostConfig.Devices {
+			deviceType, err := fileType(device.PathOnHost)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(policy.Devices, deviceType) {
+				policy.Devices = append(policy.Devices, deviceType)
+			}
+			switch {
+			case device.PathOnHost == "/dev/kvm":
+				templates[templateVirtContainer] = true
+			case strings.HasPrefix(device.PathOnHost, "/dev/tty") || strings.HasPrefix(device.PathOnHost, "/dev/pts"):
+				templates[templateTTYContainer] = true
+			}
+		}
+		sort.Strings(policy.Devices)
+	}
+
+	paths := make(map[string]bool)
+	for _, m := range ctr.Mounts {
+		if m.Type != define.TypeBind {
+			continue
+		}
+		source := filepath.Clean(m.Source)
+		switch {
+		case source == "/home" || strings.HasPrefix(source, "/home/"):
+			templates[templateHomeContainer] = true
+		case source == "/var/log" || strings.HasPrefix(source, "/var/log/"):
+			templates[templateLogContainer] = true
+		case strings.HasPrefix(source, "/tmp/.X11-unix"):
+			templates[templateXContainer] = true
+		}
+		pathType, err := fileType(source)
+		if err != nil {
+			return nil, err
+		}
+		paths[pathType] = paths[pathType] || m.RW
+	}
+	for pathType, rw := range paths {
+		policy.Paths = append(policy.Paths, PathRule{Type: pathType, ReadWrite: rw})
+	}
+	sort.Slice(policy.Paths, func(i, j int) bool { return policy.Paths[i].Type < policy.Paths[j].Type })
+
+	for template := range templates {
+		policy.Templates = append(policy.Templates, template)
+	}
+	sort.Slice(policy.Templates, func(i, j int) bool {
+		// The base template must be inherited first.
+		if policy.Templates[i] == templateContainer || policy.Templates[j] == templateContainer {
+			return policy.Templates[i] == templateContainer
+		}
+		return policy.Templates[i] < policy.Templates[j]
+	})
+	return policy, nil
+}
+
+// ProcessType returns the SELinux type of the container process, to be used
+// with --security-opt label=type:TYPE.
+func (p *Policy) ProcessType() string {
+	return p.Name + ".process"
+}
+
+// TemplateFiles returns the template files which must be installed along
+// with the policy.
+func (p *Policy) TemplateFiles() []string {
+	var files []string
+	for _, template := range p.Templates {
+		file := filepath.Join(TemplatesDir, templateFiles[template])
+		if !slices.Contains(files, file) {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// CIL returns the policy in the Common Intermediate Language.
+func (p *Policy) CIL() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "(block %s\n", p.Name)
+	for _, template := range p.Templates {
+		fmt.Fprintf(&b, "    (blockinherit %s)\n", template)
+	}
+
+	var caps, caps2 []string
+	for _, c := range p.Capabilities {
+		if slices.Contains(capability2, c) {
+			caps2 = append(caps2, c)
+		} else {
+			caps = append(caps, c)
+		}
+	}
+	if len(caps) > 0 {
+		fmt.Fprintf(&b, "    (allow process process ( capability ( %s )))\n", strings.Join(caps, " "))
+	}
+	if len(caps2) > 0 {
+		fmt.Fprintf(&b, "    (allow process process ( capability2 ( %s )))\n", strings.Join(caps2, " "))
+	}
+
+	for _, port := range p.Ports {
+		fmt.Fprintf(&b, "    (allow process %s ( %s_socket ( name_bind )))\n", port.Type, port.Protocol)
+	}
+
+	for _, path := range p.Paths {
+		dir, file, fifo, sock := permsDirReadOnly, permsFileReadOnly, permsFifoReadOnly, permsSockReadOnly
+		if path.ReadWrite {
+			dir, file, fifo, sock = permsDirReadWrite, permsFileReadWrite, permsFifoReadWrite, permsSockReadWrite
+		}
+		fmt.Fprintf(&b, "    (allow process %s ( dir ( %s )))\n", path.Type, dir)
+		fmt.Fprintf(&b, "    (allow process %s ( file ( %s )))\n", path.Type, file)
+		fmt.Fprintf(&b, "    (allow process %s ( fifo_file ( %s )))\n", path.Type, fifo)
+		fmt.Fprintf(&b, "    (allow process %s ( sock_file ( %s )))\n", path.Type, sock)
+	}
+
+	for _, device := range p.Devices {
+		fmt.Fprintf(&b, "    (allow process %s ( chr_file ( %s )))\n", device, permsDeviceReadWrite)
+		fmt.Fprintf(&b, "    (allow process %s ( blk_file ( %s )))\n", device, permsDeviceReadWrite)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// fileType returns the SELinux type of the file at path.
+func fileType(path string) (string, error) {
+	label, err := fileLabel(path)
+	if err != nil {
+		return "", fmt.Errorf("getting SELinux label of %q: %w", path, err)
+	}
+	if label == "" {
+		return "", fmt.Errorf("%q has no SELinux label, is SELinux enabled?", path)
+	}
+	context, err := selinux.NewContext(label)
+	if err != nil {
+		return "", err
+	}
+	return context["type"], nil
+}
+
+// policyPorts returns the port rules for the published ports.
+func policyPorts(bindings map[string][]define.InspectHostPort) ([]PortRule, error) {
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+	definitions, err := portDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []PortRule
+	for key := range bindings {
+		portString, protocol, _ := strings.Cut(key, "/")
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		if protocol != "tcp" && protocol != "udp" {
+			continue
+		}
+		port, err := strconv.ParseUint(portString, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", key, err)
+		}
+		rule := PortRule{Type: portType(definitions, uint16(port), protocol), Protocol: protocol}
+		if !slices.Contains(rules, rule) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Type != rules[j].Type {
+			return rules[i].Type < rules[j].Type
+		}
+		return rules[i].Protocol < rules[j].Protocol
+	})
+	return rules, nil
+}
+
+// portDefinition is a port or port range of an SELinux port type.
+type portDefinition struct {
+	Type     string
+	Protocol string
+	Low      uint16
+	High     uint16
+}
+
+// portDefinitions returns the port types defined in the loaded policy.  If
+// they cannot be listed, e.g. because semanage is not installed, no port
+// types are returned and the generic port types are used instead.
+func portDefinitions() ([]portDefinition, error) {
+	output, err := semanagePorts()
+	if err != nil {
+		if !errors.Is(err, exec.ErrNotFound) {
+			logrus.Warnf("Listing SELinux port types failed, using %s and %s: %v", reservedPortType, unreservedPortType, err)
+		}
+		return nil, nil
+	}
+	return parsePortDefinitions(output)
+}
+
+// parsePortDefinitions parses the output of semanage port -l.
+func parsePortDefinitions(output []byte) ([]portDefinition, error) {
+	var definitions []portDefinition
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || (fields[1] != "tcp" && fields[1] != "udp") {
+			continue
+		}
+		for _, item := range strings.Split(strings.Join(fields[2:], ""), ",") {
+			lowString, highString, isRange := strings.Cut(item, "-")
+			if !isRange {
+				highString = lowString
+			}
+			low, err := strconv.ParseUint(lowString, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port %q of SELinux port type %s: %w", item, fields[0], err)
+			}
+			high, err := strconv.ParseUint(highString, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port %q of SELinux port type %s: %w", item, fields[0], err)
+			}
+			definitions = append(definitions, portDefinition{Type: fields[0], Protocol: fields[1], Low: uint16(low), High: uint16(high)})
+		}
+	}
+	return definitions, scanner.Err()
+}
+
+// portType returns the most specific SELinux type of port.
+func portType(definitions []portDefinition, port uint16, protocol string) string {
+	var match *portDefinition
+	for i, d := range definitions {
+		if d.Protocol != protocol || port < d.Low || port > d.High {
+			continue
+		}
+		if match == nil || d.High-d.Low < match.High-match.Low {
+			match = &definitions[i]
+		}
+	}
+	if match != nil {
+		return match.Type
+	}
+	if port < firstUnreservedPort {
+		return reservedPortType
+	}
+	return unreservedPortType
+}
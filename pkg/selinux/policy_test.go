@@ -0,0 +1,148 @@
+package selinux
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSemanagePorts = `SELinux Port Type              Proto    Port Number
+
+http_cache_port_t              tcp      8080, 8118, 8123, 10001-10010
+http_port_t                    tcp      80, 81, 443, 488, 8008, 8009, 8443, 9000
+dns_port_t                     udp      53, 853
+unreserved_port_t              tcp      61000-65535, 1024-32767
+`
+
+func fakeLookups(t *testing.T, labels map[string]string, ports string) {
+	oldFileLabel, oldSemanagePorts := fileLabel, semanagePorts
+	t.Cleanup(func() {
+		fileLabel, semanagePorts = oldFileLabel, oldSemanagePorts
+	})
+	fileLabel = func(path string) (string, error) {
+		label, ok := labels[path]
+		if !ok {
+			return "", errors.New("no such file")
+		}
+		return label, nil
+	}
+	semanagePorts = func() ([]byte, error) { return []byte(ports), nil }
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	fakeLookups(t, map[string]string{
+		"/home/user/data": "unconfined_u:object_r:user_home_t:s0",
+		"/home/user/logs": "unconfined_u:object_r:user_home_t:s0",
+		"/etc/ssl":        "system_u:object_r:cert_t:s0",
+		"/dev/fuse":       "system_u:object_r:fuse_device_t:s0",
+	}, testSemanagePorts)
+
+	ctr := &define.InspectContainerData{
+		Name:          "web",
+		EffectiveCaps: []string{"CAP_NET_BIND_SERVICE", "CAP_CHOWN", "CAP_SYSLOG"},
+		Mounts: []define.InspectMount{
+			{Type: define.TypeBind, Source: "/home/user/data", RW: false},
+			{Type: define.TypeBind, Source: "/home/user/logs", RW: true},
+			{Type: define.TypeBind, Source: "/etc/ssl"},
+			{Type: "volume", Source: "/var/lib/containers/storage/volumes/v/_data", RW: true},
+		},
+		HostConfig: &define.InspectContainerHostConfig{
+			NetworkMode: "bridge",
+			PortBindings: map[string][]define.InspectHostPort{
+				"80/tcp":    {{HostPort: "8080"}},
+				"8080/tcp":  {{HostPort: "8081"}},
+				"10005/tcp": {{HostPort: "10005"}},
+				"30000/tcp": {{HostPort: "30000"}},
+				"53/udp":    {{HostPort: "5353"}},
+			},
+			Devices: []define.InspectDevice{{PathOnHost: "/dev/fuse", PathInContainer: "/dev/fuse"}},
+		},
+	}
+
+	policy, err := GeneratePolicy(PolicyName("web-1.test"), ctr)
+	require.NoError(t, err)
+	assert.Equal(t, "web_1_test", policy.Name)
+	assert.Equal(t, "web_1_test.process", policy.ProcessType())
+	assert.Equal(t, []string{"container", "home_container", "restricted_net_container"}, policy.Templates)
+	assert.Equal(t, []string{
+		"/usr/share/udica/templates/base_container.cil",
+		"/usr/share/udica/templates/home_container.cil",
+		"/usr/share/udica/templates/net_container.cil",
+	}, policy.TemplateFiles())
+
+	assert.Equal(t, `(block web_1_test
+    (blockinherit container)
+    (blockinherit home_container)
+    (blockinherit restricted_net_container)
+    (allow process process ( capability ( chown net_bind_service )))
+    (allow process process ( capability2 ( syslog )))
+    (allow process dns_port_t ( udp_socket ( name_bind )))
+    (allow process http_cache_port_t ( tcp_socket ( name_bind )))
+    (allow process http_port_t ( tcp_socket ( name_bind )))
+    (allow process unreserved_port_t ( tcp_socket ( name_bind )))
+    (allow process cert_t ( dir ( getattr search open read lock ioctl )))
+    (allow process cert_t ( file ( getattr read ioctl lock open )))
+    (allow process cert_t ( fifo_file ( getattr open read lock ioctl )))
+    (allow process cert_t ( sock_file ( getattr open read )))
+    (allow process user_home_t ( dir ( open read getattr lock search ioctl add_name remove_name write )))
+    (allow process user_home_t ( file ( getattr read write append ioctl lock map open create )))
+    (allow process user_home_t ( fifo_file ( getattr read write append ioctl lock open )))
+    (allow process user_home_t ( sock_file ( append getattr open read write )))
+    (allow process fuse_device_t ( chr_file ( getattr read write append ioctl lock open )))
+    (allow process fuse_device_t ( blk_file ( getattr read write append ioctl lock open )))
+)
+`, policy.CIL())
+}
+
+func TestGeneratePolicyHostNetwork(t *testing.T) {
+	fakeLookups(t, nil, "")
+	ctr := &define.InspectContainerData{
+		Name:       "host",
+		HostConfig: &define.InspectContainerHostConfig{NetworkMode: "host"},
+	}
+	policy, err := GeneratePolicy("host", ctr)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"container", "net_container"}, policy.Templates)
+	assert.Equal(t, "(block host\n    (blockinherit container)\n    (blockinherit net_container)\n)\n", policy.CIL())
+}
+
+func TestGeneratePolicyErrors(t *testing.T) {
+	fakeLookups(t, nil, "")
+
+	_, err := GeneratePolicy("priv", &define.InspectContainerData{
+		Name:       "priv",
+		HostConfig: &define.InspectContainerHostConfig{Privileged: true},
+	})
+	assert.ErrorContains(t, err, "is privileged")
+
+	_, err = GeneratePolicy("mount", &define.InspectContainerData{
+		Name:   "mount",
+		Mounts: []define.InspectMount{{Type: define.TypeBind, Source: "/srv"}},
+	})
+	assert.ErrorContains(t, err, `getting SELinux label of "/srv"`)
+}
+
+func TestPortType(t *testing.T) {
+	definitions, err := parsePortDefinitions([]byte(testSemanagePorts))
+	require.NoError(t, err)
+
+	tests := []struct {
+		port     uint16
+		protocol string
+		expected string
+	}{
+		{80, "tcp", "http_port_t"},
+		{8080, "tcp", "http_cache_port_t"},
+		{10001, "tcp", "http_cache_port_t"},
+		{2000, "tcp", "unreserved_port_t"},
+		{53, "udp", "dns_port_t"},
+		{53, "tcp", "reserved_port_t"},
+		{40000, "udp", "unreserved_port_t"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, portType(definitions, tt.port, tt.protocol), "%d/%s", tt.port, tt.protocol)
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/containers/common/libnetwork/slirp4netns"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/logs"
 	"github.com/containers/podman/v5/pkg/namespaces"
 	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/podman/v5/pkg/seccompnotify"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/util"
@@ -372,6 +374,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	if s.Umask != "" {
 		options = append(options, libpod.WithUmask(s.Umask))
 	}
+	if s.Timing != nil && *s.Timing {
+		options = append(options, libpod.WithTiming())
+	}
 	if s.Volatile != nil && *s.Volatile {
 		options = append(options, libpod.WithVolatile())
 	}
@@ -547,6 +552,13 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		if len(s.LogConfiguration.Options) > 0 && s.LogConfiguration.Options["tag"] != "" {
 			options = append(options, libpod.WithLogTag(s.LogConfiguration.Options["tag"]))
 		}
+		if s.LogConfiguration.Options["rate"] != "" || s.LogConfiguration.Options["burst"] != "" {
+			limit, err := logs.ParseRateLimit(s.LogConfiguration.Options["rate"], s.LogConfiguration.Options["burst"])
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, libpod.WithLogRateLimit(limit))
+		}
 
 		if len(s.LogConfiguration.Driver) > 0 {
 			options = append(options, libpod.WithLogDriver(s.LogConfiguration.Driver))
@@ -573,6 +585,13 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		}
 	}
 	options = append(options, libpod.WithPrivileged(s.IsPrivileged()))
+	if s.SeccompNotifyPolicyPath != "" {
+		policy, err := seccompnotify.LoadPolicy(s.SeccompNotifyPolicyPath)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, libpod.WithSeccompNotifyPolicy(policy))
+	}
 	if s.ReadWriteTmpfs != nil {
 		options = append(options, libpod.WithReadWriteTmpfs(*s.ReadWriteTmpfs))
 	}
@@ -183,6 +183,10 @@ type CtrSpecGenOptions struct {
 	PodSecurityContext *v1.PodSecurityContext
 	// TerminationGracePeriodSeconds is the grace period given to a container to stop before being forcefully killed
 	TerminationGracePeriodSeconds *int64
+	// OCIRuntime is the OCI runtime the runtime class of the pod maps to
+	OCIRuntime string
+	// ImagePullSecrets are the names of the image pull secrets of the pod
+	ImagePullSecrets []string
 }
 
 func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGenerator, error) {
@@ -249,8 +253,8 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 			case 0:
 				return nil, fmt.Errorf("invalid log option: %w", define.ErrInvalidArg)
 			default:
-				// tags for journald only
-				if s.LogConfiguration.Driver == "" || s.LogConfiguration.Driver == define.JournaldLogging {
+				// rate limits apply to all drivers, tags for journald only
+				if opt == "rate" || opt == "burst" || s.LogConfiguration.Driver == "" || s.LogConfiguration.Driver == define.JournaldLogging {
 					s.LogConfiguration.Options[opt] = val
 				} else {
 					logrus.Warnf("Can only set tags with journald log driver but driver is %q", s.LogConfiguration.Driver)
@@ -259,6 +263,23 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 		}
 	}
 
+	logRateLimit, ok := opts.Annotations[define.LogRateLimitAnnotation+"/"+opts.Container.Name]
+	if !ok {
+		logRateLimit, ok = opts.Annotations[define.LogRateLimitAnnotation]
+	}
+	// --log-opt takes precedence over the annotation
+	if ok && s.LogConfiguration.Options["rate"] == "" {
+		for _, o := range strings.Split(logRateLimit, ",") {
+			opt, val, _ := strings.Cut(o, "=")
+			switch opt {
+			case "rate", "burst":
+				s.LogConfiguration.Options[opt] = val
+			default:
+				return nil, fmt.Errorf("invalid %s annotation %q: only rate and burst can be set: %w", define.LogRateLimitAnnotation, logRateLimit, define.ErrInvalidArg)
+			}
+		}
+	}
+
 	s.InitContainerType = opts.InitContainerType
 
 	setupSecurityContext(s, opts.Container.SecurityContext, opts.PodSecurityContext)
@@ -383,6 +404,12 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 		annotations[ann.SandboxID] = opts.PodInfraID
 	}
 	s.Annotations = annotations
+	if len(opts.ImagePullSecrets) > 0 {
+		s.Annotations[define.KubeImagePullSecretsAnnotation] = strings.Join(opts.ImagePullSecrets, ",")
+	}
+	if opts.OCIRuntime != "" {
+		s.OCIRuntime = opts.OCIRuntime
+	}
 
 	if containerCIDFile, ok := opts.Annotations[define.InspectAnnotationCIDFile+"/"+opts.Container.Name]; ok {
 		s.Annotations[define.InspectAnnotationCIDFile] = containerCIDFile
@@ -0,0 +1,165 @@
+//go:build !remote
+
+package kube
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/containers/common/pkg/secrets"
+	"github.com/containers/image/v5/docker/reference"
+	itypes "github.com/containers/image/v5/types"
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// dockerConfigJSON is the format of .dockerconfigjson secrets.
+type dockerConfigJSON struct {
+	Auths map[string]dockerAuthConfig `json:"auths"`
+}
+
+type dockerAuthConfig struct {
+	Auth          string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+}
+
+// PullSecrets are the registry credentials of the image pull secrets of a
+// pod.
+type PullSecrets struct {
+	// Names of the secrets.
+	Names []string
+	// auths maps the normalized registries to their credentials.
+	auths map[string]itypes.DockerAuthConfig
+}
+
+// LoadPullSecrets reads the registry credentials of the image pull secrets of
+// a pod.  The secrets must be Podman secrets containing either a Kubernetes
+// Secret of the kubernetes.io/dockerconfigjson type, as created by kube play
+// for Secrets in the YAML, or a container registry auth file in the same
+// format.  Nil is returned if the pod has no image pull secrets.
+func LoadPullSecrets(refs []v1.LocalObjectReference, secretsManager *secrets.SecretsManager) (*PullSecrets, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	pullSecrets := &PullSecrets{auths: make(map[string]itypes.DockerAuthConfig)}
+	for _, ref := range refs {
+		if ref.Name == "" {
+			continue
+		}
+		_, data, err := secretsManager.LookupSecretData(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("looking up image pull secret %q: %w", ref.Name, err)
+		}
+		auths, err := parsePullSecret(data)
+		if err != nil {
+			return nil, fmt.Errorf("image pull secret %q: %w", ref.Name, err)
+		}
+		for registry, auth := range auths {
+			// The first secret with credentials for a registry wins.
+			if _, ok := pullSecrets.auths[registry]; !ok {
+				pullSecrets.auths[registry] = auth
+			}
+		}
+		pullSecrets.Names = append(pullSecrets.Names, ref.Name)
+	}
+	return pullSecrets, nil
+}
+
+// Credentials returns the credentials for pulling image from the most
+// specific matching registry entry, or nil if there is none.
+func (p *PullSecrets) Credentials(image string) *itypes.DockerAuthConfig {
+	if p == nil {
+		return nil
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil
+	}
+	name := named.Name()
+
+	var match string
+	for registry := range p.auths {
+		if (name == registry || strings.HasPrefix(name, registry+"/")) && len(registry) > len(match) {
+			match = registry
+		}
+	}
+	if match == "" {
+		return nil
+	}
+	auth := p.auths[match]
+	return &auth
+}
+
+// parsePullSecret returns the credentials of the pull secret data by
+// normalized registry.
+func parsePullSecret(data []byte) (map[string]itypes.DockerAuthConfig, error) {
+	var secret v1.Secret
+	if err := yaml.Unmarshal(data, &secret); err == nil && secret.Kind == "Secret" {
+		if secret.Type != "" && secret.Type != v1.SecretTypeDockerConfigJson {
+			return nil, fmt.Errorf("unsupported secret type %q, must be %q", secret.Type, v1.SecretTypeDockerConfigJson)
+		}
+		config, ok := secret.Data[v1.DockerConfigJsonKey]
+		if !ok {
+			stringConfig, ok := secret.StringData[v1.DockerConfigJsonKey]
+			if !ok {
+				return nil, fmt.Errorf("secret has no %s key", v1.DockerConfigJsonKey)
+			}
+			config = []byte(stringConfig)
+		}
+		data = config
+	}
+
+	var config dockerConfigJSON
+	if err := json.Unmarshal(data, &config); err != nil || config.Auths == nil {
+		return nil, fmt.Errorf("secret is not in the %s format", v1.SecretTypeDockerConfigJson)
+	}
+	auths := make(map[string]itypes.DockerAuthConfig, len(config.Auths))
+	for registry, entry := range config.Auths {
+		auth := itypes.DockerAuthConfig{
+			Username:      entry.Username,
+			Password:      entry.Password,
+			IdentityToken: entry.IdentityToken,
+		}
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("decoding credentials of %s: %w", registry, err)
+			}
+			var found bool
+			auth.Username, auth.Password, found = strings.Cut(string(decoded), ":")
+			if !found {
+				return nil, fmt.Errorf("invalid credentials of %s: missing colon", registry)
+			}
+		}
+		// Credentials are either a username and password or an
+		// identity token.
+		if auth.IdentityToken != "" && auth.Username != "" {
+			auth.IdentityToken = ""
+		}
+		auths[normalizeRegistry(registry)] = auth
+	}
+	return auths, nil
+}
+
+// normalizeRegistry normalizes a registry key of an auth file, which may be
+// a URL like https://index.docker.io/v1/, to the format of image names.
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(registry, "https://")
+	registry = strings.TrimPrefix(registry, "http://")
+	registry = strings.TrimSuffix(registry, "/")
+	registry = strings.TrimSuffix(registry, "/v1")
+	registry = strings.TrimSuffix(registry, "/v2")
+	host, path, hasPath := strings.Cut(registry, "/")
+	switch host {
+	case "index.docker.io", "registry-1.docker.io":
+		host = "docker.io"
+	}
+	if hasPath {
+		return host + "/" + path
+	}
+	return host
+}
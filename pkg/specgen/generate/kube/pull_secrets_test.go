@@ -0,0 +1,101 @@
+//go:build !remote
+
+package kube
+
+import (
+	"testing"
+
+	itypes "github.com/containers/image/v5/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePullSecret(t *testing.T) {
+	// "dXNlcjpwYXNz" is "user:pass"
+	authFile := `{"auths": {
+		"https://index.docker.io/v1/": {"auth": "dXNlcjpwYXNz"},
+		"quay.io": {"username": "quser", "password": "qpass"},
+		"quay.io/team": {"identitytoken": "token"},
+		"localhost:5000/": {"auth": "dXNlcjpwYXNz"}
+	}}`
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"auth file", authFile},
+		{"kube secret data", `apiVersion: v1
+kind: Secret
+metadata:
+  name: regcred
+type: kubernetes.io/dockerconfigjson
+data:
+  .dockerconfigjson: eyJhdXRocyI6IHsKCQkiaHR0cHM6Ly9pbmRleC5kb2NrZXIuaW8vdjEvIjogeyJhdXRoIjogImRYTmxjanB3WVhOeiJ9LAoJCSJxdWF5LmlvIjogeyJ1c2VybmFtZSI6ICJxdXNlciIsICJwYXNzd29yZCI6ICJxcGFzcyJ9LAoJCSJxdWF5LmlvL3RlYW0iOiB7ImlkZW50aXR5dG9rZW4iOiAidG9rZW4ifSwKCQkibG9jYWxob3N0OjUwMDAvIjogeyJhdXRoIjogImRYTmxjanB3WVhOeiJ9Cgl9fQ==
+`},
+		{"kube secret string data", `apiVersion: v1
+kind: Secret
+metadata:
+  name: regcred
+stringData:
+  .dockerconfigjson: '` + authFile + `'
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auths, err := parsePullSecret([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, map[string]itypes.DockerAuthConfig{
+				"docker.io":      {Username: "user", Password: "pass"},
+				"quay.io":        {Username: "quser", Password: "qpass"},
+				"quay.io/team":   {IdentityToken: "token"},
+				"localhost:5000": {Username: "user", Password: "pass"},
+			}, auths)
+		})
+	}
+}
+
+func TestParsePullSecretErrors(t *testing.T) {
+	_, err := parsePullSecret([]byte("kind: Secret\ntype: Opaque\ndata:\n  password: cGFzcw==\n"))
+	assert.ErrorContains(t, err, `unsupported secret type "Opaque"`)
+
+	_, err = parsePullSecret([]byte("kind: Secret\ndata:\n  password: cGFzcw==\n"))
+	assert.ErrorContains(t, err, "secret has no .dockerconfigjson key")
+
+	_, err = parsePullSecret([]byte(`{"password": "pass"}`))
+	assert.ErrorContains(t, err, "secret is not in the kubernetes.io/dockerconfigjson format")
+
+	_, err = parsePullSecret([]byte(`{"auths": {"quay.io": {"auth": "bm9jb2xvbg=="}}}`))
+	assert.ErrorContains(t, err, "invalid credentials of quay.io: missing colon")
+}
+
+func TestPullSecretsCredentials(t *testing.T) {
+	pullSecrets := &PullSecrets{auths: map[string]itypes.DockerAuthConfig{
+		"docker.io":    {Username: "docker"},
+		"quay.io":      {Username: "quay"},
+		"quay.io/team": {Username: "team"},
+	}}
+
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"alpine", "docker"},
+		{"docker.io/library/alpine:latest", "docker"},
+		{"quay.io/podman/hello", "quay"},
+		{"quay.io/team/app@sha256:0000000000000000000000000000000000000000000000000000000000000000", "team"},
+		{"quay.io/teamwork/app", "quay"},
+		{"registry.example.com/app", ""},
+	}
+	for _, tt := range tests {
+		auth := pullSecrets.Credentials(tt.image)
+		if tt.expected == "" {
+			assert.Nil(t, auth, tt.image)
+			continue
+		}
+		require.NotNil(t, auth, tt.image)
+		assert.Equal(t, tt.expected, auth.Username, tt.image)
+	}
+
+	var noSecrets *PullSecrets
+	assert.Nil(t, noSecrets.Credentials("alpine"))
+}
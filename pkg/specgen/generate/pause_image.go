@@ -4,13 +4,33 @@ package generate
 
 import (
 	"context"
+	"debug/elf"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	buildahDefine "github.com/containers/buildah/define"
 	"github.com/containers/common/pkg/config"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/opencontainers/selinux/go-selinux/label"
+)
+
+const (
+	// infraRootfsDir is the directory in the static directory of the
+	// runtime which is the root filesystem of all infra containers
+	// without an image.
+	infraRootfsDir = "infra-rootfs"
+	// infraRootfsLabel is the SELinux label of the infra root filesystem,
+	// which is shared by the infra containers of all pods.
+	infraRootfsLabel = "system_u:object_r:container_file_t:s0"
+	// infraInitPath is the path of the init binary in infra containers
+	// without an image, the same as in the local pause image.
+	infraInitPath = "/catatonit"
 )
 
 // PullOrBuildInfraImage pulls down the specified image or the one set in
@@ -26,7 +46,7 @@ func PullOrBuildInfraImage(rt *libpod.Runtime, imageName string) (string, error)
 	}
 
 	if imageName != "" {
-		_, err := rt.LibimageRuntime().Pull(context.Background(), imageName, config.PullPolicyMissing, nil)
+		_, err := rt.PullImage(context.Background(), imageName, config.PullPolicyMissing, nil)
 		if err != nil {
 			return "", err
 		}
@@ -94,3 +114,88 @@ ENTRYPOINT ["/catatonit", "-P"]`, catatonitPath)
 
 	return imageName, nil
 }
+
+// SetupRootfslessInfra configures the spec of an infra container to run
+// without an image. Its root filesystem is an empty, read-only directory
+// with the init binary of the host bind-mounted into it, so that no image
+// needs to be built or stored for the pod. The init binary reaps zombies
+// and runs in pause mode unless another infra command is set.
+func SetupRootfslessInfra(rt *libpod.Runtime, s *specgen.SpecGenerator, healthInterval string) error {
+	rtConfig, err := rt.GetConfigNoCopy()
+	if err != nil {
+		return err
+	}
+	initPath, err := rtConfig.FindInitBinary()
+	if err != nil {
+		return fmt.Errorf("finding pause binary: %w", err)
+	}
+	if err := checkStaticBinary(initPath); err != nil {
+		return err
+	}
+
+	rootfs := filepath.Join(rtConfig.Engine.StaticDir, infraRootfsDir)
+	if err := os.MkdirAll(rootfs, 0o755); err != nil {
+		return fmt.Errorf("creating infra root filesystem: %w", err)
+	}
+	if err := label.Relabel(rootfs, infraRootfsLabel, true); err != nil {
+		return fmt.Errorf("relabeling infra root filesystem: %w", err)
+	}
+
+	s.Image = ""
+	s.RawImageName = ""
+	s.Rootfs = rootfs
+	readOnly := true
+	s.ReadOnlyFilesystem = &readOnly
+	s.Mounts = append(s.Mounts, specs.Mount{
+		Type:        define.TypeBind,
+		Source:      initPath,
+		Destination: infraInitPath,
+		Options:     []string{"bind", "ro"},
+	})
+	if len(s.Entrypoint) == 0 {
+		s.Entrypoint = []string{infraInitPath, "-P"}
+	}
+
+	if healthInterval != "" {
+		// The healthcheck runs the binary of the infra process through
+		// /proc/1/exe, so it fails once the process is gone.  Only the
+		// init binary is known to exit right away with --version.
+		if s.Entrypoint[0] != infraInitPath {
+			return fmt.Errorf("an infra healthcheck requires the infra command to run %s", infraInitPath)
+		}
+		interval, err := time.ParseDuration(healthInterval)
+		if err != nil {
+			return fmt.Errorf("invalid infra healthcheck interval: %w", err)
+		}
+		if interval < time.Second {
+			return fmt.Errorf("infra healthcheck interval %s must be at least 1 second", interval)
+		}
+		timeout, err := time.ParseDuration(define.DefaultHealthCheckTimeout)
+		if err != nil {
+			return err
+		}
+		s.HealthConfig = &manifest.Schema2HealthConfig{
+			Test:     []string{"CMD", "/proc/1/exe", "--version"},
+			Interval: interval,
+			Timeout:  timeout,
+			Retries:  int(define.DefaultHealthCheckRetries),
+		}
+	}
+	return nil
+}
+
+// checkStaticBinary returns an error unless the binary at path is
+// statically linked, as it has to run in an empty root filesystem.
+func checkStaticBinary(path string) error {
+	f, err := elf.Open(path)
+	if err != nil {
+		return fmt.Errorf("reading pause binary: %w", err)
+	}
+	defer f.Close()
+	for _, prog := range f.Progs {
+		if prog.Type == elf.PT_INTERP {
+			return fmt.Errorf("pause binary %s is dynamically linked and cannot run in an infra container without image", path)
+		}
+	}
+	return nil
+}
@@ -37,7 +37,7 @@ func MakePod(p *entities.PodSpec, rt *libpod.Runtime) (_ *libpod.Pod, finalErr e
 		p.PodSpecGen.ResourceLimits = &specs.LinuxResources{}
 	}
 
-	if !p.PodSpecGen.NoInfra {
+	if !p.PodSpecGen.NoInfra && !p.PodSpecGen.InfraNoImage {
 		imageName, err := PullOrBuildInfraImage(rt, p.PodSpecGen.InfraImage)
 		if err != nil {
 			return nil, err
@@ -50,6 +50,11 @@ func MakePod(p *entities.PodSpec, rt *libpod.Runtime) (_ *libpod.Pod, finalErr e
 	if err != nil {
 		return nil, err
 	}
+	if !p.PodSpecGen.NoInfra && p.PodSpecGen.InfraNoImage {
+		if err := SetupRootfslessInfra(rt, spec, p.PodSpecGen.InfraHealthInterval); err != nil {
+			return nil, err
+		}
+	}
 	if err := specgen.FinishThrottleDevices(spec); err != nil {
 		return nil, err
 	}
@@ -28,10 +28,22 @@ func (p *PodSpecGenerator) Validate() error {
 		if len(p.InfraName) > 0 {
 			return exclusivePodOptions("NoInfra", "InfraName")
 		}
+		if p.InfraNoImage {
+			return exclusivePodOptions("NoInfra", "InfraNoImage")
+		}
+		if len(p.InfraHealthInterval) > 0 {
+			return exclusivePodOptions("NoInfra", "InfraHealthInterval")
+		}
 		if len(p.SharedNamespaces) > 0 {
 			return exclusivePodOptions("NoInfra", "SharedNamespaces")
 		}
 	}
+	if p.InfraNoImage && len(p.InfraImage) > 0 {
+		return exclusivePodOptions("InfraNoImage", "InfraImage")
+	}
+	if len(p.InfraHealthInterval) > 0 && !p.InfraNoImage {
+		return fmt.Errorf("InfraHealthInterval requires InfraNoImage: %w", ErrInvalidPodSpecConfig)
+	}
 
 	// PodNetworkConfig
 	if err := validateNetNS(&p.NetNS); err != nil {
@@ -47,6 +47,17 @@ type PodBasicConfig struct {
 	// Conflicts with NoInfra=true.
 	// Optional.
 	InfraImage string `json:"infra_image,omitempty"`
+	// InfraNoImage runs the infra container without an image. Its root
+	// filesystem is an empty directory with the init binary of the host,
+	// which must be statically linked, bind-mounted into it.
+	// Conflicts with NoInfra=true and InfraImage.
+	// Optional.
+	InfraNoImage bool `json:"infra_no_image,omitempty"`
+	// InfraHealthInterval is the interval of a healthcheck of the infra
+	// container, which runs the init binary of the infra container.
+	// Requires InfraNoImage=true.
+	// Optional.
+	InfraHealthInterval string `json:"infra_health_interval,omitempty"`
 	// InfraName is the name that will be used for the infra container.
 	// If not set, the default set in the Libpod configuration file will be
 	// used.
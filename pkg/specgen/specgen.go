@@ -228,6 +228,10 @@ type ContainerBasicConfig struct {
 	// GroupEntry specifies an arbitrary string to append to the container's /etc/group file.
 	// Optional.
 	GroupEntry string `json:"group_entry,omitempty"`
+	// Timing records the durations of the steps of starting the container.
+	// They are shown by inspect and in the start event.
+	// Optional.
+	Timing *bool `json:"timing,omitempty"`
 }
 
 // ContainerStorageConfig contains information on the storage configuration of a
@@ -406,6 +410,11 @@ type ContainerSecurityConfig struct {
 	// If not specified, no Seccomp profile will be used.
 	// Optional.
 	SeccompProfilePath string `json:"seccomp_profile_path,omitempty"`
+	// SeccompNotifyPolicyPath is the path to a JSON file containing the
+	// policy of the seccomp user-notify listener of the container, which
+	// allows, denies or emulates the syscalls of the policy.
+	// Optional.
+	SeccompNotifyPolicyPath string `json:"seccomp_notify_policy_path,omitempty"`
 	// NoNewPrivileges is whether the container will set the no new
 	// privileges flag on create, which disables gaining additional
 	// privileges (e.g. via setuid) in the container.
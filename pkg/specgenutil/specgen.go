@@ -731,6 +731,9 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		case "seccomp":
 			s.SeccompProfilePath = val
 			s.Annotations[define.InspectAnnotationSeccomp] = val
+		case "seccomp-notify":
+			s.SeccompNotifyPolicyPath = val
+			s.Annotations[define.InspectAnnotationSeccompNotify] = val
 			// this option is for docker compatibility, it is the same as unmask=ALL
 		case "systempaths":
 			if val == "unconfined" {
@@ -34,6 +34,7 @@ const (
 	NetworkGroup    = "Network"
 	PodGroup        = "Pod"
 	ServiceGroup    = "Service"
+	SocketGroup     = "Socket"
 	UnitGroup       = "Unit"
 	VolumeGroup     = "Volume"
 	ImageGroup      = "Image"
@@ -118,6 +119,7 @@ const (
 	KeyIPRange               = "IPRange"
 	KeyIPv6                  = "IPv6"
 	KeyKubeDownForce         = "KubeDownForce"
+	KeyKustomize             = "Kustomize"
 	KeyLabel                 = "Label"
 	KeyLogDriver             = "LogDriver"
 	KeyLogOpt                = "LogOpt"
@@ -331,6 +333,7 @@ var (
 		KeyExitCodePropagation:  true,
 		KeyGlobalArgs:           true,
 		KeyKubeDownForce:        true,
+		KeyKustomize:            true,
 		KeyLogDriver:            true,
 		KeyLogOpt:               true,
 		KeyNetwork:              true,
@@ -430,6 +433,10 @@ func (u *UnitInfo) ServiceFileName() string {
 	return fmt.Sprintf("%s.service", u.ServiceName)
 }
 
+func (u *UnitInfo) SocketFileName() string {
+	return fmt.Sprintf("%s.socket", u.ServiceName)
+}
+
 func removeExtension(name string, extraPrefix string, extraSuffix string) string {
 	baseName := name
 
@@ -542,6 +549,15 @@ func ConvertContainer(container *parser.UnitFile, isUser bool, unitsInfoMap map[
 	// Rename common quadlet group
 	service.RenameGroup(QuadletGroup, XQuadletGroup)
 
+	// The Socket group belongs to the socket unit generated by
+	// ConvertContainerSocket, which activates the service.  Podman passes
+	// the LISTEN_FDS sockets on to the container.
+	if container.HasGroup(SocketGroup) {
+		service.RemoveGroup(SocketGroup)
+		service.Add(UnitGroup, "Requires", unitInfo.SocketFileName())
+		service.Add(UnitGroup, "After", unitInfo.SocketFileName())
+	}
+
 	// One image or rootfs must be specified for the container
 	image, _ := container.Lookup(ContainerGroup, KeyImage)
 	rootfs, _ := container.Lookup(ContainerGroup, KeyRootfs)
@@ -878,6 +894,60 @@ func ConvertContainer(container *parser.UnitFile, isUser bool, unitsInfoMap map[
 	return service, nil
 }
 
+// ConvertContainerSocket converts the Socket group of a .container file to
+// a socket unit for the service of the container.  It returns nil if the file
+// has no Socket group.
+func ConvertContainerSocket(container *parser.UnitFile, unitsInfoMap map[string]*UnitInfo) (*parser.UnitFile, error) {
+	if !container.HasGroup(SocketGroup) {
+		return nil, nil
+	}
+
+	unitInfo, ok := unitsInfoMap[container.Filename]
+	if !ok {
+		return nil, fmt.Errorf("internal error while processing container %s", container.Filename)
+	}
+
+	hasListen := false
+	for _, key := range container.ListKeys(SocketGroup) {
+		if strings.HasPrefix(key, "Listen") {
+			hasListen = true
+		}
+	}
+	if !hasListen {
+		return nil, fmt.Errorf("no Listen key specified in the Socket group")
+	}
+	// With Accept=yes, systemd would start an instance of a template
+	// service for each connection, instead of the service of the container.
+	if accept, ok := container.LookupBoolean(SocketGroup, "Accept"); ok && accept {
+		return nil, fmt.Errorf("accepting connections with Accept=yes is not supported in the Socket group")
+	}
+	if container.HasKey(SocketGroup, "Service") {
+		return nil, fmt.Errorf("the Service key is not supported in the Socket group, the socket activates %s", unitInfo.ServiceFileName())
+	}
+
+	socket := parser.NewUnitFile()
+	socket.Filename = unitInfo.SocketFileName()
+
+	if container.Path != "" {
+		socket.Add(UnitGroup, "SourcePath", container.Path)
+	}
+
+	// Copy the Socket group with its comments and the order of its keys
+	socketGroup := container.Dup()
+	for _, group := range socketGroup.ListGroups() {
+		if group != SocketGroup {
+			socketGroup.RemoveGroup(group)
+		}
+	}
+	socket.Merge(socketGroup)
+
+	// Start listening at boot, the service is started on the first
+	// connection.
+	socket.Add(InstallGroup, "WantedBy", "sockets.target")
+
+	return socket, nil
+}
+
 func defaultOneshotServiceGroup(service *parser.UnitFile, remainAfterExit bool) {
 	// The default syslog identifier is the exec basename (podman) which isn't very useful here
 	if _, ok := service.Lookup(ServiceGroup, "SyslogIdentifier"); !ok {
@@ -1169,14 +1239,28 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 	// Rename common quadlet group
 	service.RenameGroup(QuadletGroup, XQuadletGroup)
 
-	yamlPath, ok := kube.Lookup(KubeGroup, KeyYaml)
-	if !ok || len(yamlPath) == 0 {
-		return nil, fmt.Errorf("no Yaml key specified")
-	}
-
-	yamlPath, err := getAbsolutePath(kube, yamlPath)
-	if err != nil {
-		return nil, err
+	// The pods are either defined by a YAML file or by a kustomization
+	// directory rendered by kube play.
+	yamlPath, _ := kube.Lookup(KubeGroup, KeyYaml)
+	kustomizePath, _ := kube.Lookup(KubeGroup, KeyKustomize)
+	var playArgs []string
+	switch {
+	case len(yamlPath) > 0 && len(kustomizePath) > 0:
+		return nil, fmt.Errorf("the Yaml and Kustomize keys are mutually exclusive")
+	case len(kustomizePath) > 0:
+		kustomizePath, err := getAbsolutePath(kube, kustomizePath)
+		if err != nil {
+			return nil, err
+		}
+		playArgs = []string{"--kustomize", kustomizePath}
+	case len(yamlPath) > 0:
+		yamlPath, err := getAbsolutePath(kube, yamlPath)
+		if err != nil {
+			return nil, err
+		}
+		playArgs = []string{yamlPath}
+	default:
+		return nil, fmt.Errorf("no Yaml or Kustomize key specified")
 	}
 
 	// Only allow mixed or control-group, as nothing else works well
@@ -1264,7 +1348,7 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 
 	handlePodmanArgs(kube, KubeGroup, execStart)
 
-	execStart.add(yamlPath)
+	execStart.add(playArgs...)
 
 	service.AddCmdline(ServiceGroup, "ExecStart", execStart.Args)
 
@@ -1278,11 +1362,10 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 		execStop.addBool("--force", kubeDownForce)
 	}
 
-	execStop.add(yamlPath)
+	execStop.add(playArgs...)
 	service.AddCmdline(ServiceGroup, "ExecStopPost", execStop.Args)
 
-	_, err = handleSetWorkingDirectory(kube, service, KubeGroup)
-	if err != nil {
+	if _, err := handleSetWorkingDirectory(kube, service, KubeGroup); err != nil {
 		return nil, err
 	}
 
@@ -1947,7 +2030,12 @@ func handleSetWorkingDirectory(quadletUnitFile, serviceUnitFile *parser.UnitFile
 
 		relativeToFile, ok = quadletUnitFile.Lookup(quadletGroup, KeyYaml)
 		if !ok {
-			return "", fmt.Errorf("no Yaml key specified")
+			// With a kustomization, use its directory.
+			kustomization, ok := quadletUnitFile.Lookup(quadletGroup, KeyKustomize)
+			if !ok {
+				return "", fmt.Errorf("no Yaml or Kustomize key specified")
+			}
+			relativeToFile = filepath.Join(kustomization, "kustomization.yaml")
 		}
 	case "file":
 		if quadletGroup != BuildGroup {
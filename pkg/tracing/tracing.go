@@ -0,0 +1,292 @@
+// Package tracing records OpenTelemetry traces of podman operations.
+//
+// Tracing is disabled unless Init is called with an OTLP/HTTP endpoint or a
+// file to export the spans to. The spans are recorded and exported with the
+// OpenTelemetry SDK. The trace context is propagated in contexts and,
+// between the remote client and the API service, in the W3C traceparent
+// header.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EndpointEnv and TracesEndpointEnv are the standard OpenTelemetry
+	// environment variables of the OTLP endpoint, which are used unless
+	// an endpoint is set in the Options.
+	EndpointEnv       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	TracesEndpointEnv = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// TraceparentHeader is the W3C trace context header.
+	TraceparentHeader = "traceparent"
+
+	// ServiceName is the default name of the service the spans belong to.
+	ServiceName = "podman"
+
+	// instrumentationName is the name of the tracer of podman.
+	instrumentationName = "github.com/containers/podman/v5"
+)
+
+// Options configure the export of spans.
+type Options struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver, e.g.
+	// http://localhost:4318. Spans are sent to its /v1/traces path.
+	Endpoint string
+	// File to append the spans to, one JSON line per span as written by
+	// the stdout exporter of the OpenTelemetry SDK.
+	File string
+	// Service is the name of the service the spans belong to, "podman" if
+	// empty.
+	Service string
+}
+
+// Span is an operation being traced. All methods of a nil Span are no-ops,
+// so that callers do not need to check whether tracing is enabled.
+type Span struct {
+	span trace.Span
+}
+
+var (
+	globalLock     sync.RWMutex
+	globalProvider *sdktrace.TracerProvider
+	globalTracer   trace.Tracer
+	// processSpan is the parent of spans started with a context without a
+	// span, e.g. the span of the podman command.
+	processSpan trace.SpanContext
+
+	propagator = propagation.TraceContext{}
+)
+
+// Enabled returns true if spans are recorded.
+func Enabled() bool {
+	globalLock.RLock()
+	defer globalLock.RUnlock()
+	return globalProvider != nil
+}
+
+// Init enables tracing. The endpoint defaults to the value of the
+// TracesEndpointEnv or EndpointEnv environment variables. Tracing remains
+// disabled if neither an endpoint nor a file is set.
+func Init(opts Options) error {
+	useEnv := os.Getenv(TracesEndpointEnv) != "" || os.Getenv(EndpointEnv) != ""
+	if opts.Endpoint == "" && !useEnv && opts.File == "" {
+		return nil
+	}
+	service := opts.Service
+	if service == "" {
+		service = ServiceName
+	}
+
+	globalLock.Lock()
+	defer globalLock.Unlock()
+	if globalProvider != nil {
+		return errors.New("tracing is already initialized")
+	}
+
+	providerOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	}
+	if opts.Endpoint != "" || useEnv {
+		// Without an endpoint in the options, the exporter reads the
+		// endpoint and its other settings from the environment.
+		var exporterOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithEndpointURL(strings.TrimSuffix(opts.Endpoint, "/")+"/v1/traces"))
+		}
+		exporter, err := otlptracehttp.New(context.Background(), exporterOpts...)
+		if err != nil {
+			return fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	}
+	if opts.File != "" {
+		exporter, err := newFileExporter(opts.File)
+		if err != nil {
+			return err
+		}
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	}
+	globalProvider = sdktrace.NewTracerProvider(providerOpts...)
+	globalTracer = globalProvider.Tracer(instrumentationName)
+	return nil
+}
+
+// Shutdown exports all ended spans and disables tracing.
+func Shutdown(ctx context.Context) error {
+	globalLock.Lock()
+	provider := globalProvider
+	globalProvider = nil
+	globalTracer = nil
+	processSpan = trace.SpanContext{}
+	globalLock.Unlock()
+	if provider == nil {
+		return nil
+	}
+	if err := provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("exporting spans: %w", err)
+	}
+	return nil
+}
+
+// Start starts a span of an internal operation as child of the span in ctx.
+// The returned context contains the new span.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, trace.SpanKindInternal, true)
+}
+
+// StartClient starts the span of a request of the remote client to the API
+// service as child of the span in ctx, or of the process span if ctx has no
+// span. The returned span is nil if tracing is disabled.
+func StartClient(ctx context.Context, name string) (context.Context, *Span) {
+	return start(ctx, name, trace.SpanKindClient, true)
+}
+
+// StartServer starts the span of a request to the API service. Its parent
+// is the span of the client in the traceparent header of the request, if
+// any, otherwise the span starts a new trace.
+func StartServer(ctx context.Context, header http.Header, name string) (context.Context, *Span) {
+	return start(Extract(ctx, header), name, trace.SpanKindServer, false)
+}
+
+// Record records a span of an operation which was measured rather than
+// traced, e.g. by the API service, as child of the span in ctx. The span
+// has the attributes, if any.
+func Record(ctx context.Context, name string, startTime, endTime time.Time, attributes map[string]string) {
+	_, span := start(ctx, name, trace.SpanKindInternal, true, trace.WithTimestamp(startTime))
+	if span == nil {
+		return
+	}
+	for key, value := range attributes {
+		span.SetAttribute(key, value)
+	}
+	span.span.End(trace.WithTimestamp(endTime))
+}
+
+func start(ctx context.Context, name string, kind trace.SpanKind, useProcessSpan bool, opts ...trace.SpanStartOption) (context.Context, *Span) {
+	globalLock.RLock()
+	tracer := globalTracer
+	parent := processSpan
+	globalLock.RUnlock()
+	if tracer == nil {
+		return ctx, nil
+	}
+
+	if useProcessSpan && parent.IsValid() && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, parent)
+	}
+	ctx, span := tracer.Start(ctx, name, append(opts, trace.WithSpanKind(kind))...)
+	return ctx, &Span{span: span}
+}
+
+// SetProcessSpan makes the span the parent of all spans started with a
+// context without a span, e.g. with the context of the connection of the
+// remote client, which is created before the span of the command.
+func SetProcessSpan(s *Span) {
+	if s == nil {
+		return
+	}
+	globalLock.Lock()
+	defer globalLock.Unlock()
+	processSpan = s.span.SpanContext()
+}
+
+// SetAttribute sets an attribute of the span.
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+// SetError marks the operation of the span as failed with err, unless err
+// is nil.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End ends the span. Only the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.span.End()
+}
+
+// EndWithError marks the operation of the span as failed with the error
+// err points to, if any, and ends the span. It is meant to be deferred with
+// the named error result of a function:
+//
+//	ctx, span := tracing.Start(ctx, "operation")
+//	defer span.EndWithError(&retErr)
+func (s *Span) EndWithError(err *error) {
+	if s == nil {
+		return
+	}
+	s.SetError(*err)
+	s.End()
+}
+
+// Inject sets the traceparent header to the span in ctx, or to the process
+// span if ctx has no span.
+func Inject(ctx context.Context, header http.Header) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		globalLock.RLock()
+		parent := processSpan
+		globalLock.RUnlock()
+		ctx = trace.ContextWithSpanContext(ctx, parent)
+	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Extract returns a context with the remote span of the traceparent header
+// as parent of the spans started with it. ctx is returned unchanged if the
+// header is missing or invalid.
+func Extract(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// fileExporter appends the spans to a file with the stdout exporter of the
+// SDK and closes the file on shutdown.
+type fileExporter struct {
+	*stdouttrace.Exporter
+	file *os.File
+}
+
+func newFileExporter(path string) (*fileExporter, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening trace file: %w", err)
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("creating trace file exporter: %w", err)
+	}
+	return &fileExporter{Exporter: exporter, file: file}, nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.Exporter.Shutdown(ctx), e.file.Close())
+}
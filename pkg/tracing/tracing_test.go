@@ -0,0 +1,207 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type exportedSpanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+type exportedSpan struct {
+	Name        string
+	SpanContext exportedSpanContext
+	Parent      exportedSpanContext
+	SpanKind    int
+	StartTime   time.Time
+	EndTime     time.Time
+	Attributes  []struct {
+		Key   string
+		Value struct {
+			Value string
+		}
+	}
+	Status struct {
+		Code        string
+		Description string
+	}
+}
+
+func decodeSpans(t *testing.T, data []byte) []exportedSpan {
+	var spans []exportedSpan
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for decoder.More() {
+		var span exportedSpan
+		require.NoError(t, decoder.Decode(&span))
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func TestDisabled(t *testing.T) {
+	assert.False(t, Enabled())
+	ctx, span := Start(context.Background(), "op")
+	assert.Nil(t, span)
+	assert.Equal(t, context.Background(), ctx)
+	// Methods of nil spans are no-ops.
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("failed"))
+	span.End()
+
+	header := http.Header{}
+	Inject(ctx, header)
+	assert.Empty(t, header.Get(TraceparentHeader))
+}
+
+func TestFileExport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	require.NoError(t, Init(Options{File: path}))
+	assert.True(t, Enabled())
+	assert.Error(t, Init(Options{File: path}), "tracing can only be initialized once")
+
+	_, root := Start(context.Background(), "podman ps")
+	SetProcessSpan(root)
+	// Spans without a parent in the context are children of the process span.
+	ctx, child := StartClient(context.Background(), "GET /containers/json")
+	header := http.Header{}
+	Inject(ctx, header)
+	child.SetError(errors.New("connection refused"))
+	child.End()
+	child.End()
+	root.End()
+	require.NoError(t, Shutdown(context.Background()))
+	assert.False(t, Enabled())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	spans := decodeSpans(t, data)
+	require.Len(t, spans, 2)
+	assert.Equal(t, "GET /containers/json", spans[0].Name)
+	assert.Equal(t, int(trace.SpanKindClient), spans[0].SpanKind)
+	assert.Equal(t, root.span.SpanContext().SpanID().String(), spans[0].Parent.SpanID)
+	assert.Equal(t, root.span.SpanContext().TraceID().String(), spans[0].SpanContext.TraceID)
+	assert.Equal(t, "Error", spans[0].Status.Code)
+	assert.Equal(t, "connection refused", spans[0].Status.Description)
+	assert.Equal(t, "podman ps", spans[1].Name)
+	assert.Equal(t, "0000000000000000", spans[1].Parent.SpanID)
+	assert.Equal(t, "Unset", spans[1].Status.Code)
+
+	assert.Equal(t, "00-"+spans[0].SpanContext.TraceID+"-"+spans[0].SpanContext.SpanID+"-01", header.Get(TraceparentHeader))
+
+	// Spans of further commands are appended to the file.
+	require.NoError(t, Init(Options{File: path}))
+	_, span := Start(context.Background(), "podman info")
+	span.End()
+	require.NoError(t, Shutdown(context.Background()))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	spans = decodeSpans(t, data)
+	require.Len(t, spans, 3)
+	assert.Equal(t, "podman info", spans[2].Name)
+}
+
+func TestRecord(t *testing.T) {
+	// Recording spans is a no-op when tracing is disabled.
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	Record(context.Background(), "storage-mount", start, start.Add(time.Second), nil)
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+	require.NoError(t, Init(Options{File: path}))
+	ctx, root := Start(context.Background(), "podman run")
+	Record(ctx, "network-setup", start, start.Add(time.Second), map[string]string{"detail": "netavark"})
+	root.End()
+	require.NoError(t, Shutdown(context.Background()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	spans := decodeSpans(t, data)
+	require.Len(t, spans, 2)
+	assert.Equal(t, "network-setup", spans[0].Name)
+	assert.Equal(t, root.span.SpanContext().SpanID().String(), spans[0].Parent.SpanID)
+	assert.True(t, start.Equal(spans[0].StartTime))
+	assert.True(t, start.Add(time.Second).Equal(spans[0].EndTime))
+	require.Len(t, spans[0].Attributes, 1)
+	assert.Equal(t, "detail", spans[0].Attributes[0].Key)
+	assert.Equal(t, "netavark", spans[0].Attributes[0].Value.Value)
+}
+
+func TestEndpointExport(t *testing.T) {
+	received := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		received <- body
+	}))
+	defer server.Close()
+
+	t.Setenv(EndpointEnv, server.URL+"/")
+	require.NoError(t, Init(Options{}))
+
+	// The span of a request to the API service is a child of the span of
+	// the client.
+	_, service := Start(context.Background(), "podman system service")
+	SetProcessSpan(service)
+	header := http.Header{}
+	header.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, span := StartServer(context.Background(), header, "POST /containers/{name}/start")
+	_, engineSpan := Start(ctx, "ContainerEngine.ContainerStart")
+	engineSpan.SetError(errors.New("no such container"))
+	engineSpan.End()
+	span.End()
+	// Requests without a trace context start a new trace.
+	_, span = StartServer(context.Background(), http.Header{}, "GET /_ping")
+	span.End()
+	require.NoError(t, Shutdown(context.Background()))
+
+	var request coltracepb.ExportTraceServiceRequest
+	require.NoError(t, proto.Unmarshal(<-received, &request))
+	require.Len(t, request.ResourceSpans, 1)
+	require.Len(t, request.ResourceSpans[0].ScopeSpans, 1)
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 3)
+	assert.Equal(t, "ContainerEngine.ContainerStart", spans[0].Name)
+	assert.Equal(t, spans[1].SpanId, spans[0].ParentSpanId)
+	assert.Equal(t, "no such container", spans[0].Status.GetMessage())
+	assert.Equal(t, "POST /containers/{name}/start", spans[1].Name)
+	assert.Equal(t, "SPAN_KIND_SERVER", spans[1].Kind.String())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(spans[1].TraceId))
+	assert.Equal(t, "00f067aa0ba902b7", hex.EncodeToString(spans[1].ParentSpanId))
+	assert.Equal(t, "GET /_ping", spans[2].Name)
+	assert.Empty(t, spans[2].ParentSpanId)
+	assert.NotEqual(t, service.span.SpanContext().TraceID().String(), hex.EncodeToString(spans[2].TraceId))
+}
+
+func TestExtractInvalid(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00F067AA0BA902B7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-01",
+	} {
+		header := http.Header{}
+		header.Set(TraceparentHeader, value)
+		ctx := Extract(context.Background(), header)
+		assert.False(t, trace.SpanContextFromContext(ctx).IsValid(), value)
+	}
+}
@@ -170,6 +170,16 @@ func (t *quadletTestcase) assertKeyIs(args []string, unit *parser.UnitFile) bool
 	return true
 }
 
+// assertSocketKeyIs checks a key of the socket unit generated along with the
+// service unit.
+func (t *quadletTestcase) assertSocketKeyIs(args []string, unit *parser.UnitFile) bool {
+	socket, err := parser.ParseUnitFile(strings.TrimSuffix(unit.Path, ".service") + ".socket")
+	if err != nil {
+		return false
+	}
+	return t.assertKeyIs(args, socket)
+}
+
 func (t *quadletTestcase) assertKeyIsEmpty(args []string, unit *parser.UnitFile) bool {
 	Expect(args).To(HaveLen(2))
 	group := args[0]
@@ -566,6 +576,8 @@ func (t *quadletTestcase) doAssert(check []string, unit *parser.UnitFile, sessio
 		ok = t.assertStartPrePodmanFinalArgsRegex(args, unit)
 	case "assert-symlink":
 		ok = t.assertSymlink(args, unit)
+	case "assert-socket-key-is":
+		ok = t.assertSocketKeyIs(args, unit)
 	case "assert-podman-stop-args":
 		ok = t.assertStopPodmanArgs(args, unit)
 	case "assert-podman-stop-global-args":
@@ -904,6 +916,7 @@ BOGUS=foo
 		Entry("secrets.container", "secrets.container"),
 		Entry("selinux.container", "selinux.container"),
 		Entry("shmsize.container", "shmsize.container"),
+		Entry("socket.container", "socket.container"),
 		Entry("stopsigal.container", "stopsignal.container"),
 		Entry("stoptimeout.container", "stoptimeout.container"),
 		Entry("subidmapping.container", "subidmapping.container"),
@@ -957,6 +970,8 @@ BOGUS=foo
 		Entry("Kube - Containers Conf Modules", "containersconfmodule.kube"),
 		Entry("Kube - Service Type=oneshot", "oneshot.kube"),
 		Entry("Kube - Down force", "downforce.kube"),
+		Entry("Kube - Kustomize", "kustomize.kube"),
+		Entry("Kube - Working Directory Kustomize", "workingdir-kustomize.kube"),
 
 		Entry("Network - Basic", "basic.network"),
 		Entry("Network - Disable DNS", "disable-dns.network"),
@@ -1053,6 +1068,8 @@ BOGUS=foo
 		runErrorQuadletTestCase,
 		Entry("idmapping-with-remap.container", "idmapping-with-remap.container", "converting \"idmapping-with-remap.container\": deprecated Remap keys are set along with explicit mapping keys"),
 		Entry("noimage.container", "noimage.container", "converting \"noimage.container\": no Image or Rootfs key specified"),
+		Entry("socket-accept.container", "socket-accept.container", "converting \"socket-accept.container\": accepting connections with Accept=yes is not supported in the Socket group"),
+		Entry("socket-no-listen.container", "socket-no-listen.container", "converting \"socket-no-listen.container\": no Listen key specified in the Socket group"),
 		Entry("pod.non-quadlet.container", "pod.non-quadlet.container", "converting \"pod.non-quadlet.container\": pod test-pod is not Quadlet based"),
 		Entry("pod.not-found.container", "pod.not-found.container", "converting \"pod.not-found.container\": quadlet pod unit not-found.pod does not exist"),
 		Entry("subidmapping-with-remap.container", "subidmapping-with-remap.container", "converting \"subidmapping-with-remap.container\": deprecated Remap keys are set along with explicit mapping keys"),
@@ -1063,6 +1080,7 @@ BOGUS=foo
 		Entry("Volume - Quadlet image (.image) not found", "image-not-found.quadlet.volume", "converting \"image-not-found.quadlet.volume\": requested Quadlet image not-found.image was not found"),
 
 		Entry("Kube - User Remap Manual", "remap-manual.kube", "converting \"remap-manual.kube\": RemapUsers=manual is not supported"),
+		Entry("Kube - Yaml and Kustomize", "kustomize-yaml.kube", "converting \"kustomize-yaml.kube\": the Yaml and Kustomize keys are mutually exclusive"),
 
 		Entry("Network - Gateway not enough Subnet", "gateway.less-subnet.network", "converting \"gateway.less-subnet.network\": cannot set more gateways than subnets"),
 		Entry("Network - Gateway without Subnet", "gateway.no-subnet.network", "converting \"gateway.no-subnet.network\": cannot set gateway or range without subnet"),
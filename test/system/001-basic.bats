@@ -280,4 +280,25 @@ run_podman --noout system connection ls
     is "$output" ".*Shutting down engines.*"
 }
 
+# bats test_tags=ci:parallel
+@test "podman --trace-otlp-file exports spans of the command" {
+    outfile=${BATS_TEST_TMPDIR}/traces.json
+    run_podman --trace-otlp-file $outfile ps
+
+    run jq -r '.Name' $outfile
+    assert "$status" -eq 0 "jq succeeds on exported spans"
+    assert "$output" =~ "podman(-remote)? ps" "span of the command"
+    if is_remote; then
+        assert "$output" =~ "GET /libpod/containers/json" "span of the request"
+    else
+        assert "$output" =~ "ContainerEngine.ContainerList" "span of the engine method"
+    fi
+
+    # All spans are part of the same trace
+    run jq -r '.SpanContext.TraceID' $outfile
+    assert "$status" -eq 0 "jq succeeds on exported spans"
+    run sort -u <<<"$output"
+    assert "${#lines[@]}" -eq 1 "number of traces"
+}
+
 # vim: filetype=sh
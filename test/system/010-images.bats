@@ -448,5 +448,79 @@ EOF
     wait
 }
 
+@test "podman images - last used and prune --filter unused-for" {
+    img=localhost/lastused:$(safename)
+    run_podman run --name c1-$(safename) $IMAGE true
+    run_podman commit -q --change LABEL=lastused.$(safename)=1 c1-$(safename) $img
+    iid="$output"
+    run_podman rm c1-$(safename)
+
+    run_podman images --format '{{.LastUsed}}|{{.LastUsedAt}}' $img
+    is "$output" "never|" "committed image has never been used"
+
+    # Images without a recorded use count as used when created
+    run_podman image prune -a -f --filter label=lastused.$(safename)=1 --filter unused-for=1h
+    is "$output" "" "recently created image is not pruned"
+
+    run_podman create --name c2-$(safename) $img true
+    run_podman images --format '{{.LastUsed}}' $img
+    assert "$output" =~ "second" "creating a container records the last use"
+    run_podman rm c2-$(safename)
+
+    sleep 2
+    run_podman image prune -a -f --filter label=lastused.$(safename)=1 --filter unused-for=1h
+    is "$output" "" "recently used image is not pruned"
+    run_podman image prune -a -f --filter label=lastused.$(safename)=1 --filter unused-for=1s
+    is "$output" "$iid" "image unused for more than a second is pruned"
+
+    run_podman 125 image prune -f --filter unused-for=bogus
+    is "$output" 'Error: invalid unused-for filter "bogus": .*'
+}
+
+# bats test_tags=ci:parallel
+@test "podman image convert" {
+    skip_if_remote "podman image convert is not available remote"
+
+    cname=c_$(safename)
+    iname=i_$(safename)
+    run_podman run --name $cname $IMAGE touch /converted
+    run_podman commit -q --format docker $cname localhost/$iname:docker
+    iid="$output"
+    run_podman rm $cname
+
+    # The converted image keeps the ID, converting in place must be requested
+    run_podman 125 image convert --format oci --compression-format zstd -t localhost/$iname:oci localhost/$iname:docker
+    assert "$output" =~ "would change it in place, .* use --in-place to convert it anyway" "conversion in place is refused"
+    run_podman image inspect --format '{{.ManifestType}}' localhost/$iname:docker
+    is "$output" "application/vnd.docker.distribution.manifest.v2+json" "source image is unchanged"
+    run_podman 1 image exists localhost/$iname:oci
+
+    run_podman image convert --in-place --format oci --compression-format zstd -t localhost/$iname:oci localhost/$iname:docker
+    assert "${lines[-3]}" == "ID:     ${iid:0:12} -> ${iid:0:12}" "converted image keeps its ID"
+    assert "${lines[-2]}" == "Format: application/vnd.docker.distribution.manifest.v2+json -> application/vnd.oci.image.manifest.v1+json" \
+           "manifest type of the converted image"
+    assert "${lines[-1]}" =~ "^Size:   .* -> .* \([+-][0-9.]+%\)$" "size comparison"
+
+    run_podman image inspect --format '{{.ManifestType}}' localhost/$iname:oci
+    is "$output" "application/vnd.oci.image.manifest.v1+json" "manifest type after conversion"
+    # The image was converted in place, under all of its names
+    run_podman image inspect --format '{{.ID}} {{.ManifestType}}' localhost/$iname:docker
+    is "$output" "$iid application/vnd.oci.image.manifest.v1+json" "source tag after conversion in place"
+
+    # the converted image can be used
+    run_podman run --rm localhost/$iname:oci ls /converted
+    is "$output" "/converted" "file of the converted image"
+
+    run_podman image convert -q --in-place --format v2s2 --compression-format gzip localhost/$iname:oci
+    is "$output" "$iid" "-q prints only the image ID"
+
+    run_podman 125 image convert $iid
+    is "$output" "Error: converting image $iid referenced by ID requires a tag" "image given by ID without a tag"
+
+    run_podman 125 image convert --format v2s1 localhost/$iname:oci
+    is "$output" "Error: unknown format \"v2s1\". Choose one of the supported formats: 'oci' or 'v2s2'" "unsupported format"
+
+    run_podman rmi localhost/$iname:docker localhost/$iname:oci
+}
 
 # vim: filetype=sh
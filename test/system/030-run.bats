@@ -1108,6 +1108,37 @@ EOF
     run_podman rm $output
 }
 
+# bats test_tags=ci:parallel
+@test "podman run --security-opt seccomp-notify" {
+    skip_if_rootless "mknod emulation requires root"
+    skip_if_remote "the policy file has to be on the server"
+
+    policy=$PODMAN_TMPDIR/seccomp-notify.json
+    cat >$policy <<EOF
+{"rules": [
+  {"names": ["mknod", "mknodat"], "action": "emulate", "handler": "mknod",
+   "args": {"devices": [{"type": "c", "major": 1, "minor": 3, "path": "/tmp/null"}]}},
+  {"names": ["mknod", "mknodat"], "action": "deny", "errno": "EACCES"}
+]}
+EOF
+
+    # Without CAP_MKNOD, the device can only be created by the listener.
+    run_podman 1 run --rm --cap-drop mknod --security-opt seccomp-notify=$policy $IMAGE \
+               sh -c "mknod /tmp/null c 1 3 && stat -c '%F %t:%T' /tmp/null && mknod /tmp/zero c 1 5"
+    assert "${lines[0]}" = "character special file 1:3" "emulated mknod"
+    assert "$output" =~ "Permission denied" "denied mknod"
+
+    run_podman create --security-opt seccomp-notify=$policy $IMAGE
+    cid=$output
+    run_podman inspect --format '{{.HostConfig.SecurityOpt}}' $cid
+    assert "$output" =~ "seccomp-notify=$policy" "seccomp-notify in inspect"
+    run_podman rm $cid
+
+    echo '{"rules": [{"names": ["mount"], "action": "emulate"}]}' >$policy
+    run_podman 125 create --security-opt seccomp-notify=$policy $IMAGE
+    assert "$output" =~ "rule 1: the emulate action requires a handler" "invalid policy"
+}
+
 # bats test_tags=distro-integration, ci:parallel
 @test "podman run --device-read-bps" {
     skip_if_rootless "cannot use this flag in rootless mode"
@@ -1711,4 +1742,82 @@ search               | $IMAGE           |
     run_podman rm -f -t0 $cname
 }
 
+# bats test_tags=ci:parallel
+@test "podman run with admission policy" {
+    skip_if_remote "admission policy is read by the server"
+    skip_if_rootless "CONTAINERS_ADMISSION_POLICY is only honored for root"
+
+    policy=$PODMAN_TMPDIR/admission.policy.toml
+    cat >$policy <<EOF
+deny_privileged = true
+forbidden_host_mounts = ["/etc", "/dev/zero"]
+required_labels = ["owner"]
+EOF
+
+    CONTAINERS_ADMISSION_POLICY=$policy run_podman 125 run --rm --privileged -v /etc/hosts:/h --device /dev/zero:/dev/z $IMAGE true
+    assert "$output" =~ "container creation denied by admission policy" "creation is denied"
+    assert "$output" =~ "privileged containers are not allowed" "privileged violation"
+    assert "$output" =~ "mounting host path \"/etc/hosts\" is not allowed" "host mount violation"
+    assert "$output" =~ "passing host device \"/dev/zero\" is not allowed" "host device violation"
+    assert "$output" =~ "label \"owner\" is required" "label violation"
+
+    # Only the registry an image was pulled from counts, not its names
+    local registrypolicy=$PODMAN_TMPDIR/registry.policy.toml
+    echo 'allowed_registries = ["allowed.example.com"]' >$registrypolicy
+    local forged=allowed.example.com/i-$(safename):latest
+    run_podman tag $IMAGE $forged
+    CONTAINERS_ADMISSION_POLICY=$registrypolicy run_podman 125 create $forged true
+    assert "$output" =~ "image \"$forged\" was not pulled from an allowed registry" "registry violation"
+    run_podman untag $IMAGE $forged
+
+    CONTAINERS_ADMISSION_POLICY=$policy run_podman run --rm --label owner=me $IMAGE echo allowed
+    is "$output" "allowed" "compliant container is created"
+
+    echo 'mode = "audit"' >>$policy
+    CONTAINERS_ADMISSION_POLICY=$policy run_podman run --rm --privileged $IMAGE echo audited
+    assert "$output" =~ "Admission policy violation by container .* \(audit mode\): privileged containers are not allowed" \
+           "violations are logged in audit mode"
+    assert "$output" =~ "audited" "container is created in audit mode"
+
+    echo 'mode = "warn"' >$policy
+    CONTAINERS_ADMISSION_POLICY=$policy run_podman 125 run --rm $IMAGE true
+    is "$output" "Error: invalid admission policy mode \"warn\", must be \"enforce\" or \"audit\"" "invalid policy"
+}
+
+# bats test_tags=ci:parallel
+@test "podman run --timing" {
+    local cname=c-$(safename)
+    local otlpfile=$PODMAN_TMPDIR/timing.json
+
+    run_podman run --name $cname --timing-otlp-file $otlpfile $IMAGE true
+    for step in image-resolution storage-mount spec-generation conmon-spawn runtime-create runtime-start total; do
+        assert "$output" =~ "$step" "step $step is printed"
+    done
+
+    run_podman container inspect --format '{{range .Timing}}{{.Name}} {{end}}' $cname
+    assert "$output" =~ "storage-mount" "timing is recorded with the container"
+    assert "$output" !~ "image-resolution" "the image is resolved by the client"
+
+    run jq -r '.Name' $otlpfile
+    assert "$status" -eq 0 "jq succeeds on exported spans"
+    assert "$output" =~ "podman(-remote)? run" "span of the command"
+    assert "$output" =~ "runtime-start" "runtime start span"
+
+    # The timing spans are children of the span of the command
+    run jq -r 'select(.Name == "runtime-start") | .Parent.SpanID' $otlpfile
+    assert "$status" -eq 0 "jq succeeds on exported spans"
+    local parent="$output"
+    run jq -r "select(.SpanContext.SpanID == \"$parent\") | .Name" $otlpfile
+    assert "$output" =~ "podman(-remote)? run" "parent of the timing span"
+
+    # The timing is also reported for containers removed on exit
+    run_podman run --rm --timing $IMAGE true
+    assert "$output" =~ "runtime-start" "timing with --rm"
+
+    # Timing is only recorded when requested
+    run_podman run --rm $IMAGE true
+    assert "$output" !~ "runtime-start" "no timing without --timing"
+    run_podman rm $cname
+}
+
 # vim: filetype=sh
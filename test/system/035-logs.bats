@@ -452,4 +452,74 @@ EOF
     run_podman rm $cname
 }
 
+function _log_test_ratelimit() {
+    local driver=$1
+    local cname=c-ratelimit-$(safename)
+
+    run_podman run --name $cname --log-driver=$driver \
+               --log-opt rate=1/h --log-opt burst=2 \
+               $IMAGE sh -c 'for i in $(seq 1 10); do echo line$i; done'
+
+    # the marker is written at the latest when conmon closes the log
+    wait_for_output "8 lines suppressed" $cname
+    run_podman logs $cname
+    assert "$output" = "line1
+line2
+8 lines suppressed" "logs beyond the burst are suppressed"
+
+    run_podman inspect --format '{{.HostConfig.LogConfig.RateLimit.Burst}} {{.HostConfig.LogConfig.RateLimit.Lines}} {{.HostConfig.LogConfig.RateLimit.Suppressed}}' $cname
+    is "$output" "2 2 8" "log rate limit counters in inspect"
+
+    run_podman rm $cname
+}
+
+# bats test_tags=ci:parallel
+@test "podman logs - rate limit k8s-file" {
+    _log_test_ratelimit k8s-file
+}
+
+# bats test_tags=ci:parallel
+@test "podman logs - rate limit journald" {
+    # We can't use journald on RHEL as rootless: rhbz#1895105
+    skip_if_journald_unavailable
+
+    _log_test_ratelimit journald
+}
+
+# bats test_tags=ci:parallel
+@test "podman logs - rate limit relay lives with conmon" {
+    local cname=c-ratelimit-$(safename)
+    run_podman run -d --name $cname --log-opt rate=10/s $IMAGE top
+    cid=$output
+
+    run pgrep -f "podman-log-relay .*$cid"
+    assert "$status" -eq 0 "log relay is running"
+    relay=$output
+
+    run_podman inspect --format '{{.State.ConmonPid}}' $cname
+    assert "$(< /proc/$relay/cgroup)" = "$(< /proc/$output/cgroup)" "log relay is in the cgroup of conmon"
+
+    run_podman rm -f -t0 $cname
+    for i in {1..20}; do
+        if ! kill -0 $relay 2>/dev/null; then
+            break
+        fi
+        sleep 0.5
+    done
+    run kill -0 $relay
+    assert "$status" -ne 0 "log relay exited with conmon"
+}
+
+# bats test_tags=ci:parallel
+@test "podman run - log rate limit errors" {
+    run_podman 125 run --rm --log-opt rate=100 $IMAGE true
+    is "$output" "Error: invalid log rate \"100\": must be in the form N/s, N/m or N/h"
+
+    run_podman 125 run --rm --log-opt burst=10 $IMAGE true
+    is "$output" "Error: log option burst requires log option rate"
+
+    run_podman 125 run --rm --log-driver=none --log-opt rate=10/s $IMAGE true
+    is "$output" "Error: log rate limits are not supported with the none log driver: invalid argument"
+}
+
 # vim: filetype=sh
@@ -255,4 +255,45 @@ load helpers
     run_podman rmi $(pause_image)
 }
 
+# bats test_tags=ci:parallel
+@test "podman ps --filter label, name and ancestor" {
+    local lbl=l$(random_string 8 | tr A-Z a-z)
+    local c1=c1-$(safename)
+    local c2=c2-$(safename)
+
+    run_podman create --name $c1 --label $lbl=one --label $lbl.other=x $IMAGE true
+    run_podman create --name $c2 --label $lbl=two $IMAGE true
+
+    run_podman ps -a --filter label=$lbl=one --format '{{.Names}}'
+    is "$output" "$c1" "filter: label with value"
+
+    run_podman ps -a --filter label=$lbl --format '{{.Names}}' --sort names
+    is "$output" "$c1
+$c2" "filter: label without value"
+
+    run_podman ps -a --filter label=$lbl=one --filter label=$lbl.other --format '{{.Names}}'
+    is "$output" "$c1" "filter: all labels must match"
+
+    run_podman ps -a --filter "label=*.other" --filter label=$lbl --format '{{.Names}}'
+    is "$output" "$c1" "filter: label key pattern"
+
+    run_podman ps -a --filter name=$c2 --filter name=bogus --format '{{.Names}}'
+    is "$output" "$c2" "filter: name substring"
+
+    run_podman ps -a --filter "name=^c[12]-$(safename)$" --filter label=$lbl --format '{{.Names}}' --sort names
+    is "$output" "$c1
+$c2" "filter: name regex"
+
+    run_podman ps -a --filter ancestor=$IMAGE --filter label=$lbl=two --format '{{.Names}}'
+    is "$output" "$c2" "filter: ancestor"
+
+    run_podman ps -a --filter ancestor=bogus-$(safename) --filter label=$lbl --noheading
+    is "$output" "" "filter: unknown ancestor"
+
+    run_podman ps -a --filter pod=bogus-$(safename) --noheading
+    is "$output" "" "filter: unknown pod"
+
+    run_podman rm $c1 $c2
+}
+
 # vim: filetype=sh
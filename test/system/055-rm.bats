@@ -123,6 +123,35 @@ load helpers
     assert "$output" !~ "$(safename)" "container should be removed"
 }
 
+# bats test_tags=ci:parallel
+@test "podman rm --format json --fail-fast --parallel" {
+    local c1=c1-$(safename)
+    local c2=c2-$(safename)
+    local c3=c3-$(safename)
+    run_podman create --name $c1 $IMAGE
+    local cid1="$output"
+    run_podman run -d --name $c2 $IMAGE top
+    run_podman create --name $c3 $IMAGE
+
+    # The running container fails to be removed, so the last one is skipped
+    run_podman 2 rm --format json --fail-fast --parallel 1 $c1 $c2 $c3
+    run jq -r '.[] | [.id, .rawInput, .status] | join(" ")' <<<"$output"
+    assert "${lines[0]}" == "$cid1 $c1 ok" "first container removed"
+    assert "${lines[1]}" =~ " $c2 failed\$" "running container not removed"
+    assert "${lines[2]}" =~ " $c3 skipped\$" "last container skipped"
+
+    run_podman 2 rm --format json $c2
+    run jq -r '.[0].error' <<<"$output"
+    assert "$output" =~ "cannot remove container .* as it is running - running or paused containers cannot be removed without force" "error of the container"
+
+    run_podman rm --format json --parallel 2 -t 0 -f $c2 $c3
+    run jq -r '.[].status' <<<"$output"
+    assert "$output" == $'ok\nok' "status of both containers"
+
+    run_podman 125 rm --format yaml $c1
+    is "$output" "Error: unsupported --format \"yaml\", only \"json\" is supported"
+}
+
 # DO NOT CHANGE "sleep infinity"! This is how we get a container to
 # remain in state "stopping" for long enough to check it.
 function __run_healthcheck_container() {
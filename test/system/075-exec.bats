@@ -253,4 +253,91 @@ load helpers
     run_podman rm -f -t0 $cid
 }
 
+# bats test_tags=ci:parallel
+@test "podman exec --record and session replay" {
+    local cname=c-$(safename)
+    local castfile=$PODMAN_TMPDIR/session.cast
+    run_podman run -d --name $cname $IMAGE top
+
+    run_podman 125 exec --record $castfile $cname true
+    is "$output" "Error: --record requires --tty" "--record requires --tty"
+
+    local content=$(random_string 20)
+    run_podman exec -t --record $castfile $cname echo $content
+    assert "$output" =~ "$content" "output of the session"
+
+    run head -1 $castfile
+    assert "$output" =~ '"version":2' "asciicast v2 header"
+    assert "$output" =~ '"type":"exec"' "session metadata"
+    run tail -1 $castfile
+    assert "$output" =~ '"m","end"' "end of the session is marked"
+
+    run_podman session replay $castfile
+    assert "$output" =~ "$content" "replayed output"
+
+    run_podman session replay --info $castfile
+    assert "$output" =~ "Type: +exec" "type of the session"
+    assert "$output" =~ "Container: +$cname" "container of the session"
+    assert "$output" =~ "Command: +echo $content" "command of the session"
+    assert "$output" =~ "End: " "end of the session"
+
+    run_podman rm -f -t0 $cname
+}
+
+@test "podman exec session recording configured by the administrator" {
+    skip_if_remote "the configuration of the server cannot be changed"
+
+    # The directory is only read from the configuration of the administrator
+    local conf=/etc/containers/containers.conf.d/zz-$(safename).conf
+    if [[ -e $conf ]]; then
+        die "File already exists (it should not): $conf"
+    fi
+    local recdir=$PODMAN_TMPDIR/sessions
+    printf '[engine]\nsession_recording_dir = "%s"\n' "$recdir" | sudo -n tee $conf >/dev/null || skip "test requires sudo"
+
+    local cname=c-$(safename)
+    local content=$(random_string 20)
+    run_podman run -d --name $cname $IMAGE top
+    run_podman exec -t $cname echo $content
+    run_podman exec $cname true
+
+    # The configuration of the user cannot turn recording off
+    printf '[engine]\nsession_recording_dir = ""\n' > $PODMAN_TMPDIR/user.conf
+    CONTAINERS_CONF=$PODMAN_TMPDIR/user.conf run_podman exec -t $cname echo $content
+    sudo -n rm -f $conf
+
+    run ls $recdir
+    is "${#lines[@]}" "2" "only the sessions with a terminal are recorded"
+    assert "${lines[0]}" =~ "^$cname-exec-.*\.cast$" "name of the recording"
+    run_podman session replay $recdir/${lines[0]}
+    assert "$output" =~ "$content" "replayed output of the recorded session"
+
+    run_podman rm -f -t0 $cname
+}
+
+@test "podman exec --record does not replace the recording configured by the administrator" {
+    skip_if_remote "the configuration of the server cannot be changed"
+
+    local conf=/etc/containers/containers.conf.d/zz-$(safename).conf
+    if [[ -e $conf ]]; then
+        die "File already exists (it should not): $conf"
+    fi
+    local recdir=$PODMAN_TMPDIR/sessions
+    printf '[engine]\nsession_recording_dir = "%s"\n' "$recdir" | sudo -n tee $conf >/dev/null || skip "test requires sudo"
+
+    local cname=c-$(safename)
+    local content=$(random_string 20)
+    run_podman run -d --name $cname $IMAGE top
+    run_podman exec -t --record /dev/null $cname echo $content
+    sudo -n rm -f $conf
+
+    run ls $recdir
+    is "${#lines[@]}" "1" "the session is recorded into the directory of the administrator"
+    assert "${lines[0]}" =~ "^$cname-exec-.*\.cast$" "name of the recording"
+    run_podman session replay $recdir/${lines[0]}
+    assert "$output" =~ "$content" "replayed output of the recorded session"
+
+    run_podman rm -f -t0 $cname
+}
+
 # vim: filetype=sh
@@ -318,4 +318,68 @@ verify_img_config_digest_and_name() {
     is "$output" ".*POSIX tar archive" "layers are uncompressed"
 }
 
+@test "podman save --all-platforms and load of a manifest list" {
+    dockerfile=$PODMAN_TMPDIR/Dockerfile
+    cat >$dockerfile <<EOF
+FROM scratch
+ARG TARGETARCH
+COPY Dockerfile /i-am-\${TARGETARCH}
+EOF
+
+    local img="i-$(safename)"
+    local list="localhost/m-$(safename):1.0"
+    run_podman manifest create $list
+    for arch in amd arm; do
+        run_podman build --layers=false -t "$img-$arch" --platform linux/${arch}64 -f $dockerfile
+        run_podman manifest add $list containers-storage:localhost/"$img-$arch:latest"
+    done
+    echo "sbom-$(random_string 8)" > $PODMAN_TMPDIR/sbom.json
+    run_podman manifest add --artifact --artifact-type application/x-sbom $list $PODMAN_TMPDIR/sbom.json
+    run_podman image inspect --format '{{.Id}}' "$img-amd" "$img-arm"
+    local iids="$output"
+
+    run_podman 125 save --all-platforms -o $PODMAN_TMPDIR/list.tar $list
+    is "$output" "Error: --all-platforms can only be set when --format is 'oci-archive' or 'oci-dir'"
+
+    for format in oci-archive oci-dir; do
+        archive=$PODMAN_TMPDIR/list-$(random_string 8)
+        run_podman save --all-platforms --format $format -o $archive $list
+
+        run_podman 125 load -i $archive
+        is "$output" "Error: loading image index: an image or manifest list named \"$list\" already exists, remove it first"
+
+        # Remove the list and, on the first round, all of its instances.
+        run_podman manifest rm $list
+        if [[ "$format" == "oci-archive" ]]; then
+            run_podman rmi "$img-amd" "$img-arm"
+        fi
+
+        run_podman load -i $archive
+        is "$output" ".*Loaded image: $list" "load restores the manifest list ($format)"
+
+        run_podman manifest inspect $list
+        is "$(jq -r '.manifests[] | select(.artifactType == null) | .platform.architecture' <<<"$output" | sort | tr '\n' ' ')" \
+           "amd64 arm64 " "all instances are restored ($format)"
+        is "$(jq -r '.manifests[].artifactType | select(. != null)' <<<"$output")" \
+           "application/x-sbom" "the artifact is restored ($format)"
+        # The artifact no longer depends on the file it was created from.
+        rm -f $PODMAN_TMPDIR/sbom.json
+    done
+
+    run_podman manifest rm $list
+    run_podman rmi $iids
+}
+
+@test "podman load --progress=json" {
+    archive=$PODMAN_TMPDIR/progress.tar
+    run_podman save -q -o $archive $IMAGE
+
+    run_podman load --progress=json -i $archive
+    assert "$output" =~ '\{"event":"success",.*"images":\[' \
+           "load --progress=json emits a final success event"
+
+    run_podman 125 load --progress=yaml -i $archive
+    is "$output" 'Error: invalid progress format "yaml": must be "text" or "json"'
+}
+
 # vim: filetype=sh
@@ -59,4 +59,44 @@ load helpers
     buildah rm buildahctr
 }
 
+@test "podman diff --content and --export" {
+    skip_if_remote "--content and --export are not available remotely"
+
+    n=c-$(safename)
+    rand_file=$(random_string 10)
+    run_podman run --name $n $IMAGE sh -c "echo test >>/etc/passwd; echo $rand_file >/$rand_file; rm /etc/services; cp /bin/busybox /etc/busybox; echo x >>/etc/busybox"
+
+    run_podman diff --content $n
+    assert "$output" =~ "--- a/etc/passwd
+\+\+\+ b/etc/passwd
+@@ .* @@
+.*
+\+test" "unified diff of a changed file"
+    assert "$output" =~ "--- /dev/null
+\+\+\+ b/$rand_file
+@@ -0,0 \+1 @@
+\+$rand_file" "unified diff of an added file"
+    assert "$output" =~ "--- a/etc/services
+\+\+\+ /dev/null" "unified diff of a deleted file"
+    assert "$output" =~ "Binary file /etc/busybox added: [0-9]+ bytes, sha256:[0-9a-f]{64}" "binary file"
+
+    # Only the given paths are compared
+    run_podman container diff --content --format json $n /etc/passwd
+    run jq -r '.[] | "\(.kind) \(.path) \(.binary)"' <<<"$output"
+    assert "$output" = "changed /etc/passwd false" "json output restricted to path"
+
+    run_podman diff --export $PODMAN_TMPDIR/patch.tar $n /etc
+    assert "$output" =~ "D /etc/services" "changes are listed with --export"
+    assert "$output" !~ "/$rand_file" "changes are restricted to path"
+    run tar -tf $PODMAN_TMPDIR/patch.tar
+    assert "$output" =~ "etc/passwd" "changed file is exported"
+    assert "$output" =~ "etc/.wh.services" "deleted file is exported as whiteout"
+    assert "$output" !~ "$rand_file" "exported files are restricted to path"
+
+    run_podman 125 diff --content $IMAGE
+    assert "$output" =~ "--content and --export require a container" "images are rejected"
+
+    run_podman rm $n
+}
+
 # vim: filetype=sh
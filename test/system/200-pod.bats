@@ -809,4 +809,70 @@ function thingy_with_unique_id() {
     done
 }
 
+# bats test_tags=ci:parallel
+@test "podman pod debug" {
+    local podname=p-$(safename)
+    local ctrname=c-$(safename)
+    local debugname=d-$(safename)
+
+    run_podman pod create --name $podname
+    run_podman 125 pod debug --image $IMAGE $podname true
+    assert "$output" =~ "pod $podname must be running to add a debug container" "pod must be running"
+
+    run_podman run -d --pod $podname --name $ctrname $IMAGE top
+
+    # The processes of the target are visible although the pod does not share its PID namespace
+    run_podman pod debug --image $IMAGE --name $debugname --target $ctrname $podname ps -o args
+    assert "$output" =~ "top" "processes of the target container are visible"
+
+    run_podman pod debug --image $IMAGE $podname sh -c "ps -o args; exit 7"
+    assert "$output" !~ "top" "processes of the target container are not visible without --target"
+
+    run_podman 7 pod debug --image $IMAGE $podname sh -c "exit 7"
+
+    # The debug containers are removed
+    run_podman ps -a --filter pod=$podname --format '{{.Names}}'
+    assert "$output" !~ "$debugname" "debug container was removed"
+    run_podman pod inspect --format '{{.NumContainers}}' $podname
+    is "$output" "2" "only the infra and the regular container remain"
+
+    run_podman 125 pod debug --image $IMAGE --target $IMAGE $podname true
+    assert "$output" =~ "no such container $IMAGE" "target must be a container"
+
+    run_podman pod rm -f -t0 $podname
+}
+
+# bats test_tags=ci:parallel
+@test "podman pod create --infra-no-image" {
+    skip_if_remote "the static dir of the server is not accessible"
+
+    local podname="p-$(safename)"
+    run_podman 125 pod create --infra-no-image --infra-image $IMAGE --name $podname
+    is "$output" ".*InfraNoImage and InfraImage are mutually exclusive pod options.*"
+    run_podman 125 pod create --infra-health-interval 5s --name $podname
+    is "$output" ".*InfraHealthInterval requires InfraNoImage.*"
+
+    run_podman '?' pod create --infra-no-image --infra-health-interval 2s --name $podname
+    if [[ $status -ne 0 ]]; then
+        assert "$output" =~ "is dynamically linked" "only a dynamically linked init binary may fail"
+        skip "the init binary is not statically linked"
+    fi
+    run_podman 125 pod create --infra-no-image --infra-command /pause --infra-health-interval 2s --name p2-$(safename)
+    is "$output" ".*an infra healthcheck requires the infra command to run /catatonit.*"
+    run_podman pod inspect --format '{{.InfraConfig.NoImage}}' $podname
+    is "$output" "true" "infra container has no image"
+    run_podman pod inspect --format '{{.InfraContainerID}}' $podname
+    local infra_cid="$output"
+    run_podman container inspect --format '{{.ImageName}}--{{.Config.Entrypoint}}' $infra_cid
+    is "$output" "--\[/catatonit -P\]" "infra container runs the init binary"
+
+    run_podman pod start $podname
+    run_podman run --rm --pod $podname $IMAGE true
+    run_podman healthcheck run $infra_cid
+    run_podman pod inspect --format '{{.InfraHealth}}' $podname
+    is "$output" "healthy" "health status of the infra container"
+
+    run_podman pod rm -f -t0 $podname
+}
+
 # vim: filetype=sh
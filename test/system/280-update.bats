@@ -161,4 +161,48 @@ device-write-iops   = /dev/zero:4000 | - | -
     run_podman rm -f -t0 testctr
 }
 
+@test "podman container recommend" {
+    skip_if_rootless_cgroupsv1
+
+    run_podman run -d --name testctr $IMAGE top
+    cid="$output"
+
+    samples=${PODMAN_TMPDIR}/samples.json
+    run_podman container recommend --window 3s --samples $samples testctr
+    assert "$output" =~ "podman update --cpus=[0-9.]+ --memory=[0-9]+m --memory-reservation=[0-9]+m --pids-limit=[0-9]+ testctr" \
+           "update command is recommended"
+    assert "$(wc -l <$samples)" -ge 2 "samples are recorded"
+
+    # The recorded samples can be used without sampling again
+    run_podman container recommend --window 0 --samples $samples --format quadlet testctr
+    assert "${lines[0]}" = "[Container]" "quadlet section"
+    assert "${lines[1]}" =~ "^PidsLimit=[0-9]+$" "quadlet pids limit"
+    assert "${lines[2]}" =~ "^PodmanArgs=--cpus=" "quadlet podman args"
+
+    run_podman container recommend --window 0 --samples $samples --format kube testctr
+    assert "$output" =~ "- name: testctr" "kube patch names the container"
+    assert "$output" =~ "memory: [0-9]+Mi" "kube patch has a memory limit"
+
+    run_podman container recommend --window 0 --samples $samples --format json testctr
+    run jq -r .recommendation.pids_limit <<<"$output"
+    assert "$output" -ge 1 "json recommendation"
+
+    run_podman container recommend --window 0 --samples $samples --io-device /dev/sda testctr
+    assert "$output" =~ "--device-read-bps=/dev/sda:[0-9]+m --device-write-bps=/dev/sda:[0-9]+m" \
+           "block I/O limits are recommended for the device"
+    run_podman container recommend --window 0 --samples $samples --io-device /dev/sda --format quadlet testctr
+    assert "${lines[2]}" =~ "--device-write-bps=/dev/sda:[0-9]+m$" "quadlet block I/O limits"
+    run_podman 125 container recommend --window 0 --samples $samples --io-device sda testctr
+    is "$output" "Error: --io-device \"sda\" is not a path in /dev"
+
+    run_podman 125 container recommend --window 0 testctr
+    is "$output" "Error: --samples is required if --window is 0"
+
+    run_podman 125 container recommend --format yaml testctr
+    assert "$output" =~ "unsupported format \"yaml\"" "invalid format"
+
+    run_podman rm -f -t0 $cid
+}
+
+
 # vim: filetype=sh
@@ -0,0 +1,142 @@
+#!/usr/bin/env bats   -*- bats -*-
+#
+# tests for podman system storage migrate
+#
+
+load helpers
+
+function setup() {
+    basic_setup
+
+    skip_if_remote "podman system storage migrate is not available remote"
+}
+
+@test "podman system storage migrate - invalid driver" {
+    driver=$(podman_storage_driver)
+
+    run_podman 125 system storage migrate --driver $driver --force
+    is "$output" "Error: storage already uses the $driver graph driver: invalid argument" \
+       "migrating to the current graph driver"
+
+    run_podman 125 system storage migrate --force
+    is "$output" "Error: required flag(s) \"driver\" not set" "--driver is required"
+}
+
+@test "podman system storage migrate - dry run" {
+    driver=$(podman_storage_driver)
+    other=vfs
+    if [[ "$driver" == "vfs" ]]; then
+        other=overlay
+    fi
+
+    cname=c-$(safename)
+    run_podman create --name $cname $IMAGE true
+
+    run_podman system storage migrate --driver $other --dry-run
+    assert "$output" =~ "^Migrating from $driver to $other copies [0-9]+ images with [0-9]+ layers and [0-9]+ containers, about " \
+           "dry run output"
+
+    # nothing changed
+    run_podman info --format '{{.Store.GraphDriverName}}'
+    is "$output" "$driver" "graph driver after dry run"
+    run_podman container exists $cname
+
+    run_podman rm $cname
+}
+
+# Creates containers, a volume and an image in an isolated storage with the
+# current graph driver, to be migrated to the graph driver $other.
+function _setup_migration() {
+    driver=$(podman_storage_driver)
+    other=vfs
+    if [[ "$driver" == "vfs" ]]; then
+        other=overlay
+    fi
+
+    # To avoid network pull, copy $IMAGE straight to temp root
+    p_opts="$(podman_isolation_opts ${PODMAN_TMPDIR}) --events-backend file"
+    run_podman         save -o $PODMAN_TMPDIR/image.tar $IMAGE
+    run_podman $p_opts load -i $PODMAN_TMPDIR/image.tar
+    run_podman $p_opts image inspect --format '{{.ID}}' $IMAGE
+    iid="$output"
+
+    volname=v-$(safename)
+    volcontent=$(random_string 20)
+    run_podman $p_opts volume create $volname
+
+    # A file in the writable layer of a stopped container...
+    cname=c-$(safename)
+    content=$(random_string 20)
+    run_podman $p_opts run --name $cname -v $volname:/vol $IMAGE \
+               sh -c "echo $content >/myfile; echo $volcontent >/vol/file"
+    run_podman $p_opts inspect --format '{{.Id}}' $cname
+    cid="$output"
+
+    # ...and a running container
+    rname=r-$(safename)
+    run_podman $p_opts run -d --name $rname $IMAGE top
+    rid="$output"
+}
+
+# Checks that everything created by _setup_migration survived the migration.
+function _check_migration() {
+    run_podman $p_opts info --format '{{.Store.GraphDriverName}}'
+    is "$output" "$other" "graph driver after migration"
+
+    run_podman $p_opts images --format '{{.ID}} {{.Repository}}:{{.Tag}}' --no-trunc
+    is "$output" "sha256:$iid $IMAGE" "images after migration"
+
+    run_podman $p_opts ps -a --format '{{.ID}} {{.Names}} {{.State}}' --no-trunc --sort names
+    is "${lines[0]}" "$cid $cname exited" "stopped container after migration"
+    is "${lines[1]}" "$rid $rname exited" "running container is stopped by the migration"
+
+    run_podman $p_opts cp $cname:/myfile $PODMAN_TMPDIR/myfile
+    is "$(< $PODMAN_TMPDIR/myfile)" "$content" "file in the writable layer after migration"
+
+    run_podman $p_opts run --rm -v $volname:/vol $IMAGE cat /vol/file
+    is "$output" "$volcontent" "file in the volume after migration"
+
+    run_podman $p_opts start $rname
+    run_podman $p_opts rm -f -t0 $cname $rname
+    run_podman $p_opts volume rm $volname
+    run_podman $p_opts rmi $iid
+
+    if [[ -e ${PODMAN_TMPDIR}/root/storage-migration.json ]]; then
+        die "State of the migration still exists after the migration"
+    fi
+}
+
+@test "podman system storage migrate" {
+    _setup_migration
+
+    run_podman $p_opts system storage migrate --driver $other --force
+    assert "$output" =~ "Migrated 1 images with [0-9]+ layers and 2 containers from $driver to $other" \
+           "migration output"
+    assert "$output" !~ "Resuming" "a new migration is not resumed"
+
+    _check_migration
+}
+
+@test "podman system storage migrate - resume interrupted migration" {
+    _setup_migration
+
+    # Pretend that a migration was interrupted after it copied the image.
+    local root=${PODMAN_TMPDIR}/root
+    local runroot=${PODMAN_TMPDIR}/runroot
+    run_podman $p_opts push $IMAGE "containers-storage:[$other@$root+$runroot]$IMAGE"
+    printf '{"from":"%s","to":"%s"}' $driver $other > $root/storage-migration.json
+
+    # It must be resumed first
+    run_podman 125 $p_opts system storage migrate --driver btrfs --force
+    is "$output" "Error: an interrupted migration to the $other graph driver must be resumed first: invalid argument" \
+       "migrating to another graph driver during an interrupted migration"
+
+    run_podman $p_opts system storage migrate --driver $other --force
+    assert "${lines[0]}" == "Resuming the interrupted migration to $other" "migration is resumed"
+    assert "$output" =~ "Migrated 1 images with [0-9]+ layers and 2 containers from $driver to $other" \
+           "migration output"
+
+    _check_migration
+}
+
+# vim: filetype=sh
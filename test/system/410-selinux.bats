@@ -400,4 +400,38 @@ EOF
     is "$output" "${RELABEL} $tmpdir" "Ignored private relabel Correctly"}
 }
 
+# bats test_tags=ci:parallel
+@test "podman generate selinux" {
+    skip_if_no_selinux
+    skip_if_remote "podman generate selinux is not available remotely"
+
+    ctrname=c-$(safename)
+    mkdir -p $PODMAN_TMPDIR/data
+    run_podman create --name $ctrname --cap-add net_admin \
+               -v $PODMAN_TMPDIR/data:/data -p 8080:80 $IMAGE true
+
+    run_podman generate selinux --name "my-policy" $ctrname
+    assert "${lines[0]}" = "(block my_policy" "policy name is sanitized"
+    assert "$output" =~ "\(blockinherit container\)" "inherits the base template"
+    assert "$output" =~ "\(blockinherit restricted_net_container\)" "inherits the network template"
+    assert "$output" =~ "\(allow process process \( capability \(.* net_admin .*\)\)\)" "allows capabilities"
+    assert "$output" =~ "\(allow process [a-z_]+_port_t \( tcp_socket \( name_bind \)\)\)" "allows the published port"
+
+    label=$(stat -c %C $PODMAN_TMPDIR/data)
+    assert "$output" =~ "\(allow process $(secon -t $label) \( dir \( open read getattr lock search ioctl add_name remove_name write \)\)\)" \
+           "allows writing the bind mount"
+    assert "$output" =~ "label=type:my_policy.process" "prints how to use the policy"
+
+    run_podman generate selinux --filename $PODMAN_TMPDIR/policy.cil $ctrname
+    assert "${lines[0]}" = "$PODMAN_TMPDIR/policy.cil" "prints the policy file"
+    assert "$(head -n1 $PODMAN_TMPDIR/policy.cil)" = "(block ${ctrname//-/_}" "policy file is written"
+
+    run_podman rm $ctrname
+
+    run_podman create --name $ctrname --privileged $IMAGE true
+    run_podman 125 generate selinux $ctrname
+    assert "$output" =~ "is privileged and runs without SELinux separation" "privileged containers are rejected"
+    run_podman rm $ctrname
+}
+
 # vim: filetype=sh
@@ -816,6 +816,74 @@ spec:
     run_podman kube down $pod_file
 }
 
+# bats test_tags=ci:parallel
+@test "podman kube play --kustomize" {
+    foovalue="foo-$(safename)"
+    podname="p-$(safename)"
+    ctrname="c-$(safename)"
+
+    base=$PODMAN_TMPDIR/kustomize/base
+    overlay=$PODMAN_TMPDIR/kustomize/overlay
+    mkdir -p $base $overlay
+    cat >$base/pod.yaml <<EOF
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $podname
+spec:
+  restartPolicy: Never
+  containers:
+  - name: $ctrname
+    image: $IMAGE
+    envFrom:
+    - configMapRef:
+        name: config
+    - secretRef:
+        name: secret
+    command:
+    - /bin/sh
+    args:
+    - -c
+    - "echo \$FOO:\$MODE:\$TOKEN"
+EOF
+    cat >$base/kustomization.yaml <<EOF
+resources:
+- pod.yaml
+configMapGenerator:
+- name: config
+  literals:
+  - FOO=$foovalue
+  - MODE=base
+EOF
+    cat >$overlay/kustomization.yaml <<EOF
+resources:
+- ../base
+nameSuffix: -prod
+configMapGenerator:
+- name: config
+  behavior: merge
+  literals:
+  - MODE=prod
+secretGenerator:
+- name: secret
+  envs:
+  - secret.env
+EOF
+    echo "TOKEN=t0ken" >$overlay/secret.env
+
+    run_podman 125 kube play --kustomize -
+    is "$output" "Error: --kustomize requires a local kustomization directory, not \"-\""
+
+    run_podman kube play --kustomize $overlay
+    run_podman wait $podname-prod-$ctrname
+    run_podman logs $podname-prod-$ctrname
+    assert "$output" = "$foovalue:prod:t0ken" "output from podman logs"
+
+    run_podman kube down --kustomize $overlay
+    assert "$output" =~ "Pods removed:" "kube down --kustomize"
+    run_podman 1 pod exists $podname-prod
+}
+
 # bats test_tags=ci:parallel
 @test "podman kube with --authfile=/tmp/bogus" {
     _write_test_yaml
@@ -1282,3 +1350,153 @@ EOF
 
     run_podman pod rm -f $podname
 }
+
+# CANNOT BE PARALLELIZED: starts the registry
+@test "podman kube play - imagePullSecrets" {
+    local registry=localhost:${PODMAN_LOGIN_REGISTRY_PORT}
+    local image=$registry/pull-secret-$(safename):$(random_string)
+    local authfile=$PODMAN_TMPDIR/authfile.json
+    local podname=p-$(safename)
+    local secretname=s-$(safename)
+
+    start_registry
+    run_podman login --authfile=$authfile \
+        --tls-verify=false \
+        --username ${PODMAN_LOGIN_USER} \
+        --password ${PODMAN_LOGIN_PASS} \
+        $registry
+    run_podman image tag $IMAGE $image
+    run_podman image push --tls-verify=false --authfile=$authfile $image
+    run_podman image rm $image
+
+    auth=$(echo -n "${PODMAN_LOGIN_USER}:${PODMAN_LOGIN_PASS}" | base64 -w0)
+    dockerconfigjson=$(echo -n "{\"auths\":{\"$registry\":{\"auth\":\"$auth\"}}}" | base64 -w0)
+    cat >$PODMAN_TMPDIR/pull-secret.yaml <<EOF
+apiVersion: v1
+kind: Secret
+metadata:
+  name: $secretname
+type: kubernetes.io/dockerconfigjson
+data:
+  .dockerconfigjson: $dockerconfigjson
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $podname
+spec:
+  imagePullSecrets:
+  - name: $secretname
+  containers:
+  - name: ctr
+    image: $image
+    command:
+    - "true"
+EOF
+
+    # Without the secret the pull fails
+    sed -e "/imagePullSecrets/,+1d" $PODMAN_TMPDIR/pull-secret.yaml >$PODMAN_TMPDIR/no-secret.yaml
+    run_podman 125 kube play --tls-verify=false --start=false $PODMAN_TMPDIR/no-secret.yaml
+    assert "$output" =~ "authentication required|unauthorized" "pull without the secret fails"
+    run_podman kube down $PODMAN_TMPDIR/no-secret.yaml
+
+    run_podman kube play --tls-verify=false --start=false $PODMAN_TMPDIR/pull-secret.yaml
+    run_podman image exists $image
+
+    run_podman kube generate $podname
+    assert "$output" =~ "imagePullSecrets:
+  - name: $secretname" "kube generate emits the image pull secrets"
+    assert "$output" !~ "io.podman.annotations.kube.image.pull.secrets" "annotation is not emitted"
+
+    run_podman 125 kube play --replace --start=false - <<EOF
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $podname
+spec:
+  imagePullSecrets:
+  - name: bogus-$secretname
+  containers:
+  - name: ctr
+    image: $image
+EOF
+    assert "$output" =~ "looking up image pull secret \"bogus-$secretname\"" "unknown secret"
+
+    run_podman kube down $PODMAN_TMPDIR/pull-secret.yaml
+    run_podman rmi -f $image
+}
+
+# bats test_tags=ci:parallel
+@test "podman kube play - runtimeClassName" {
+    local podname=p-$(safename)
+
+    run_podman info --format '{{.Host.OCIRuntime.Name}}'
+    runtime="$output"
+
+    cat >$PODMAN_TMPDIR/runtime-class.yaml <<EOF
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $podname
+spec:
+  runtimeClassName: $runtime
+  containers:
+  - name: ctr
+    image: $IMAGE
+    command:
+    - "true"
+EOF
+    run_podman kube play --start=false $PODMAN_TMPDIR/runtime-class.yaml
+    run_podman container inspect --format '{{.OCIRuntime}}' $podname-ctr
+    is "$output" "$runtime" "container uses the OCI runtime of the runtime class"
+
+    # The default runtime is not emitted
+    run_podman kube generate $podname
+    assert "$output" !~ "runtimeClassName" "default runtime is not emitted"
+    run_podman kube down $PODMAN_TMPDIR/runtime-class.yaml
+
+    sed -i -e "s/runtimeClassName: .*/runtimeClassName: bogus-$(safename)/" $PODMAN_TMPDIR/runtime-class.yaml
+    run_podman 125 kube play --start=false $PODMAN_TMPDIR/runtime-class.yaml
+    assert "$output" =~ "runtime class \"bogus-$(safename)\" does not match an OCI runtime in containers.conf" "unknown runtime class"
+}
+
+# bats test_tags=ci:parallel
+@test "podman kube play - ephemeralContainers" {
+    local podname=p-$(safename)
+
+    cat >$PODMAN_TMPDIR/ephemeral.yaml <<EOF
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $podname
+spec:
+  containers:
+  - name: ctr
+    image: $IMAGE
+    command:
+    - top
+  ephemeralContainers:
+  - name: debug
+    image: $IMAGE
+    targetContainerName: ctr
+    command:
+    - sh
+    - -c
+    - "ps -o args"
+EOF
+    run_podman kube play $PODMAN_TMPDIR/ephemeral.yaml
+    run_podman wait $podname-debug
+    run_podman logs $podname-debug
+    assert "$output" =~ "top" "ephemeral container sees the processes of the target"
+    run_podman container inspect --format '{{.HostConfig.RestartPolicy.Name}}' $podname-debug
+    is "$output" "no" "ephemeral containers are not restarted"
+
+    run_podman kube generate $podname
+    assert "$output" !~ "$podname-debug|name: debug" "ephemeral containers are not emitted"
+    run_podman kube down $PODMAN_TMPDIR/ephemeral.yaml
+
+    sed -i -e "s/targetContainerName: ctr/targetContainerName: bogus/" $PODMAN_TMPDIR/ephemeral.yaml
+    run_podman 125 kube play $PODMAN_TMPDIR/ephemeral.yaml
+    assert "$output" =~ "target container \"bogus\" of ephemeral container \"debug\" does not exist" "unknown target"
+    run_podman kube down $PODMAN_TMPDIR/ephemeral.yaml
+}
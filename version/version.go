@@ -30,7 +30,7 @@ const (
 // bumped.
 var Version = semver.MustParse(rawversion.RawVersion)
 
-// See https://docs.docker.com/engine/api/v1.40/
+// See https://docs.docker.com/engine/api/v1.47/
 // libpod compat handlers are expected to honor docker API versions
 
 // APIVersion provides the current and minimal API versions for compat and libpod endpoint trees
@@ -43,7 +43,7 @@ var APIVersion = map[Tree]map[Level]semver.Version{
 		MinimalAPI: semver.MustParse("4.0.0"),
 	},
 	Compat: {
-		CurrentAPI: semver.MustParse("1.41.0"),
+		CurrentAPI: semver.MustParse("1.47.0"),
 		MinimalAPI: semver.MustParse("1.24.0"),
 	},
 }